@@ -60,6 +60,17 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// candidateLocations is the ordered set of Azure Regions configured for this test, which are
+	// preferred when selecting the Locations for a test which has location requirements
+	candidateLocations []string
+
+	// locationRequirements is a list of capabilities which the Locations used by this test must support
+	locationRequirements []locationRequirement
+
+	// locationSkipReason is the reason this test should be skipped, when fewer than three
+	// regions meet the locationRequirements
+	locationSkipReason string
 }
 
 // BuildTestData generates some test data for the given resource
@@ -90,6 +101,11 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 			Ternary:   os.Getenv("ARM_TEST_LOCATION_ALT2"),
 		}
 	}
+	testData.candidateLocations = []string{
+		testData.Locations.Primary,
+		testData.Locations.Secondary,
+		testData.Locations.Ternary,
+	}
 
 	testData.Subscriptions = Subscriptions{
 		Primary:   os.Getenv("ARM_SUBSCRIPTION_ID"),
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// locationAvailabilityJson is a snapshot of the SKUs, Availability Zones and Resource Providers which
+// are available (and have quota) within the test subscription for each Azure Region.
+//
+// This is checked in so that location selection remains deterministic (and works offline) - as such
+// it should be regenerated using `./internal/tools/generator-location-availability` when the test
+// subscription gains/loses capacity in a given region. Until the snapshot has been generated (and so
+// contains no locations) the requirements of a test can't be evaluated, and the configured locations
+// are used as-is.
+//
+//go:embed location_availability.json
+var locationAvailabilityJson []byte
+
+var (
+	locationAvailabilityOnce     sync.Once
+	locationAvailabilitySnapshot *locationAvailability
+	locationAvailabilityErr      error
+)
+
+type locationAvailability struct {
+	// GeneratedOn is the date that this snapshot was taken
+	GeneratedOn string `json:"generatedOn"`
+
+	// Locations is a map of the normalized Azure Region name to the capabilities available in that region
+	Locations map[string]locationCapabilities `json:"locations"`
+}
+
+type locationCapabilities struct {
+	// AvailabilityZones is a list of the Availability Zones available in this region
+	AvailabilityZones []string `json:"availabilityZones"`
+
+	// Services is a list of the Resource Provider namespaces available in this region
+	Services []string `json:"services"`
+
+	// SKUs is a list of the Virtual Machine SKUs which are unrestricted and have quota in this region
+	SKUs []string `json:"skus"`
+}
+
+// locationRequirement is a capability which must be available in a region for it to be used for a test
+type locationRequirement struct {
+	// description is a human-readable description of this requirement, used when skipping a test
+	description string
+
+	// satisfiedBy returns whether the capabilities of a given region meet this requirement
+	satisfiedBy func(input locationCapabilities) bool
+}
+
+// RequireSKU ensures that the Locations used for this test have capacity for the specified SKUs
+// if fewer than three regions qualify, the test will be skipped
+func (td *TestData) RequireSKU(skus ...string) {
+	for _, sku := range skus {
+		sku := sku
+		td.addLocationRequirement(locationRequirement{
+			description: fmt.Sprintf("SKU %q", sku),
+			satisfiedBy: func(input locationCapabilities) bool {
+				return containsFold(input.SKUs, sku)
+			},
+		})
+	}
+}
+
+// RequireAvailabilityZones ensures that the Locations used for this test support Availability Zones
+// if fewer than three regions qualify, the test will be skipped
+func (td *TestData) RequireAvailabilityZones() {
+	td.addLocationRequirement(locationRequirement{
+		description: "Availability Zones",
+		satisfiedBy: func(input locationCapabilities) bool {
+			return len(input.AvailabilityZones) > 0
+		},
+	})
+}
+
+// RequireService ensures that the Locations used for this test support the specified Resource Providers
+// (e.g. `Microsoft.ContainerService`) - if fewer than three regions qualify, the test will be skipped
+func (td *TestData) RequireService(namespaces ...string) {
+	for _, namespace := range namespaces {
+		namespace := namespace
+		td.addLocationRequirement(locationRequirement{
+			description: fmt.Sprintf("Service %q", namespace),
+			satisfiedBy: func(input locationCapabilities) bool {
+				return containsFold(input.Services, namespace)
+			},
+		})
+	}
+}
+
+func (td *TestData) addLocationRequirement(requirement locationRequirement) {
+	td.locationRequirements = append(td.locationRequirements, requirement)
+
+	snapshot, err := loadLocationAvailability()
+	if err != nil {
+		td.locationSkipReason = fmt.Sprintf("loading the location availability snapshot: %+v", err)
+		return
+	}
+	if len(snapshot.Locations) == 0 {
+		return
+	}
+
+	regions, skipReason := selectLocations(snapshot, td.candidateLocations, td.locationRequirements)
+	td.locationSkipReason = skipReason
+	if skipReason == "" {
+		td.Locations = regions
+	}
+}
+
+// selectLocations returns three distinct locations which meet all of the requirements, preferring the candidate
+// locations (in order) before falling back to the other qualifying regions within the snapshot - returning a reason
+// the test should be skipped when fewer than three regions qualify
+func selectLocations(snapshot *locationAvailability, candidates []string, requirements []locationRequirement) (Regions, string) {
+	selected := make([]string, 0)
+	seen := make(map[string]struct{})
+	reasons := make([]string, 0)
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+		if _, ok := seen[location.Normalize(candidate)]; ok {
+			continue
+		}
+		seen[location.Normalize(candidate)] = struct{}{}
+
+		if unmet := snapshot.unmetRequirements(candidate, requirements); len(unmet) > 0 {
+			reasons = append(reasons, fmt.Sprintf("%q (missing %s)", candidate, strings.Join(unmet, ", ")))
+			continue
+		}
+		selected = append(selected, candidate)
+	}
+
+	// then fill any remaining slots from the other regions in the snapshot, sorted so that the selection is deterministic
+	others := make([]string, 0)
+	for name := range snapshot.Locations {
+		if _, ok := seen[name]; !ok {
+			others = append(others, name)
+		}
+	}
+	sort.Strings(others)
+	for _, name := range others {
+		if len(selected) == 3 {
+			break
+		}
+		if len(snapshot.unmetRequirements(name, requirements)) == 0 {
+			selected = append(selected, name)
+		}
+	}
+
+	if len(selected) < 3 {
+		message := fmt.Sprintf("Skipping since only %d of the 3 test locations can meet the requirements of this test (snapshot generated on %s)", len(selected), snapshot.GeneratedOn)
+		if len(reasons) > 0 {
+			message = fmt.Sprintf("%s: %s", message, strings.Join(reasons, "; "))
+		}
+		return Regions{}, message
+	}
+
+	return Regions{
+		Primary:   selected[0],
+		Secondary: selected[1],
+		Ternary:   selected[2],
+	}, ""
+}
+
+// unmetRequirements returns the descriptions of the requirements which the specified location doesn't meet
+func (a locationAvailability) unmetRequirements(input string, requirements []locationRequirement) []string {
+	capabilities, ok := a.Locations[location.Normalize(input)]
+	if !ok {
+		return []string{fmt.Sprintf("an entry in the location availability snapshot (generated on %s)", a.GeneratedOn)}
+	}
+
+	unmet := make([]string, 0)
+	for _, requirement := range requirements {
+		if !requirement.satisfiedBy(capabilities) {
+			unmet = append(unmet, requirement.description)
+		}
+	}
+	return unmet
+}
+
+func loadLocationAvailability() (*locationAvailability, error) {
+	locationAvailabilityOnce.Do(func() {
+		var snapshot locationAvailability
+		if err := json.Unmarshal(locationAvailabilityJson, &snapshot); err != nil {
+			locationAvailabilityErr = fmt.Errorf("unmarshaling: %+v", err)
+			return
+		}
+
+		locationAvailabilitySnapshot = &snapshot
+	})
+
+	return locationAvailabilitySnapshot, locationAvailabilityErr
+}
+
+func containsFold(input []string, value string) bool {
+	for _, v := range input {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance
+
+import (
+	"testing"
+)
+
+func TestLocationAvailabilitySnapshotIsValid(t *testing.T) {
+	snapshot, err := loadLocationAvailability()
+	if err != nil {
+		t.Fatalf("loading snapshot: %+v", err)
+	}
+
+	// the snapshot is empty until it's been generated against the test subscription
+	if len(snapshot.Locations) > 0 && snapshot.GeneratedOn == "" {
+		t.Fatalf("expected the snapshot to contain the date it was generated on")
+	}
+}
+
+func TestSelectLocations(t *testing.T) {
+	snapshot := &locationAvailability{
+		GeneratedOn: "2024-01-01",
+		Locations: map[string]locationCapabilities{
+			"westeurope": {
+				AvailabilityZones: []string{"1", "2", "3"},
+				Services:          []string{"Microsoft.ContainerService"},
+				SKUs:              []string{"Standard_D2s_v3"},
+			},
+			"westus": {
+				Services: []string{"Microsoft.ContainerService"},
+				SKUs:     []string{"Standard_F2"},
+			},
+			"eastus2": {
+				AvailabilityZones: []string{"1", "2", "3"},
+				SKUs:              []string{"Standard_F2"},
+			},
+			"northeurope": {
+				AvailabilityZones: []string{"1", "2", "3"},
+				Services:          []string{"Microsoft.ContainerService"},
+				SKUs:              []string{"Standard_D2s_v3", "Standard_F2"},
+			},
+			"australiaeast": {
+				AvailabilityZones: []string{"1", "2", "3"},
+				Services:          []string{"Microsoft.ContainerService"},
+				SKUs:              []string{"Standard_D2s_v3"},
+			},
+			"uksouth": {
+				Services: []string{"Microsoft.ContainerService"},
+				SKUs:     []string{"Standard_F2"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		candidates    []string
+		requirements  func(td *TestData)
+		expected      Regions
+		expectSkipped bool
+	}{
+		{
+			name:       "sku only in the ternary test location",
+			candidates: []string{"westus", "eastus2", "West Europe"},
+			requirements: func(td *TestData) {
+				td.RequireSKU("standard_d2s_v3")
+			},
+			expected: Regions{
+				Primary:   "West Europe",
+				Secondary: "australiaeast",
+				Ternary:   "northeurope",
+			},
+		},
+		{
+			name:       "availability zones",
+			candidates: []string{"westus", "eastus2", "westeurope"},
+			requirements: func(td *TestData) {
+				td.RequireAvailabilityZones()
+			},
+			expected: Regions{
+				Primary:   "eastus2",
+				Secondary: "westeurope",
+				Ternary:   "australiaeast",
+			},
+		},
+		{
+			name:       "all test locations qualify",
+			candidates: []string{"westus", "uksouth", "northeurope"},
+			requirements: func(td *TestData) {
+				td.RequireSKU("Standard_F2")
+			},
+			expected: Regions{
+				Primary:   "westus",
+				Secondary: "uksouth",
+				Ternary:   "northeurope",
+			},
+		},
+		{
+			name:       "multiple requirements",
+			candidates: []string{"eastus2", "westus", "westeurope"},
+			requirements: func(td *TestData) {
+				td.RequireService("Microsoft.ContainerService")
+				td.RequireAvailabilityZones()
+			},
+			expected: Regions{
+				Primary:   "westeurope",
+				Secondary: "australiaeast",
+				Ternary:   "northeurope",
+			},
+		},
+		{
+			name:       "location missing from the snapshot",
+			candidates: []string{"southindia", "westus", "eastus2"},
+			requirements: func(td *TestData) {
+				td.RequireAvailabilityZones()
+			},
+			expected: Regions{
+				Primary:   "eastus2",
+				Secondary: "australiaeast",
+				Ternary:   "northeurope",
+			},
+		},
+		{
+			name:       "fewer than three qualifying locations",
+			candidates: []string{"eastus2", "westus"},
+			requirements: func(td *TestData) {
+				td.RequireSKU("Standard_D2s_v3")
+				td.RequireAvailabilityZones()
+				td.RequireService("Microsoft.ContainerService")
+				td.RequireSKU("Standard_F2")
+			},
+			expectSkipped: true,
+		},
+		{
+			name:       "no qualifying location",
+			candidates: []string{"eastus2", "westus"},
+			requirements: func(td *TestData) {
+				td.RequireSKU("Standard_NC6")
+			},
+			expectSkipped: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		td := TestData{
+			candidateLocations: v.candidates,
+		}
+		v.requirements(&td)
+
+		actual, skipReason := selectLocations(snapshot, td.candidateLocations, td.locationRequirements)
+		if v.expectSkipped {
+			if skipReason == "" {
+				t.Fatalf("expected the test to be skipped but it wasn't")
+			}
+			continue
+		}
+
+		if skipReason != "" {
+			t.Fatalf("expected the test not to be skipped but got %q", skipReason)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
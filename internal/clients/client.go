@@ -467,7 +467,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.IoTTimeSeriesInsights, err = timeseriesinsights.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for IoT TimeSeries Insights: %+v", err)
 	}
-	client.KeyVault = keyvault.NewClient(o)
+	if client.KeyVault, err = keyvault.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for KeyVault: %+v", err)
+	}
 	if client.Kusto, err = kusto.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Kusto: %+v", err)
 	}
@@ -594,15 +596,21 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Search, err = search.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Search: %+v", err)
 	}
-	client.SecurityCenter = securityCenter.NewClient(o)
-	client.Sentinel = sentinel.NewClient(o)
+	if client.SecurityCenter, err = securityCenter.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Security Center: %+v", err)
+	}
+	if client.Sentinel, err = sentinel.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Sentinel: %+v", err)
+	}
 	if client.ServiceBus, err = serviceBus.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for ServiceBus: %+v", err)
 	}
 	if client.ServiceConnector, err = serviceConnector.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for ServiceConnector: %+v", err)
 	}
-	client.ServiceFabric = serviceFabric.NewClient(o)
+	if client.ServiceFabric, err = serviceFabric.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Service Fabric: %+v", err)
+	}
 	if client.ServiceFabricManaged, err = serviceFabricManaged.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for ServiceFabricManagedCluster: %+v", err)
 	}
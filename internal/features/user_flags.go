@@ -11,6 +11,7 @@ type UserFeatures struct {
 	VirtualMachine           VirtualMachineFeatures
 	VirtualMachineScaleSet   VirtualMachineScaleSetFeatures
 	KeyVault                 KeyVaultFeatures
+	ManagedHSM               ManagedHSMFeatures
 	TemplateDeployment       TemplateDeploymentFeatures
 	LogAnalyticsWorkspace    LogAnalyticsWorkspaceFeatures
 	ResourceGroup            ResourceGroupFeatures
@@ -47,6 +48,11 @@ type KeyVaultFeatures struct {
 	RecoverSoftDeletedSecrets        bool
 }
 
+type ManagedHSMFeatures struct {
+	PurgeSoftDeleteOnDestroy bool
+	RecoverSoftDeleted       bool
+}
+
 type TemplateDeploymentFeatures struct {
 	DeleteNestedItemsDuringDeletion bool
 }
@@ -270,6 +270,29 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"managed_hsm": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"purge_soft_delete_on_destroy": {
+						Description: "When enabled soft-deleted `azurerm_key_vault_managed_hardware_security_module` resources will be permanently deleted (e.g purged), when destroyed",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+
+					"recover_soft_deleted": {
+						Description: "When enabled soft-deleted `azurerm_key_vault_managed_hardware_security_module` resources will be restored, instead of creating new ones",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+				},
+			},
+		},
+
 		"subscription": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -486,6 +509,19 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["managed_hsm"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			managedHSMRaw := items[0].(map[string]interface{})
+			if v, ok := managedHSMRaw["purge_soft_delete_on_destroy"]; ok {
+				featuresMap.ManagedHSM.PurgeSoftDeleteOnDestroy = v.(bool)
+			}
+			if v, ok := managedHSMRaw["recover_soft_deleted"]; ok {
+				featuresMap.ManagedHSM.RecoverSoftDeleted = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["subscription"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -52,6 +52,10 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: true,
 				},
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -125,6 +129,12 @@ func TestExpandFeatures(t *testing.T) {
 							"expand_without_downtime": true,
 						},
 					},
+					"managed_hsm": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
 					"postgresql_flexible_server": []interface{}{
 						map[string]interface{}{
 							"restart_server_on_configuration_value_change": true,
@@ -193,6 +203,10 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: true,
 				},
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
@@ -266,6 +280,12 @@ func TestExpandFeatures(t *testing.T) {
 							"expand_without_downtime": false,
 						},
 					},
+					"managed_hsm": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
 					"postgresql_flexible_server": []interface{}{
 						map[string]interface{}{
 							"restart_server_on_configuration_value_change": false,
@@ -334,6 +354,10 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: false,
 				},
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
@@ -1235,6 +1259,76 @@ func TestExpandFeaturesManagedDisk(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesManagedHSM(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"managed_hsm": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Delete On Destroy and Recover Soft Deleted Managed HSM Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"managed_hsm": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Purge Soft Delete On Destroy and Recover Soft Deleted Managed HSM Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"managed_hsm": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagedHSM: features.ManagedHSMFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ManagedHSM, testCase.Expected.ManagedHSM) {
+			t.Fatalf("Expected %+v but got %+v", result.ManagedHSM, testCase.Expected.ManagedHSM)
+		}
+	}
+}
+
 func TestExpandFeaturesSubscription(t *testing.T) {
 	testData := []struct {
 		Name     string
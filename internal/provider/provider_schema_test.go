@@ -331,6 +331,18 @@ func TestResourcesDoNotContainANameFieldWithADefaultOfDefault(t *testing.T) {
 		resourceFieldsWhichNeedToBeAddressed = map[string]map[string]struct{}{}
 	}
 
+	// these Resources intentionally expose an item which can also be configured within the parent Resource,
+	// so that it can be managed separately from the parent - as such these are excluded in 4.0 too
+	resourceFieldsWhichAreIntentional := map[string]map[string]struct{}{
+		"azurerm_kubernetes_cluster_maintenance_configuration": {
+			// `default` is one of the Maintenance Configurations which can be managed outside of `azurerm_kubernetes_cluster`
+			"name": {},
+		},
+	}
+	for resourceName, fields := range resourceFieldsWhichAreIntentional {
+		resourceFieldsWhichNeedToBeAddressed[resourceName] = fields
+	}
+
 	for _, resourceName := range resourceNames {
 		resource := provider.ResourcesMap[resourceName]
 		fieldsToBeAddressed := resourceFieldsWhichNeedToBeAddressed[resourceName]
@@ -100,8 +100,8 @@ func (r ApiManagementNotificationRecipientEmailResource) Create() sdk.ResourceFu
 					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 				}
 			}
-			if m := emails.Model; m != nil && m.Value != nil {
-				for _, existing := range *m.Value {
+			if m := emails.Model; m != nil {
+				for _, existing := range *m {
 					if existing.Properties != nil && existing.Properties.Email != nil && *existing.Properties.Email == model.Email {
 						return metadata.ResourceRequiresImport(r.ResourceType(), id)
 					}
@@ -140,8 +140,8 @@ func (r ApiManagementNotificationRecipientEmailResource) Read() sdk.ResourceFunc
 			}
 
 			found := false
-			if model := emails.Model; model != nil && model.Value != nil {
-				for _, existing := range *model.Value {
+			if model := emails.Model; model != nil {
+				for _, existing := range *model {
 					if existing.Properties != nil && existing.Properties.Email != nil && *existing.Properties.Email == id.RecipientEmailName {
 						found = true
 					}
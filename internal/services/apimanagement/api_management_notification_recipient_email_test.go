@@ -62,8 +62,8 @@ func (ApiManagementNotificationRecipientEmailResource) Exists(ctx context.Contex
 			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 		}
 	}
-	if model := emails.Model; model != nil && model.Value != nil {
-		for _, existing := range *model.Value {
+	if model := emails.Model; model != nil {
+		for _, existing := range *model {
 			if existing.Properties != nil && existing.Properties.Email != nil && *existing.Properties.Email == id.RecipientEmailName {
 				return pointer.To(true), nil
 			}
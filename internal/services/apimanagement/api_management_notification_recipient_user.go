@@ -100,8 +100,8 @@ func (r ApiManagementNotificationRecipientUserResource) Create() sdk.ResourceFun
 					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 				}
 			}
-			if m := users.Model; m != nil && m.Value != nil {
-				for _, existing := range *m.Value {
+			if m := users.Model; m != nil {
+				for _, existing := range *m {
 					if existing.Name != nil && *existing.Name == model.UserId {
 						return metadata.ResourceRequiresImport(r.ResourceType(), id)
 					}
@@ -140,8 +140,8 @@ func (r ApiManagementNotificationRecipientUserResource) Read() sdk.ResourceFunc
 			}
 
 			found := false
-			if m := users.Model; m != nil && m.Value != nil {
-				for _, existing := range *m.Value {
+			if m := users.Model; m != nil {
+				for _, existing := range *m {
 					if existing.Name != nil && *existing.Name == id.UserId {
 						found = true
 					}
@@ -62,8 +62,8 @@ func (ApiManagementNotificationRecipientUserResource) Exists(ctx context.Context
 			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 		}
 	}
-	if model := users.Model; model != nil && model.Value != nil {
-		for _, existing := range *model.Value {
+	if model := users.Model; model != nil {
+		for _, existing := range *model {
 			if existing.Name != nil && *existing.Name == id.UserId {
 				return pointer.To(true), nil
 			}
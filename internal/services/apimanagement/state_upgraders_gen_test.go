@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package apimanagement_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestApiManagementApi_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, apimanagement.Registration{}, "azurerm_api_management_api")
+}
+
+func TestApiManagementApiOperationPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, apimanagement.Registration{}, "azurerm_api_management_api_operation_policy")
+}
+
+func TestApiManagementApiPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, apimanagement.Registration{}, "azurerm_api_management_api_policy")
+}
+
+func TestApiManagementApiVersionSet_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, apimanagement.Registration{}, "azurerm_api_management_api_version_set")
+}
+
+func TestApiManagementGatewayApi_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, apimanagement.Registration{}, "azurerm_api_management_gateway_api")
+}
+
+func TestApiManagementPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, apimanagement.Registration{}, "azurerm_api_management_policy")
+}
+
+func TestApiManagementProductPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, apimanagement.Registration{}, "azurerm_api_management_product_policy")
+}
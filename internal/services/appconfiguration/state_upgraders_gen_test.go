@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package appconfiguration_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestAppConfigurationFeature_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appconfiguration.Registration{}, "azurerm_app_configuration_feature")
+}
+
+func TestAppConfigurationKey_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appconfiguration.Registration{}, "azurerm_app_configuration_key")
+}
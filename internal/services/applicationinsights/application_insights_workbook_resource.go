@@ -155,7 +155,7 @@ func (r ApplicationInsightsWorkbookResource) Create() sdk.ResourceFunc {
 			properties := &workbooks.Workbook{
 				Identity: identityValue,
 				Kind:     &kindValue,
-				Location: utils.String(location.Normalize(model.Location)),
+				Location: location.Normalize(model.Location),
 				Properties: &workbooks.WorkbookProperties{
 					Category:       model.Category,
 					DisplayName:    model.DisplayName,
@@ -270,7 +270,7 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 			state := ApplicationInsightsWorkbookModel{
 				Name:              id.WorkbookName,
 				ResourceGroupName: id.ResourceGroupName,
-				Location:          location.NormalizeNilable(model.Location),
+				Location:          location.Normalize(model.Location),
 			}
 
 			identityValue, err := identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
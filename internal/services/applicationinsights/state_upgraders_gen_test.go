@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package applicationinsights_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestApplicationInsights_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, applicationinsights.Registration{}, "azurerm_application_insights")
+}
+
+func TestApplicationInsightsAnalyticsItem_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, applicationinsights.Registration{}, "azurerm_application_insights_analytics_item")
+}
+
+func TestApplicationInsightsApiKey_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, applicationinsights.Registration{}, "azurerm_application_insights_api_key")
+}
+
+func TestApplicationInsightsSmartDetectionRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, applicationinsights.Registration{}, "azurerm_application_insights_smart_detection_rule")
+}
+
+func TestApplicationInsightsWebTest_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, applicationinsights.Registration{}, "azurerm_application_insights_web_test")
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package appservice_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestLinuxFunctionApp_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_linux_function_app")
+}
+
+func TestLinuxFunctionAppSlot_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_linux_function_app_slot")
+}
+
+func TestLinuxWebApp_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_linux_web_app")
+}
+
+func TestLinuxWebAppSlot_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_linux_web_app_slot")
+}
+
+func TestServicePlan_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_service_plan")
+}
+
+func TestWindowsFunctionApp_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_windows_function_app")
+}
+
+func TestWindowsFunctionAppSlot_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_windows_function_app_slot")
+}
+
+func TestWindowsWebApp_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_windows_web_app")
+}
+
+func TestWindowsWebAppSlot_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, appservice.Registration{}, "azurerm_windows_web_app_slot")
+}
@@ -67,7 +67,7 @@ func (c *Client) DataPlaneClientWithEndpoint(endpoint string) (*attestation.Poli
 		segments = segments[2:]
 	}
 	authTokenUri := fmt.Sprintf("https://%s/", strings.Join(segments, "."))
-	api := environments.AttestationAPI(authTokenUri)
+	api := environments.AttestationAPI(authTokenUri, strings.Join(segments, "."))
 	auth, err := c.o.Authorizers.AuthorizerFunc(api)
 	if err != nil {
 		return nil, fmt.Errorf("building Authorizer for %q: %+v", endpoint, err)
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package authorization_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestRoleDefinition_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, authorization.Registration{}, "azurerm_role_definition")
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package automation_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestAutomationSourceControl_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, automation.Registration{}, "azurerm_automation_source_control")
+}
+
+func TestAutomationWebhook_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, automation.Registration{}, "azurerm_automation_webhook")
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package cdn_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestCdnEndpoint_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cdn.Registration{}, "azurerm_cdn_endpoint")
+}
+
+func TestCdnProfile_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cdn.Registration{}, "azurerm_cdn_profile")
+}
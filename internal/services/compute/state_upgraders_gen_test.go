@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestManagedDisk_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, compute.Registration{}, "azurerm_managed_disk")
+}
+
+func TestSnapshot_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, compute.Registration{}, "azurerm_snapshot")
+}
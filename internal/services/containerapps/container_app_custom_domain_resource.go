@@ -0,0 +1,496 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedcertificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppCustomDomainResource struct{}
+
+type ContainerAppCustomDomainModel struct {
+	Name                         string `tfschema:"name"`
+	ContainerAppId               string `tfschema:"container_app_id"`
+	CertificateId                string `tfschema:"container_app_environment_certificate_id"`
+	CertificateBindingType       string `tfschema:"certificate_binding_type"`
+	ManagedCertificateValidation string `tfschema:"managed_certificate_domain_control_validation"`
+
+	ManagedCertificateId       string `tfschema:"container_app_environment_managed_certificate_id"`
+	CustomDomainVerificationId string `tfschema:"custom_domain_verification_id"`
+}
+
+var _ sdk.Resource = ContainerAppCustomDomainResource{}
+
+func (r ContainerAppCustomDomainResource) ModelObject() interface{} {
+	return &ContainerAppCustomDomainModel{}
+}
+
+func (r ContainerAppCustomDomainResource) ResourceType() string {
+	return "azurerm_container_app_custom_domain"
+}
+
+func (r ContainerAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerAppCustomDomainID
+}
+
+func (r ContainerAppCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "The hostname of the Custom Domain.",
+		},
+
+		"container_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerapps.ValidateContainerAppID,
+			Description:  "The ID of the Container App to which this Custom Domain should be bound.",
+		},
+
+		"container_app_environment_certificate_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  managedenvironments.ValidateCertificateID,
+			RequiredWith:  []string{"certificate_binding_type"},
+			ConflictsWith: []string{"managed_certificate_domain_control_validation"},
+			Description:   "The ID of the Container App Environment Certificate to use for this Custom Domain.",
+		},
+
+		"certificate_binding_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(containerapps.PossibleValuesForBindingType(), false),
+			RequiredWith: []string{"container_app_environment_certificate_id"},
+			Description:  "The Binding type for the Container App Environment Certificate. Possible values include `Disabled` and `SniEnabled`.",
+		},
+
+		"managed_certificate_domain_control_validation": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringInSlice(managedcertificates.PossibleValuesForManagedCertificateDomainControlValidation(), false),
+			ConflictsWith: []string{"container_app_environment_certificate_id"},
+			Description:   "The method used to validate ownership of the domain when issuing a free Managed Certificate. Possible values include `CNAME`, `HTTP` and `TXT`.",
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_environment_managed_certificate_id": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The ID of the Managed Certificate issued for this Custom Domain.",
+		},
+
+		"custom_domain_verification_id": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The ID used to verify ownership of the Custom Domain, as the value of the `asuid` TXT record.",
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+			certificatesClient := metadata.Client.ContainerApps.ManagedCertificatesClient
+			environmentClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+			var model ContainerAppCustomDomainModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			appId, err := containerapps.ParseContainerAppID(model.ContainerAppId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerAppCustomDomainID(appId.SubscriptionId, appId.ResourceGroupName, appId.ContainerAppName, model.Name)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			app, err := client.Get(ctx, *appId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *appId, err)
+			}
+
+			ingress, err := containerAppIngressForCustomDomain(app.Model, *appId)
+			if err != nil {
+				return err
+			}
+
+			if findContainerAppCustomDomain(ingress.CustomDomains, model.Name) != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := waitForContainerAppCustomDomainVerification(ctx, client, *appId, model.Name, pointer.From(app.Model.Properties.CustomDomainVerificationId)); err != nil {
+				return fmt.Errorf("verifying ownership of %s: %+v", id, err)
+			}
+
+			customDomain := containerapps.CustomDomain{
+				Name:        model.Name,
+				BindingType: pointer.To(containerapps.BindingTypeDisabled),
+			}
+			if model.CertificateId != "" {
+				customDomain.BindingType = pointer.To(containerapps.BindingType(model.CertificateBindingType))
+				customDomain.CertificateId = pointer.To(model.CertificateId)
+			}
+
+			domains := append(pointer.From(ingress.CustomDomains), customDomain)
+			ingress.CustomDomains = &domains
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *appId, *app.Model); err != nil {
+				return fmt.Errorf("adding %s: %+v", id, err)
+			}
+
+			// the hostname is now bound, so track it even if issuing the Managed Certificate fails
+			metadata.SetID(id)
+
+			if model.ManagedCertificateValidation != "" {
+				// the hostname must be bound to the Container App before a Managed Certificate can be issued for it,
+				// after which the binding is updated to use the issued certificate
+				envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(app.Model.Properties.ManagedEnvironmentId))
+				if err != nil {
+					return err
+				}
+
+				env, err := environmentClient.Get(ctx, *envId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *envId, err)
+				}
+				if env.Model == nil {
+					return fmt.Errorf("retrieving %s: model was nil", *envId)
+				}
+
+				certificateId := managedcertificates.NewManagedCertificateID(envId.SubscriptionId, envId.ResourceGroupName, envId.ManagedEnvironmentName, containerAppManagedCertificateName(appId.ContainerAppName, model.Name))
+
+				certificate := managedcertificates.ManagedCertificate{
+					Location: location.Normalize(env.Model.Location),
+					Properties: &managedcertificates.ManagedCertificateProperties{
+						DomainControlValidation: pointer.To(managedcertificates.ManagedCertificateDomainControlValidation(model.ManagedCertificateValidation)),
+						SubjectName:             pointer.To(model.Name),
+					},
+				}
+
+				if _, err := certificatesClient.CreateOrUpdate(ctx, certificateId, certificate); err != nil {
+					return fmt.Errorf("creating %s for %s: %+v", certificateId, id, err)
+				}
+
+				if err := waitForContainerAppManagedCertificate(ctx, certificatesClient, certificateId); err != nil {
+					return fmt.Errorf("issuing %s for %s: %+v", certificateId, id, err)
+				}
+
+				app, err = client.Get(ctx, *appId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *appId, err)
+				}
+
+				ingress, err = containerAppIngressForCustomDomain(app.Model, *appId)
+				if err != nil {
+					return err
+				}
+
+				existing := findContainerAppCustomDomain(ingress.CustomDomains, model.Name)
+				if existing == nil {
+					return fmt.Errorf("%s was not found after being added", id)
+				}
+				existing.BindingType = pointer.To(containerapps.BindingTypeSniEnabled)
+				existing.CertificateId = pointer.To(certificateId.ID())
+
+				if err := client.CreateOrUpdateThenPoll(ctx, *appId, *app.Model); err != nil {
+					return fmt.Errorf("binding %s to %s: %+v", certificateId, id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+			certificatesClient := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := parse.ContainerAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			appId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroup, id.ContainerAppName)
+
+			app, err := client.Get(ctx, appId)
+			if err != nil {
+				if response.WasNotFound(app.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", appId, err)
+			}
+
+			var customDomain *containerapps.CustomDomain
+			state := ContainerAppCustomDomainModel{
+				Name:           id.CustomDomainName,
+				ContainerAppId: appId.ID(),
+			}
+
+			if model := app.Model; model != nil && model.Properties != nil {
+				state.CustomDomainVerificationId = pointer.From(model.Properties.CustomDomainVerificationId)
+				if config := model.Properties.Configuration; config != nil && config.Ingress != nil {
+					customDomain = findContainerAppCustomDomain(config.Ingress.CustomDomains, id.CustomDomainName)
+				}
+			}
+
+			if customDomain == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state.Name = customDomain.Name
+			state.CertificateBindingType = string(pointer.From(customDomain.BindingType))
+
+			if certificateId := pointer.From(customDomain.CertificateId); certificateId != "" {
+				if managedCertificateId, err := managedcertificates.ParseManagedCertificateIDInsensitively(certificateId); err == nil {
+					state.ManagedCertificateId = managedCertificateId.ID()
+
+					certificate, err := certificatesClient.Get(ctx, *managedCertificateId)
+					if err != nil && !response.WasNotFound(certificate.HttpResponse) {
+						return fmt.Errorf("retrieving %s for %s: %+v", *managedCertificateId, id, err)
+					}
+					if certificate.Model != nil && certificate.Model.Properties != nil {
+						state.ManagedCertificateValidation = string(pointer.From(certificate.Model.Properties.DomainControlValidation))
+					}
+				} else {
+					environmentCertificateId, err := managedenvironments.ParseCertificateIDInsensitively(certificateId)
+					if err != nil {
+						return err
+					}
+					state.CertificateId = environmentCertificateId.ID()
+				}
+			} else {
+				// the domain is bound without a certificate whilst a Managed Certificate is being issued
+				state.ManagedCertificateValidation = metadata.ResourceData.Get("managed_certificate_domain_control_validation").(string)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppCustomDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.ContainerAppClient
+			certificatesClient := metadata.Client.ContainerApps.ManagedCertificatesClient
+
+			id, err := parse.ContainerAppCustomDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ContainerAppCustomDomainModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			appId := containerapps.NewContainerAppID(id.SubscriptionId, id.ResourceGroup, id.ContainerAppName)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			app, err := client.Get(ctx, appId)
+			if err != nil {
+				if response.WasNotFound(app.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", appId, err)
+			}
+
+			ingress, err := containerAppIngressForCustomDomain(app.Model, appId)
+			if err != nil {
+				return err
+			}
+
+			domains := make([]containerapps.CustomDomain, 0)
+			for _, v := range pointer.From(ingress.CustomDomains) {
+				if !strings.EqualFold(v.Name, id.CustomDomainName) {
+					domains = append(domains, v)
+				}
+			}
+			ingress.CustomDomains = &domains
+
+			if err := client.CreateOrUpdateThenPoll(ctx, appId, *app.Model); err != nil {
+				return fmt.Errorf("removing %s: %+v", *id, err)
+			}
+
+			if state.ManagedCertificateValidation != "" {
+				envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(app.Model.Properties.ManagedEnvironmentId))
+				if err != nil {
+					return err
+				}
+				certificateId := managedcertificates.NewManagedCertificateID(envId.SubscriptionId, envId.ResourceGroupName, envId.ManagedEnvironmentName, containerAppManagedCertificateName(id.ContainerAppName, id.CustomDomainName))
+
+				if resp, err := certificatesClient.Delete(ctx, certificateId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s for %s: %+v", certificateId, *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func containerAppIngressForCustomDomain(model *containerapps.ContainerApp, id containerapps.ContainerAppId) (*containerapps.Ingress, error) {
+	if model == nil || model.Properties == nil || model.Properties.Configuration == nil {
+		return nil, fmt.Errorf("retrieving %s: configuration was nil", id)
+	}
+
+	if model.Properties.Configuration.Ingress == nil {
+		return nil, fmt.Errorf("%s must have `ingress` configured before a Custom Domain can be bound", id)
+	}
+
+	return model.Properties.Configuration.Ingress, nil
+}
+
+func findContainerAppCustomDomain(input *[]containerapps.CustomDomain, name string) *containerapps.CustomDomain {
+	if input == nil {
+		return nil
+	}
+
+	for i, v := range *input {
+		if strings.EqualFold(v.Name, name) {
+			return &(*input)[i]
+		}
+	}
+
+	return nil
+}
+
+// containerAppManagedCertificateName returns a name for the Managed Certificate of a Custom Domain which is unique
+// within the Container App Environment, since a Managed Certificate cannot be shared between Container Apps
+func containerAppManagedCertificateName(appName, hostname string) string {
+	name := fmt.Sprintf("mc-%s-%s", appName, strings.ReplaceAll(strings.ToLower(hostname), ".", "-"))
+	if len(name) > 64 {
+		name = name[:64]
+	}
+
+	return strings.TrimRight(name, "-")
+}
+
+// waitForContainerAppCustomDomainVerification waits for the DNS records proving ownership of the hostname to be
+// resolvable by the service, since they're commonly created in the same apply as the Custom Domain
+func waitForContainerAppCustomDomainVerification(ctx context.Context, client *containerapps.ContainerAppsClient, id containerapps.ContainerAppId, hostname, verificationId string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	return pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+		resp, err := client.ListCustomHostNameAnalysis(ctx, id, containerapps.ListCustomHostNameAnalysisOperationOptions{
+			CustomHostname: pointer.To(hostname),
+		})
+		if err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("analysing hostname %q: %+v", hostname, err))
+		}
+
+		result := resp.Model
+		if result == nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("analysing hostname %q: model was nil", hostname))
+		}
+
+		if pointer.From(result.HasConflictOnManagedEnvironment) || pointer.From(result.ConflictWithEnvironmentCustomDomain) {
+			return pluginsdk.NonRetryableError(fmt.Errorf("hostname %q is already in use within the Container App Environment %s", hostname, pointer.From(result.ConflictingContainerAppResourceId)))
+		}
+
+		if pointer.From(result.IsHostnameAlreadyVerified) || pointer.From(result.CustomDomainVerificationTest) == containerapps.DnsVerificationTestResultPassed {
+			return nil
+		}
+
+		reason := "the DNS records have not propagated"
+		if info := result.CustomDomainVerificationFailureInfo; info != nil && info.Message != nil {
+			reason = *info.Message
+		}
+
+		log.Printf("[DEBUG] Waiting for ownership of hostname %q to be verified: %s", hostname, reason)
+		return pluginsdk.RetryableError(fmt.Errorf("ownership of hostname %q could not be verified (%s) - ensure a TXT record `asuid.%s` exists with the value of `custom_domain_verification_id` (%s), along with a CNAME or A record pointing at the Container App", hostname, reason, hostname, verificationId))
+	})
+}
+
+func waitForContainerAppManagedCertificate(ctx context.Context, client *managedcertificates.ManagedCertificatesClient, id managedcertificates.ManagedCertificateId) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      []string{string(managedcertificates.CertificateProvisioningStatePending)},
+		Target:       []string{string(managedcertificates.CertificateProvisioningStateSucceeded)},
+		Refresh:      containerAppManagedCertificateRefreshFunc(ctx, client, id),
+		PollInterval: 30 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func containerAppManagedCertificateRefreshFunc(ctx context.Context, client *managedcertificates.ManagedCertificatesClient, id managedcertificates.ManagedCertificateId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return nil, "", fmt.Errorf("retrieving %s: properties were nil", id)
+		}
+
+		props := resp.Model.Properties
+		state := pointer.From(props.ProvisioningState)
+		switch state {
+		case managedcertificates.CertificateProvisioningStateFailed, managedcertificates.CertificateProvisioningStateCanceled:
+			return resp, string(state), fmt.Errorf("certificate provisioning %s: %s", strings.ToLower(string(state)), pointer.From(props.Error))
+		case managedcertificates.CertificateProvisioningStatePending:
+			if token := pointer.From(props.ValidationToken); token != "" {
+				log.Printf("[DEBUG] %s is waiting for domain control validation using the token %q", id, token)
+			}
+		}
+
+		return resp, string(state), nil
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppJobDataSource struct{}
+
+type ContainerAppJobDataSourceModel struct {
+	Name                    string                                     `tfschema:"name"`
+	ResourceGroup           string                                     `tfschema:"resource_group_name"`
+	ManagedEnvironmentId    string                                     `tfschema:"container_app_environment_id"`
+	Location                string                                     `tfschema:"location"`
+	ReplicaTimeoutInSeconds int                                        `tfschema:"replica_timeout_in_seconds"`
+	ReplicaRetryLimit       int                                        `tfschema:"replica_retry_limit"`
+	ManualTriggerConfig     []helpers.JobManualTriggerConfiguration    `tfschema:"manual_trigger_config"`
+	ScheduleTriggerConfig   []helpers.JobScheduleTriggerConfiguration  `tfschema:"schedule_trigger_config"`
+	EventTriggerConfig      []helpers.JobEventTriggerConfiguration     `tfschema:"event_trigger_config"`
+	Registries              []helpers.Registry                         `tfschema:"registry"`
+	Secrets                 []helpers.Secret                           `tfschema:"secret"`
+	Template                []helpers.JobTemplate                      `tfschema:"template"`
+	Identity                []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	WorkloadProfileName     string                                     `tfschema:"workload_profile_name"`
+	Tags                    map[string]interface{}                     `tfschema:"tags"`
+	OutboundIpAddresses     []string                                   `tfschema:"outbound_ip_addresses"`
+	EventStreamEndpoint     string                                     `tfschema:"event_stream_endpoint"`
+}
+
+var _ sdk.DataSource = ContainerAppJobDataSource{}
+
+func (r ContainerAppJobDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+	}
+}
+
+func (r ContainerAppJobDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_app_environment_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"location": commonschema.LocationComputed(),
+
+		"replica_timeout_in_seconds": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"replica_retry_limit": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"manual_trigger_config": helpers.ContainerAppJobManualTriggerConfigSchemaComputed(),
+
+		"schedule_trigger_config": helpers.ContainerAppJobScheduleTriggerConfigSchemaComputed(),
+
+		"event_trigger_config": helpers.ContainerAppJobEventTriggerConfigSchemaComputed(),
+
+		"template": helpers.ContainerAppJobTemplateSchemaComputed(),
+
+		"registry": helpers.ContainerAppRegistrySchemaComputed(),
+
+		"secret": helpers.SecretsDataSourceSchema(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
+
+		"workload_profile_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tags": commonschema.TagsDataSource(),
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"event_stream_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint for the Event Stream of the Container App Job.",
+		},
+	}
+}
+
+func (r ContainerAppJobDataSource) ModelObject() interface{} {
+	return &ContainerAppJobDataSourceModel{}
+}
+
+func (r ContainerAppJobDataSource) ResourceType() string {
+	return "azurerm_container_app_job"
+}
+
+func (r ContainerAppJobDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var job ContainerAppJobDataSourceModel
+			if err := metadata.Decode(&job); err != nil {
+				return err
+			}
+
+			id := jobs.NewJobID(subscriptionId, job.ResourceGroup, job.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			job.Name = id.JobName
+			job.ResourceGroup = id.ResourceGroupName
+
+			if model := existing.Model; model != nil {
+				job.Location = location.Normalize(model.Location)
+				job.Tags = tags.Flatten(model.Tags)
+
+				ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(pointer.From(model.Identity))))
+				if err != nil {
+					return err
+				}
+				job.Identity = pointer.From(ident)
+
+				if props := model.Properties; props != nil {
+					envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(props.EnvironmentId))
+					if err != nil {
+						return err
+					}
+					job.ManagedEnvironmentId = envId.ID()
+					job.Template = helpers.FlattenContainerAppJobTemplate(props.Template)
+
+					if config := props.Configuration; config != nil {
+						job.ReplicaTimeoutInSeconds = int(config.ReplicaTimeout)
+						job.ReplicaRetryLimit = int(pointer.From(config.ReplicaRetryLimit))
+						job.ManualTriggerConfig = helpers.FlattenContainerAppJobManualTriggerConfig(config.ManualTriggerConfig)
+						job.ScheduleTriggerConfig = helpers.FlattenContainerAppJobScheduleTriggerConfig(config.ScheduleTriggerConfig)
+						job.EventTriggerConfig = helpers.FlattenContainerAppJobEventTriggerConfig(config.EventTriggerConfig)
+						job.Registries = helpers.FlattenContainerAppJobRegistries(config.Registries)
+					}
+
+					job.OutboundIpAddresses = pointer.From(props.OutboundIPAddresses)
+					job.EventStreamEndpoint = pointer.From(props.EventStreamEndpoint)
+					job.WorkloadProfileName = pointer.From(props.WorkloadProfileName)
+				}
+			}
+
+			secretsResp, err := client.ListSecrets(ctx, id)
+			if err != nil {
+				if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+					return fmt.Errorf("listing secrets for %s: %+v", id, err)
+				}
+			}
+
+			job.Secrets = helpers.FlattenContainerAppJobSecrets(secretsResp.Model)
+			metadata.SetID(id)
+
+			return metadata.Encode(&job)
+		},
+	}
+}
@@ -0,0 +1,417 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppJobResource struct{}
+
+type ContainerAppJobModel struct {
+	Name                 string `tfschema:"name"`
+	ResourceGroup        string `tfschema:"resource_group_name"`
+	ManagedEnvironmentId string `tfschema:"container_app_environment_id"`
+	Location             string `tfschema:"location"`
+
+	ReplicaTimeoutInSeconds int                                       `tfschema:"replica_timeout_in_seconds"`
+	ReplicaRetryLimit       int                                       `tfschema:"replica_retry_limit"`
+	ManualTriggerConfig     []helpers.JobManualTriggerConfiguration   `tfschema:"manual_trigger_config"`
+	ScheduleTriggerConfig   []helpers.JobScheduleTriggerConfiguration `tfschema:"schedule_trigger_config"`
+	EventTriggerConfig      []helpers.JobEventTriggerConfiguration    `tfschema:"event_trigger_config"`
+	Registries              []helpers.Registry                        `tfschema:"registry"`
+	Secrets                 []helpers.Secret                          `tfschema:"secret"`
+	Template                []helpers.JobTemplate                     `tfschema:"template"`
+
+	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+	WorkloadProfileName string                                     `tfschema:"workload_profile_name"`
+	Tags                map[string]interface{}                     `tfschema:"tags"`
+
+	OutboundIpAddresses []string `tfschema:"outbound_ip_addresses"`
+	EventStreamEndpoint string   `tfschema:"event_stream_endpoint"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppJobResource{}
+
+func (r ContainerAppJobResource) ModelObject() interface{} {
+	return &ContainerAppJobModel{}
+}
+
+func (r ContainerAppJobResource) ResourceType() string {
+	return "azurerm_container_app_job"
+}
+
+func (r ContainerAppJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobs.ValidateJobID
+}
+
+func (r ContainerAppJobResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ContainerAppName,
+			Description:  "The name for this Container App Job.",
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The ID of the Container App Environment to host this Container App Job.",
+		},
+
+		"replica_timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "The maximum number of seconds a replica of the Container App Job is allowed to run.",
+		},
+
+		"replica_retry_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "The maximum number of times a replica of the Container App Job is retried before failing.",
+		},
+
+		"manual_trigger_config": helpers.ContainerAppJobManualTriggerConfigSchema(),
+
+		"schedule_trigger_config": helpers.ContainerAppJobScheduleTriggerConfigSchema(),
+
+		"event_trigger_config": helpers.ContainerAppJobEventTriggerConfigSchema(),
+
+		"template": helpers.ContainerAppJobTemplateSchema(),
+
+		"registry": helpers.ContainerAppRegistrySchema(),
+
+		"secret": helpers.SecretsSchema(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"workload_profile_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerAppJobResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"outbound_ip_addresses": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"event_stream_endpoint": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The endpoint for the Event Stream of the Container App Job.",
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobClient
+			environmentClient := metadata.Client.ContainerApps.ManagedEnvironmentClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var job ContainerAppJobModel
+			if err := metadata.Decode(&job); err != nil {
+				return err
+			}
+
+			id := jobs.NewJobID(subscriptionId, job.ResourceGroup, job.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			envId, err := managedenvironments.ParseManagedEnvironmentID(job.ManagedEnvironmentId)
+			if err != nil {
+				return fmt.Errorf("parsing Container App Environment ID for %s: %+v", id, err)
+			}
+
+			env, err := environmentClient.Get(ctx, *envId)
+			if err != nil {
+				return fmt.Errorf("reading %s for %s: %+v", *envId, id, err)
+			}
+
+			registries, err := helpers.ExpandContainerAppJobRegistries(job.Registries)
+			if err != nil {
+				return fmt.Errorf("invalid registry config for %s: %+v", id, err)
+			}
+
+			containerAppJob := jobs.Job{
+				Location: location.Normalize(env.Model.Location),
+				Properties: &jobs.JobProperties{
+					Configuration: &jobs.JobConfiguration{
+						ReplicaRetryLimit: pointer.To(int64(job.ReplicaRetryLimit)),
+						ReplicaTimeout:    int64(job.ReplicaTimeoutInSeconds),
+						Registries:        registries,
+						Secrets:           helpers.ExpandContainerAppJobSecrets(job.Secrets),
+					},
+					EnvironmentId: pointer.To(job.ManagedEnvironmentId),
+					Template:      helpers.ExpandContainerAppJobTemplate(job.Template),
+				},
+				Tags: tags.Expand(job.Tags),
+			}
+			expandContainerAppJobTriggerConfig(job, containerAppJob.Properties.Configuration)
+
+			if job.WorkloadProfileName != "" {
+				containerAppJob.Properties.WorkloadProfileName = pointer.To(job.WorkloadProfileName)
+			}
+
+			ident, err := identity.ExpandSystemAndUserAssignedMapFromModel(job.Identity)
+			if err != nil {
+				return err
+			}
+			containerAppJob.Identity = pointer.To(identity.LegacySystemAndUserAssignedMap(*ident))
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, containerAppJob); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			var state ContainerAppJobModel
+
+			state.Name = id.JobName
+			state.ResourceGroup = id.ResourceGroupName
+
+			if model := existing.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(pointer.From(model.Identity))))
+				if err != nil {
+					return err
+				}
+				state.Identity = pointer.From(ident)
+
+				if props := model.Properties; props != nil {
+					envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(props.EnvironmentId))
+					if err != nil {
+						return err
+					}
+					state.ManagedEnvironmentId = envId.ID()
+					state.Template = helpers.FlattenContainerAppJobTemplate(props.Template)
+
+					if config := props.Configuration; config != nil {
+						state.ReplicaTimeoutInSeconds = int(config.ReplicaTimeout)
+						state.ReplicaRetryLimit = int(pointer.From(config.ReplicaRetryLimit))
+						state.ManualTriggerConfig = helpers.FlattenContainerAppJobManualTriggerConfig(config.ManualTriggerConfig)
+						state.ScheduleTriggerConfig = helpers.FlattenContainerAppJobScheduleTriggerConfig(config.ScheduleTriggerConfig)
+						state.EventTriggerConfig = helpers.FlattenContainerAppJobEventTriggerConfig(config.EventTriggerConfig)
+						state.Registries = helpers.FlattenContainerAppJobRegistries(config.Registries)
+					}
+
+					state.OutboundIpAddresses = pointer.From(props.OutboundIPAddresses)
+					state.EventStreamEndpoint = pointer.From(props.EventStreamEndpoint)
+					state.WorkloadProfileName = pointer.From(props.WorkloadProfileName)
+				}
+			}
+
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil {
+				if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+					return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
+				}
+			}
+
+			state.Secrets = helpers.FlattenContainerAppJobSecrets(secretsResp.Model)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppJobResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JobClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ContainerAppJobModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", *id, err)
+			}
+
+			model := existing.Model
+			if model == nil || model.Properties == nil {
+				return fmt.Errorf("retrieving properties for %s for update: model was nil", *id)
+			}
+
+			if model.Properties.Configuration == nil {
+				model.Properties.Configuration = &jobs.JobConfiguration{}
+			}
+			config := model.Properties.Configuration
+
+			// Delta-updates need the secrets back from the list API, or we'll end up removing them or erroring out.
+			secretsResp, err := client.ListSecrets(ctx, *id)
+			if err != nil || secretsResp.Model == nil {
+				if !response.WasStatusCode(secretsResp.HttpResponse, http.StatusNoContent) {
+					return fmt.Errorf("retrieving secrets for update for %s: %+v", *id, err)
+				}
+			}
+			config.Secrets = helpers.UnpackContainerAppJobSecretsCollection(secretsResp.Model)
+
+			if metadata.ResourceData.HasChange("replica_timeout_in_seconds") {
+				config.ReplicaTimeout = int64(state.ReplicaTimeoutInSeconds)
+			}
+
+			if metadata.ResourceData.HasChange("replica_retry_limit") {
+				config.ReplicaRetryLimit = pointer.To(int64(state.ReplicaRetryLimit))
+			}
+
+			if metadata.ResourceData.HasChanges("manual_trigger_config", "schedule_trigger_config", "event_trigger_config") {
+				expandContainerAppJobTriggerConfig(state, config)
+			}
+
+			if metadata.ResourceData.HasChange("registry") {
+				config.Registries, err = helpers.ExpandContainerAppJobRegistries(state.Registries)
+				if err != nil {
+					return fmt.Errorf("invalid registry config for %s: %+v", id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("secret") {
+				config.Secrets = helpers.ExpandContainerAppJobSecrets(state.Secrets)
+			}
+
+			if metadata.ResourceData.HasChange("template") {
+				model.Properties.Template = helpers.ExpandContainerAppJobTemplate(state.Template)
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				ident, err := identity.ExpandSystemAndUserAssignedMapFromModel(state.Identity)
+				if err != nil {
+					return err
+				}
+				model.Identity = pointer.To(identity.LegacySystemAndUserAssignedMap(*ident))
+			}
+
+			if metadata.ResourceData.HasChange("workload_profile_name") {
+				model.Properties.WorkloadProfileName = pointer.To(state.WorkloadProfileName)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				model.Tags = tags.Expand(state.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppJobTriggerConfig(input ContainerAppJobModel, config *jobs.JobConfiguration) {
+	config.ManualTriggerConfig = helpers.ExpandContainerAppJobManualTriggerConfig(input.ManualTriggerConfig)
+	config.ScheduleTriggerConfig = helpers.ExpandContainerAppJobScheduleTriggerConfig(input.ScheduleTriggerConfig)
+	config.EventTriggerConfig = helpers.ExpandContainerAppJobEventTriggerConfig(input.EventTriggerConfig)
+
+	switch {
+	case config.ScheduleTriggerConfig != nil:
+		config.TriggerType = jobs.TriggerTypeSchedule
+	case config.EventTriggerConfig != nil:
+		config.TriggerType = jobs.TriggerTypeEvent
+	default:
+		config.TriggerType = jobs.TriggerTypeManual
+	}
+}
@@ -0,0 +1,901 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// NOTE: the Container App Job API uses its own (structurally identical) copies of the Container, Volume, Registry and
+// Secret models - the schemas and expand/flatten functions for Container Apps are reused and the results converted,
+// so that Jobs and Apps share the same container definitions.
+
+type JobTemplate struct {
+	Containers     []Container       `tfschema:"container"`
+	InitContainers []BaseContainer   `tfschema:"init_container"`
+	Volumes        []ContainerVolume `tfschema:"volume"`
+}
+
+func ContainerAppJobTemplateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		MaxItems: 1,
+		Required: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container": ContainerAppContainerSchema(),
+
+				"init_container": InitContainerAppContainerSchema(),
+
+				"volume": ContainerVolumeSchema(),
+			},
+		},
+	}
+}
+
+func ContainerAppJobTemplateSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"container": ContainerAppContainerSchemaComputed(),
+
+				"init_container": InitContainerAppContainerSchemaComputed(),
+
+				"volume": ContainerVolumeSchemaComputed(),
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobTemplate(input []JobTemplate) *jobs.JobTemplate {
+	if len(input) != 1 {
+		return nil
+	}
+
+	config := input[0]
+	return &jobs.JobTemplate{
+		Containers:     convertContainerAppContainersToJob(expandContainerAppContainers(config.Containers)),
+		InitContainers: convertContainerAppInitContainersToJob(expandInitContainerAppContainers(config.InitContainers)),
+		Volumes:        convertContainerAppVolumesToJob(expandContainerAppVolumes(config.Volumes)),
+	}
+}
+
+func FlattenContainerAppJobTemplate(input *jobs.JobTemplate) []JobTemplate {
+	if input == nil {
+		return []JobTemplate{}
+	}
+
+	return []JobTemplate{
+		{
+			Containers:     flattenContainerAppContainers(convertJobContainersToContainerApp(input.Containers)),
+			InitContainers: flattenInitContainerAppContainers(convertJobInitContainersToContainerApp(input.InitContainers)),
+			Volumes:        flattenContainerAppVolumes(convertJobVolumesToContainerApp(input.Volumes)),
+		},
+	}
+}
+
+func ExpandContainerAppJobRegistries(input []Registry) (*[]jobs.RegistryCredentials, error) {
+	registries, err := ExpandContainerAppRegistries(input)
+	if err != nil || registries == nil {
+		return nil, err
+	}
+
+	result := make([]jobs.RegistryCredentials, 0)
+	for _, v := range *registries {
+		result = append(result, jobs.RegistryCredentials(v))
+	}
+
+	return &result, nil
+}
+
+func FlattenContainerAppJobRegistries(input *[]jobs.RegistryCredentials) []Registry {
+	if input == nil {
+		return []Registry{}
+	}
+
+	registries := make([]containerapps.RegistryCredentials, 0)
+	for _, v := range *input {
+		registries = append(registries, containerapps.RegistryCredentials(v))
+	}
+
+	return FlattenContainerAppRegistries(&registries)
+}
+
+func ExpandContainerAppJobSecrets(input []Secret) *[]jobs.Secret {
+	secrets := ExpandContainerSecrets(input)
+	if secrets == nil {
+		return nil
+	}
+
+	result := make([]jobs.Secret, 0)
+	for _, v := range *secrets {
+		result = append(result, jobs.Secret(v))
+	}
+
+	return &result
+}
+
+func UnpackContainerAppJobSecretsCollection(input *jobs.JobSecretsCollection) *[]jobs.Secret {
+	if input == nil || len(input.Value) == 0 {
+		return nil
+	}
+
+	return pointer.To(input.Value)
+}
+
+func FlattenContainerAppJobSecrets(input *jobs.JobSecretsCollection) []Secret {
+	if input == nil || input.Value == nil {
+		return []Secret{}
+	}
+
+	result := make([]Secret, 0)
+	for _, v := range input.Value {
+		result = append(result, Secret{
+			Name:  pointer.From(v.Name),
+			Value: pointer.From(v.Value),
+		})
+	}
+
+	return result
+}
+
+type JobManualTriggerConfiguration struct {
+	Parallelism            int `tfschema:"parallelism"`
+	ReplicaCompletionCount int `tfschema:"replica_completion_count"`
+}
+
+type JobScheduleTriggerConfiguration struct {
+	CronExpression         string `tfschema:"cron_expression"`
+	Parallelism            int    `tfschema:"parallelism"`
+	ReplicaCompletionCount int    `tfschema:"replica_completion_count"`
+}
+
+type JobEventTriggerConfiguration struct {
+	Parallelism            int        `tfschema:"parallelism"`
+	ReplicaCompletionCount int        `tfschema:"replica_completion_count"`
+	Scale                  []JobScale `tfschema:"scale"`
+}
+
+type JobScale struct {
+	MaxExecutions          int            `tfschema:"max_executions"`
+	MinExecutions          int            `tfschema:"min_executions"`
+	PollingIntervalSeconds int            `tfschema:"polling_interval_in_seconds"`
+	Rules                  []JobScaleRule `tfschema:"rules"`
+}
+
+type JobScaleRule struct {
+	Name            string                    `tfschema:"name"`
+	CustomRuleType  string                    `tfschema:"custom_rule_type"`
+	Metadata        map[string]string         `tfschema:"metadata"`
+	Authentications []ScaleRuleAuthentication `tfschema:"authentication"`
+}
+
+func containerAppJobParallelismSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The number of replicas of the Container App Job which can run in parallel.",
+	}
+}
+
+func containerAppJobReplicaCompletionCountSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "The number of replicas which must complete successfully for an execution of the Container App Job to succeed.",
+	}
+}
+
+func ContainerAppJobManualTriggerConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"manual_trigger_config", "schedule_trigger_config", "event_trigger_config"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": containerAppJobParallelismSchema(),
+
+				"replica_completion_count": containerAppJobReplicaCompletionCountSchema(),
+			},
+		},
+	}
+}
+
+func ContainerAppJobScheduleTriggerConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"manual_trigger_config", "schedule_trigger_config", "event_trigger_config"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cron_expression": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The cron expression (in UTC) which determines when the Container App Job is run.",
+				},
+
+				"parallelism": containerAppJobParallelismSchema(),
+
+				"replica_completion_count": containerAppJobReplicaCompletionCountSchema(),
+			},
+		},
+	}
+}
+
+func ContainerAppJobEventTriggerConfigSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"manual_trigger_config", "schedule_trigger_config", "event_trigger_config"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": containerAppJobParallelismSchema(),
+
+				"replica_completion_count": containerAppJobReplicaCompletionCountSchema(),
+
+				"scale": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"max_executions": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      100,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The maximum number of executions of the Container App Job which can be created for a polling interval.",
+							},
+
+							"min_executions": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      0,
+								ValidateFunc: validation.IntAtLeast(0),
+								Description:  "The minimum number of executions of the Container App Job which are created for a polling interval.",
+							},
+
+							"polling_interval_in_seconds": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								Default:      30,
+								ValidateFunc: validation.IntAtLeast(1),
+								Description:  "The interval, in seconds, at which each event source is checked.",
+							},
+
+							"rules": {
+								Type:     pluginsdk.TypeList,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"name": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+
+										"custom_rule_type": {
+											Type:         pluginsdk.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+											Description:  "The type of the KEDA scaler used by this rule, for example `azure-servicebus`.",
+										},
+
+										"metadata": {
+											Type:     pluginsdk.TypeMap,
+											Required: true,
+											Elem: &pluginsdk.Schema{
+												Type: pluginsdk.TypeString,
+											},
+										},
+
+										"authentication": {
+											Type:     pluginsdk.TypeList,
+											Optional: true,
+											Elem: &pluginsdk.Resource{
+												Schema: map[string]*pluginsdk.Schema{
+													"secret_name": {
+														Type:         pluginsdk.TypeString,
+														Required:     true,
+														ValidateFunc: validate.SecretName,
+													},
+
+													"trigger_parameter": {
+														Type:         pluginsdk.TypeString,
+														Required:     true,
+														ValidateFunc: validation.StringIsNotEmpty,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ContainerAppJobManualTriggerConfigSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"replica_completion_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ContainerAppJobScheduleTriggerConfigSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"cron_expression": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"parallelism": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"replica_completion_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ContainerAppJobEventTriggerConfigSchemaComputed() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"parallelism": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"replica_completion_count": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"scale": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"max_executions": {
+								Type:     pluginsdk.TypeInt,
+								Computed: true,
+							},
+
+							"min_executions": {
+								Type:     pluginsdk.TypeInt,
+								Computed: true,
+							},
+
+							"polling_interval_in_seconds": {
+								Type:     pluginsdk.TypeInt,
+								Computed: true,
+							},
+
+							"rules": {
+								Type:     pluginsdk.TypeList,
+								Computed: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"name": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+
+										"custom_rule_type": {
+											Type:     pluginsdk.TypeString,
+											Computed: true,
+										},
+
+										"metadata": {
+											Type:     pluginsdk.TypeMap,
+											Computed: true,
+											Elem: &pluginsdk.Schema{
+												Type: pluginsdk.TypeString,
+											},
+										},
+
+										"authentication": {
+											Type:     pluginsdk.TypeList,
+											Computed: true,
+											Elem: &pluginsdk.Resource{
+												Schema: map[string]*pluginsdk.Schema{
+													"secret_name": {
+														Type:     pluginsdk.TypeString,
+														Computed: true,
+													},
+
+													"trigger_parameter": {
+														Type:     pluginsdk.TypeString,
+														Computed: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandContainerAppJobManualTriggerConfig(input []JobManualTriggerConfiguration) *jobs.JobConfigurationManualTriggerConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &jobs.JobConfigurationManualTriggerConfig{
+		Parallelism:            pointer.To(int64(input[0].Parallelism)),
+		ReplicaCompletionCount: pointer.To(int64(input[0].ReplicaCompletionCount)),
+	}
+}
+
+func FlattenContainerAppJobManualTriggerConfig(input *jobs.JobConfigurationManualTriggerConfig) []JobManualTriggerConfiguration {
+	if input == nil {
+		return []JobManualTriggerConfiguration{}
+	}
+
+	return []JobManualTriggerConfiguration{
+		{
+			Parallelism:            int(pointer.From(input.Parallelism)),
+			ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		},
+	}
+}
+
+func ExpandContainerAppJobScheduleTriggerConfig(input []JobScheduleTriggerConfiguration) *jobs.JobConfigurationScheduleTriggerConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &jobs.JobConfigurationScheduleTriggerConfig{
+		CronExpression:         input[0].CronExpression,
+		Parallelism:            pointer.To(int64(input[0].Parallelism)),
+		ReplicaCompletionCount: pointer.To(int64(input[0].ReplicaCompletionCount)),
+	}
+}
+
+func FlattenContainerAppJobScheduleTriggerConfig(input *jobs.JobConfigurationScheduleTriggerConfig) []JobScheduleTriggerConfiguration {
+	if input == nil {
+		return []JobScheduleTriggerConfiguration{}
+	}
+
+	return []JobScheduleTriggerConfiguration{
+		{
+			CronExpression:         input.CronExpression,
+			Parallelism:            int(pointer.From(input.Parallelism)),
+			ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		},
+	}
+}
+
+func ExpandContainerAppJobEventTriggerConfig(input []JobEventTriggerConfiguration) *jobs.JobConfigurationEventTriggerConfig {
+	if len(input) == 0 {
+		return nil
+	}
+
+	config := input[0]
+	result := &jobs.JobConfigurationEventTriggerConfig{
+		Parallelism:            pointer.To(int64(config.Parallelism)),
+		ReplicaCompletionCount: pointer.To(int64(config.ReplicaCompletionCount)),
+	}
+
+	if len(config.Scale) == 1 {
+		scale := config.Scale[0]
+		rules := make([]jobs.JobScaleRule, 0)
+		for _, v := range scale.Rules {
+			auths := make([]jobs.ScaleRuleAuth, 0)
+			for _, a := range v.Authentications {
+				auths = append(auths, jobs.ScaleRuleAuth{
+					SecretRef:        pointer.To(a.SecretRef),
+					TriggerParameter: pointer.To(a.TriggerParam),
+				})
+			}
+
+			var metadata interface{} = v.Metadata
+			rules = append(rules, jobs.JobScaleRule{
+				Auth:     pointer.To(auths),
+				Metadata: pointer.To(metadata),
+				Name:     pointer.To(v.Name),
+				Type:     pointer.To(v.CustomRuleType),
+			})
+		}
+
+		result.Scale = &jobs.JobScale{
+			MaxExecutions:   pointer.To(int64(scale.MaxExecutions)),
+			MinExecutions:   pointer.To(int64(scale.MinExecutions)),
+			PollingInterval: pointer.To(int64(scale.PollingIntervalSeconds)),
+			Rules:           pointer.To(rules),
+		}
+	}
+
+	return result
+}
+
+func FlattenContainerAppJobEventTriggerConfig(input *jobs.JobConfigurationEventTriggerConfig) []JobEventTriggerConfiguration {
+	if input == nil {
+		return []JobEventTriggerConfiguration{}
+	}
+
+	result := JobEventTriggerConfiguration{
+		Parallelism:            int(pointer.From(input.Parallelism)),
+		ReplicaCompletionCount: int(pointer.From(input.ReplicaCompletionCount)),
+		Scale:                  []JobScale{},
+	}
+
+	if scale := input.Scale; scale != nil {
+		rules := make([]JobScaleRule, 0)
+		if scale.Rules != nil {
+			for _, v := range *scale.Rules {
+				authentications := make([]ScaleRuleAuthentication, 0)
+				if v.Auth != nil {
+					for _, a := range *v.Auth {
+						authentications = append(authentications, ScaleRuleAuthentication{
+							SecretRef:    pointer.From(a.SecretRef),
+							TriggerParam: pointer.From(a.TriggerParameter),
+						})
+					}
+				}
+
+				metadata := make(map[string]string)
+				if v.Metadata != nil {
+					if raw, ok := (*v.Metadata).(map[string]interface{}); ok {
+						for key, value := range raw {
+							if s, ok := value.(string); ok {
+								metadata[key] = s
+							}
+						}
+					}
+				}
+
+				rules = append(rules, JobScaleRule{
+					Name:            pointer.From(v.Name),
+					CustomRuleType:  pointer.From(v.Type),
+					Metadata:        metadata,
+					Authentications: authentications,
+				})
+			}
+		}
+
+		result.Scale = []JobScale{
+			{
+				MaxExecutions:          int(pointer.From(scale.MaxExecutions)),
+				MinExecutions:          int(pointer.From(scale.MinExecutions)),
+				PollingIntervalSeconds: int(pointer.From(scale.PollingInterval)),
+				Rules:                  rules,
+			},
+		}
+	}
+
+	return []JobEventTriggerConfiguration{result}
+}
+
+func convertContainerAppContainersToJob(input *[]containerapps.Container) *[]jobs.Container {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]jobs.Container, 0)
+	for _, v := range *input {
+		result = append(result, jobs.Container{
+			Args:         v.Args,
+			Command:      v.Command,
+			Env:          convertContainerAppEnvVarsToJob(v.Env),
+			Image:        v.Image,
+			Name:         v.Name,
+			Probes:       convertContainerAppProbesToJob(v.Probes),
+			Resources:    (*jobs.ContainerResources)(v.Resources),
+			VolumeMounts: convertContainerAppVolumeMountsToJob(v.VolumeMounts),
+		})
+	}
+
+	return &result
+}
+
+func convertJobContainersToContainerApp(input *[]jobs.Container) *[]containerapps.Container {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]containerapps.Container, 0)
+	for _, v := range *input {
+		result = append(result, containerapps.Container{
+			Args:         v.Args,
+			Command:      v.Command,
+			Env:          convertJobEnvVarsToContainerApp(v.Env),
+			Image:        v.Image,
+			Name:         v.Name,
+			Probes:       convertJobProbesToContainerApp(v.Probes),
+			Resources:    (*containerapps.ContainerResources)(v.Resources),
+			VolumeMounts: convertJobVolumeMountsToContainerApp(v.VolumeMounts),
+		})
+	}
+
+	return &result
+}
+
+func convertContainerAppInitContainersToJob(input *[]containerapps.BaseContainer) *[]jobs.BaseContainer {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]jobs.BaseContainer, 0)
+	for _, v := range *input {
+		result = append(result, jobs.BaseContainer{
+			Args:         v.Args,
+			Command:      v.Command,
+			Env:          convertContainerAppEnvVarsToJob(v.Env),
+			Image:        v.Image,
+			Name:         v.Name,
+			Resources:    (*jobs.ContainerResources)(v.Resources),
+			VolumeMounts: convertContainerAppVolumeMountsToJob(v.VolumeMounts),
+		})
+	}
+
+	return &result
+}
+
+func convertJobInitContainersToContainerApp(input *[]jobs.BaseContainer) *[]containerapps.BaseContainer {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]containerapps.BaseContainer, 0)
+	for _, v := range *input {
+		result = append(result, containerapps.BaseContainer{
+			Args:         v.Args,
+			Command:      v.Command,
+			Env:          convertJobEnvVarsToContainerApp(v.Env),
+			Image:        v.Image,
+			Name:         v.Name,
+			Resources:    (*containerapps.ContainerResources)(v.Resources),
+			VolumeMounts: convertJobVolumeMountsToContainerApp(v.VolumeMounts),
+		})
+	}
+
+	return &result
+}
+
+func convertContainerAppEnvVarsToJob(input *[]containerapps.EnvironmentVar) *[]jobs.EnvironmentVar {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]jobs.EnvironmentVar, 0)
+	for _, v := range *input {
+		result = append(result, jobs.EnvironmentVar(v))
+	}
+
+	return &result
+}
+
+func convertJobEnvVarsToContainerApp(input *[]jobs.EnvironmentVar) *[]containerapps.EnvironmentVar {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]containerapps.EnvironmentVar, 0)
+	for _, v := range *input {
+		result = append(result, containerapps.EnvironmentVar(v))
+	}
+
+	return &result
+}
+
+func convertContainerAppVolumeMountsToJob(input *[]containerapps.VolumeMount) *[]jobs.VolumeMount {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]jobs.VolumeMount, 0)
+	for _, v := range *input {
+		result = append(result, jobs.VolumeMount(v))
+	}
+
+	return &result
+}
+
+func convertJobVolumeMountsToContainerApp(input *[]jobs.VolumeMount) *[]containerapps.VolumeMount {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]containerapps.VolumeMount, 0)
+	for _, v := range *input {
+		result = append(result, containerapps.VolumeMount(v))
+	}
+
+	return &result
+}
+
+func convertContainerAppVolumesToJob(input *[]containerapps.Volume) *[]jobs.Volume {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]jobs.Volume, 0)
+	for _, v := range *input {
+		volume := jobs.Volume{
+			MountOptions: v.MountOptions,
+			Name:         v.Name,
+			StorageName:  v.StorageName,
+		}
+		if v.StorageType != nil {
+			volume.StorageType = pointer.To(jobs.StorageType(*v.StorageType))
+		}
+		if v.Secrets != nil {
+			secrets := make([]jobs.SecretVolumeItem, 0)
+			for _, s := range *v.Secrets {
+				secrets = append(secrets, jobs.SecretVolumeItem(s))
+			}
+			volume.Secrets = &secrets
+		}
+
+		result = append(result, volume)
+	}
+
+	return &result
+}
+
+func convertJobVolumesToContainerApp(input *[]jobs.Volume) *[]containerapps.Volume {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]containerapps.Volume, 0)
+	for _, v := range *input {
+		volume := containerapps.Volume{
+			MountOptions: v.MountOptions,
+			Name:         v.Name,
+			StorageName:  v.StorageName,
+		}
+		if v.StorageType != nil {
+			volume.StorageType = pointer.To(containerapps.StorageType(*v.StorageType))
+		}
+		if v.Secrets != nil {
+			secrets := make([]containerapps.SecretVolumeItem, 0)
+			for _, s := range *v.Secrets {
+				secrets = append(secrets, containerapps.SecretVolumeItem(s))
+			}
+			volume.Secrets = &secrets
+		}
+
+		result = append(result, volume)
+	}
+
+	return &result
+}
+
+func convertContainerAppProbesToJob(input *[]containerapps.ContainerAppProbe) *[]jobs.ContainerAppProbe {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]jobs.ContainerAppProbe, 0)
+	for _, v := range *input {
+		probe := jobs.ContainerAppProbe{
+			FailureThreshold:              v.FailureThreshold,
+			InitialDelaySeconds:           v.InitialDelaySeconds,
+			PeriodSeconds:                 v.PeriodSeconds,
+			SuccessThreshold:              v.SuccessThreshold,
+			TcpSocket:                     (*jobs.ContainerAppProbeTcpSocket)(v.TcpSocket),
+			TerminationGracePeriodSeconds: v.TerminationGracePeriodSeconds,
+			TimeoutSeconds:                v.TimeoutSeconds,
+		}
+		if v.Type != nil {
+			probe.Type = pointer.To(jobs.Type(*v.Type))
+		}
+		if httpGet := v.HTTPGet; httpGet != nil {
+			probe.HTTPGet = &jobs.ContainerAppProbeHTTPGet{
+				Host: httpGet.Host,
+				Path: httpGet.Path,
+				Port: httpGet.Port,
+			}
+			if httpGet.Scheme != nil {
+				probe.HTTPGet.Scheme = pointer.To(jobs.Scheme(*httpGet.Scheme))
+			}
+			if httpGet.HTTPHeaders != nil {
+				headers := make([]jobs.ContainerAppProbeHTTPGetHTTPHeadersInlined, 0)
+				for _, h := range *httpGet.HTTPHeaders {
+					headers = append(headers, jobs.ContainerAppProbeHTTPGetHTTPHeadersInlined(h))
+				}
+				probe.HTTPGet.HTTPHeaders = &headers
+			}
+		}
+
+		result = append(result, probe)
+	}
+
+	return &result
+}
+
+func convertJobProbesToContainerApp(input *[]jobs.ContainerAppProbe) *[]containerapps.ContainerAppProbe {
+	if input == nil {
+		return nil
+	}
+
+	result := make([]containerapps.ContainerAppProbe, 0)
+	for _, v := range *input {
+		probe := containerapps.ContainerAppProbe{
+			FailureThreshold:              v.FailureThreshold,
+			InitialDelaySeconds:           v.InitialDelaySeconds,
+			PeriodSeconds:                 v.PeriodSeconds,
+			SuccessThreshold:              v.SuccessThreshold,
+			TcpSocket:                     (*containerapps.ContainerAppProbeTcpSocket)(v.TcpSocket),
+			TerminationGracePeriodSeconds: v.TerminationGracePeriodSeconds,
+			TimeoutSeconds:                v.TimeoutSeconds,
+		}
+		if v.Type != nil {
+			probe.Type = pointer.To(containerapps.Type(*v.Type))
+		}
+		if httpGet := v.HTTPGet; httpGet != nil {
+			probe.HTTPGet = &containerapps.ContainerAppProbeHTTPGet{
+				Host: httpGet.Host,
+				Path: httpGet.Path,
+				Port: httpGet.Port,
+			}
+			if httpGet.Scheme != nil {
+				probe.HTTPGet.Scheme = pointer.To(containerapps.Scheme(*httpGet.Scheme))
+			}
+			if httpGet.HTTPHeaders != nil {
+				headers := make([]containerapps.ContainerAppProbeHTTPGetHTTPHeadersInlined, 0)
+				for _, h := range *httpGet.HTTPHeaders {
+					headers = append(headers, containerapps.ContainerAppProbeHTTPGetHTTPHeadersInlined(h))
+				}
+				probe.HTTPGet.HTTPHeaders = &headers
+			}
+		}
+
+		result = append(result, probe)
+	}
+
+	return &result
+}
@@ -389,8 +389,11 @@ func expandContainerAppIngressCustomDomain(input []CustomDomain) *[]containerapp
 	result := make([]containerapps.CustomDomain, 0)
 	for _, v := range input {
 		customDomain := containerapps.CustomDomain{
-			Name:          v.Name,
-			CertificateId: pointer.To(v.CertificateId),
+			Name: v.Name,
+		}
+		// Custom Domains bound using the `azurerm_container_app_custom_domain` resource may not have a Certificate
+		if v.CertificateId != "" {
+			customDomain.CertificateId = pointer.To(v.CertificateId)
 		}
 		bindingType := containerapps.BindingType(v.CertBinding)
 		customDomain.BindingType = &bindingType
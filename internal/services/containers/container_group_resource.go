@@ -87,20 +87,17 @@ func resourceContainerGroup() *pluginsdk.Resource {
 			"image_registry_credential": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"server": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
 						"user_assigned_identity_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
 							Description:  "The User Assigned Identity to use for Container Registry access.",
 						},
@@ -108,7 +105,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 						"username": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
@@ -116,7 +112,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 					},
@@ -222,7 +217,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 			"init_container": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -235,13 +229,11 @@ func resourceContainerGroup() *pluginsdk.Resource {
 						"image": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
 						"environment_variables": {
 							Type:     pluginsdk.TypeMap,
-							ForceNew: true,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
 								Type: pluginsdk.TypeString,
@@ -251,7 +243,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 						"secure_environment_variables": {
 							Type:      pluginsdk.TypeMap,
 							Optional:  true,
-							ForceNew:  true,
 							Sensitive: true,
 							Elem: &pluginsdk.Schema{
 								Type: pluginsdk.TypeString,
@@ -262,7 +253,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
 								ValidateFunc: validation.StringIsNotEmpty,
@@ -278,7 +268,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 			"container": {
 				Type:     pluginsdk.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -291,7 +280,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 						"image": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
@@ -408,7 +396,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 
 						"environment_variables": {
 							Type:     pluginsdk.TypeMap,
-							ForceNew: true,
 							Optional: true,
 							Elem: &pluginsdk.Schema{
 								Type: pluginsdk.TypeString,
@@ -418,7 +405,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 						"secure_environment_variables": {
 							Type:      pluginsdk.TypeMap,
 							Optional:  true,
-							ForceNew:  true,
 							Sensitive: true,
 							Elem: &pluginsdk.Schema{
 								Type: pluginsdk.TypeString,
@@ -429,7 +415,6 @@ func resourceContainerGroup() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
 								ValidateFunc: validation.StringIsNotEmpty,
@@ -574,6 +559,24 @@ func resourceContainerGroup() *pluginsdk.Resource {
 					return fmt.Errorf("`ip_address_type` has to be `None` when `priority` is set to `Spot`")
 				}
 			}
+
+			// the properties of existing containers can be updated in-place by re-deploying the Container Group,
+			// however adding or removing a container requires the Container Group to be recreated
+			if d.Id() != "" {
+				for _, key := range []string{"container", "init_container"} {
+					if !d.HasChange(key) {
+						continue
+					}
+
+					oldRaw, newRaw := d.GetChange(key)
+					if len(oldRaw.([]interface{})) != len(newRaw.([]interface{})) {
+						if err := d.ForceNew(key); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
 			return nil
 		},
 	}
@@ -715,102 +718,13 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	location := location.Normalize(d.Get("location").(string))
-	OSType := d.Get("os_type").(string)
-	IPAddressType := d.Get("ip_address_type").(string)
-	restartPolicy := containerinstance.ContainerGroupRestartPolicy(d.Get("restart_policy").(string))
-	diagnosticsRaw := d.Get("diagnostics").([]interface{})
-	diagnostics := expandContainerGroupDiagnostics(diagnosticsRaw)
-	dnsConfig := d.Get("dns_config").([]interface{})
-	addedEmptyDirs := map[string]bool{}
-	subnets, err := expandContainerGroupSubnets(d.Get("subnet_ids").(*pluginsdk.Set).List())
-	if err != nil {
-		return err
-	}
-
-	zones := zones.ExpandUntyped(d.Get("zones").(*pluginsdk.Set).List())
-	initContainers, initContainerVolumes, err := expandContainerGroupInitContainers(d, addedEmptyDirs)
-	if err != nil {
-		return err
-	}
-
-	containers, containerGroupPorts, containerVolumes, err := expandContainerGroupContainers(d, addedEmptyDirs)
+	containerGroup, err := expandContainerGroup(d, id)
 	if err != nil {
 		return err
 	}
-	var containerGroupVolumes []containerinstance.Volume
-	if initContainerVolumes != nil {
-		containerGroupVolumes = initContainerVolumes
-	}
-	if containerGroupVolumes != nil {
-		containerGroupVolumes = append(containerGroupVolumes, containerVolumes...)
-	}
-
-	containerGroup := containerinstance.ContainerGroup{
-		Name:     pointer.FromString(id.ContainerGroupName),
-		Location: &location,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
-		Properties: containerinstance.ContainerGroupPropertiesProperties{
-			Sku:                      pointer.To(containerinstance.ContainerGroupSku(d.Get("sku").(string))),
-			InitContainers:           initContainers,
-			Containers:               containers,
-			Diagnostics:              diagnostics,
-			RestartPolicy:            &restartPolicy,
-			OsType:                   containerinstance.OperatingSystemTypes(OSType),
-			Volumes:                  &containerGroupVolumes,
-			ImageRegistryCredentials: expandContainerImageRegistryCredentials(d),
-			DnsConfig:                expandContainerGroupDnsConfig(dnsConfig),
-			SubnetIds:                subnets,
-		},
-		Zones: &zones,
-	}
-
-	// Container Groups with OS Type Windows do not support managed identities but the API also does not accept Identity Type: None
-	// https://github.com/Azure/azure-rest-api-specs/issues/18122
-	if OSType != string(containerinstance.OperatingSystemTypesWindows) {
-		expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
-		if err != nil {
-			return fmt.Errorf("expanding `identity`: %+v", err)
-		}
-		containerGroup.Identity = expandedIdentity
-	}
-
-	if IPAddressType != "None" {
-		containerGroup.Properties.IPAddress = &containerinstance.IPAddress{
-			Ports: containerGroupPorts,
-			Type:  containerinstance.ContainerGroupIPAddressType(IPAddressType),
-		}
-
-		if dnsNameLabel := d.Get("dns_name_label").(string); dnsNameLabel != "" {
-			containerGroup.Properties.IPAddress.DnsNameLabel = &dnsNameLabel
-		}
-		if dnsNameLabelReusePolicy := d.Get("dns_name_label_reuse_policy").(string); dnsNameLabelReusePolicy != "" {
-			containerGroup.Properties.IPAddress.AutoGeneratedDomainNameLabelScope = (*containerinstance.DnsNameLabelReusePolicy)(&dnsNameLabelReusePolicy)
-		}
-	}
-
-	if keyVaultKeyId := d.Get("key_vault_key_id").(string); keyVaultKeyId != "" {
-		keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyVaultKeyId)
-		if err != nil {
-			return fmt.Errorf("parsing Key Vault Key ID: %+v", err)
-		}
-		containerGroup.Properties.EncryptionProperties = &containerinstance.EncryptionProperties{
-			VaultBaseUrl: keyId.KeyVaultBaseUrl,
-			KeyName:      keyId.Name,
-			KeyVersion:   keyId.Version,
-		}
-
-		if keyVaultUAI := d.Get("key_vault_user_assigned_identity_id").(string); keyVaultUAI != "" {
-			containerGroup.Properties.EncryptionProperties.Identity = &keyVaultUAI
-		}
-	}
-
-	if priority := d.Get("priority").(string); priority != "" {
-		containerGroup.Properties.Priority = pointer.To(containerinstance.ContainerGroupPriority(priority))
-	}
 
 	// Avoid parallel provisioning if "subnet_ids" are given.
-	if subnets != nil && len(*subnets) != 0 {
+	if subnets := containerGroup.Properties.SubnetIds; subnets != nil && len(*subnets) != 0 {
 		for _, item := range *subnets {
 			subnet, err := commonids.ParseSubnetID(item.Id)
 			if err != nil {
@@ -822,7 +736,7 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if err := client.ContainerGroupsCreateOrUpdateThenPoll(ctx, id, containerGroup); err != nil {
+	if err := client.ContainerGroupsCreateOrUpdateThenPoll(ctx, id, *containerGroup); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -840,13 +754,40 @@ func resourceContainerGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		return err
 	}
 
-	t := d.Get("tags").(map[string]interface{})
+	// changes to anything other than `tags` require the Container Group to be re-deployed, which restarts the containers
+	if !d.HasChangesExcept("tags") {
+		t := d.Get("tags").(map[string]interface{})
+
+		parameters := containerinstance.Resource{
+			Tags: tags.Expand(t),
+		}
+
+		if _, err := client.ContainerGroupsUpdate(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+
+		return resourceContainerGroupRead(d, meta)
+	}
+
+	containerGroup, err := expandContainerGroup(d, *id)
+	if err != nil {
+		return err
+	}
+
+	// Avoid parallel provisioning if "subnet_ids" are given.
+	if subnets := containerGroup.Properties.SubnetIds; subnets != nil && len(*subnets) != 0 {
+		for _, item := range *subnets {
+			subnet, err := commonids.ParseSubnetID(item.Id)
+			if err != nil {
+				return fmt.Errorf(`parsing subnet id %q: %v`, item.Id, err)
+			}
 
-	parameters := containerinstance.Resource{
-		Tags: tags.Expand(t),
+			locks.ByID(subnet.ID())
+			defer locks.UnlockByID(subnet.ID())
+		}
 	}
 
-	if _, err := client.ContainerGroupsUpdate(ctx, *id, parameters); err != nil {
+	if err := client.ContainerGroupsCreateOrUpdateThenPoll(ctx, *id, *containerGroup); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
@@ -1056,6 +997,106 @@ func resourceContainerGroupDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+// expandContainerGroup builds the full Container Group payload, which is used both when creating the Container Group
+// and when re-deploying it in-place to apply changes to the updatable properties.
+func expandContainerGroup(d *pluginsdk.ResourceData, id containerinstance.ContainerGroupId) (*containerinstance.ContainerGroup, error) {
+	location := location.Normalize(d.Get("location").(string))
+	OSType := d.Get("os_type").(string)
+	IPAddressType := d.Get("ip_address_type").(string)
+	restartPolicy := containerinstance.ContainerGroupRestartPolicy(d.Get("restart_policy").(string))
+	diagnosticsRaw := d.Get("diagnostics").([]interface{})
+	diagnostics := expandContainerGroupDiagnostics(diagnosticsRaw)
+	dnsConfig := d.Get("dns_config").([]interface{})
+	addedEmptyDirs := map[string]bool{}
+	subnets, err := expandContainerGroupSubnets(d.Get("subnet_ids").(*pluginsdk.Set).List())
+	if err != nil {
+		return nil, err
+	}
+
+	zones := zones.ExpandUntyped(d.Get("zones").(*pluginsdk.Set).List())
+	initContainers, initContainerVolumes, err := expandContainerGroupInitContainers(d, addedEmptyDirs)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, containerGroupPorts, containerVolumes, err := expandContainerGroupContainers(d, addedEmptyDirs)
+	if err != nil {
+		return nil, err
+	}
+	var containerGroupVolumes []containerinstance.Volume
+	if initContainerVolumes != nil {
+		containerGroupVolumes = initContainerVolumes
+	}
+	if containerGroupVolumes != nil {
+		containerGroupVolumes = append(containerGroupVolumes, containerVolumes...)
+	}
+
+	containerGroup := containerinstance.ContainerGroup{
+		Name:     pointer.FromString(id.ContainerGroupName),
+		Location: &location,
+		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: containerinstance.ContainerGroupPropertiesProperties{
+			Sku:                      pointer.To(containerinstance.ContainerGroupSku(d.Get("sku").(string))),
+			InitContainers:           initContainers,
+			Containers:               containers,
+			Diagnostics:              diagnostics,
+			RestartPolicy:            &restartPolicy,
+			OsType:                   containerinstance.OperatingSystemTypes(OSType),
+			Volumes:                  &containerGroupVolumes,
+			ImageRegistryCredentials: expandContainerImageRegistryCredentials(d),
+			DnsConfig:                expandContainerGroupDnsConfig(dnsConfig),
+			SubnetIds:                subnets,
+		},
+		Zones: &zones,
+	}
+
+	// Container Groups with OS Type Windows do not support managed identities but the API also does not accept Identity Type: None
+	// https://github.com/Azure/azure-rest-api-specs/issues/18122
+	if OSType != string(containerinstance.OperatingSystemTypesWindows) {
+		expandedIdentity, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		containerGroup.Identity = expandedIdentity
+	}
+
+	if IPAddressType != "None" {
+		containerGroup.Properties.IPAddress = &containerinstance.IPAddress{
+			Ports: containerGroupPorts,
+			Type:  containerinstance.ContainerGroupIPAddressType(IPAddressType),
+		}
+
+		if dnsNameLabel := d.Get("dns_name_label").(string); dnsNameLabel != "" {
+			containerGroup.Properties.IPAddress.DnsNameLabel = &dnsNameLabel
+		}
+		if dnsNameLabelReusePolicy := d.Get("dns_name_label_reuse_policy").(string); dnsNameLabelReusePolicy != "" {
+			containerGroup.Properties.IPAddress.AutoGeneratedDomainNameLabelScope = (*containerinstance.DnsNameLabelReusePolicy)(&dnsNameLabelReusePolicy)
+		}
+	}
+
+	if keyVaultKeyId := d.Get("key_vault_key_id").(string); keyVaultKeyId != "" {
+		keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyVaultKeyId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing Key Vault Key ID: %+v", err)
+		}
+		containerGroup.Properties.EncryptionProperties = &containerinstance.EncryptionProperties{
+			VaultBaseUrl: keyId.KeyVaultBaseUrl,
+			KeyName:      keyId.Name,
+			KeyVersion:   keyId.Version,
+		}
+
+		if keyVaultUAI := d.Get("key_vault_user_assigned_identity_id").(string); keyVaultUAI != "" {
+			containerGroup.Properties.EncryptionProperties.Identity = &keyVaultUAI
+		}
+	}
+
+	if priority := d.Get("priority").(string); priority != "" {
+		containerGroup.Properties.Priority = pointer.To(containerinstance.ContainerGroupPriority(priority))
+	}
+
+	return &containerGroup, nil
+}
+
 func expandContainerGroupInitContainers(d *pluginsdk.ResourceData, addedEmptyDirs map[string]bool) (*[]containerinstance.InitContainerDefinition, []containerinstance.Volume, error) {
 	containersConfig := d.Get("init_container").([]interface{})
 	containers := make([]containerinstance.InitContainerDefinition, 0)
@@ -359,6 +359,37 @@ func TestAccContainerGroup_linuxBasicTagsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccContainerGroup_linuxInPlaceUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
+	r := ContainerGroupResource{}
+
+	// the Public IP Address is only retained when the Container Group is updated rather than recreated
+	var ipAddress string
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linuxInPlaceUpdate(data, "ubuntu:20.04", "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container.0.image").HasValue("ubuntu:20.04"),
+				check.That(data.ResourceName).Key("container.0.environment_variables.VERSION").HasValue("first"),
+				r.ipAddressUnchanged(data.ResourceName, &ipAddress),
+			),
+		},
+		data.ImportStep("container.0.secure_environment_variables.%", "container.0.secure_environment_variables.SECRET"),
+		{
+			Config: r.linuxInPlaceUpdate(data, "ubuntu:22.04", "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("container.0.image").HasValue("ubuntu:22.04"),
+				check.That(data.ResourceName).Key("container.0.environment_variables.VERSION").HasValue("second"),
+				r.ipAddressUnchanged(data.ResourceName, &ipAddress),
+			),
+		},
+		data.ImportStep("container.0.secure_environment_variables.%", "container.0.secure_environment_variables.SECRET"),
+	})
+}
+
 func TestAccContainerGroup_linuxComplete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_group", "test")
 
@@ -1167,6 +1198,59 @@ resource "azurerm_container_group" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (ContainerGroupResource) linuxInPlaceUpdate(data acceptance.TestData, image, version string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_group" "test" {
+  name                = "acctestcontainergroup-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  ip_address_type     = "Public"
+  dns_name_label      = "acctestcontainergroup-%[1]d"
+  os_type             = "Linux"
+
+  container {
+    name     = "hw"
+    image    = "%[3]s"
+    cpu      = "0.5"
+    memory   = "0.5"
+    commands = ["/bin/bash", "-c", "sleep infinity"]
+
+    ports {
+      port     = 80
+      protocol = "TCP"
+    }
+
+    environment_variables = {
+      VERSION = "%[4]s"
+    }
+
+    secure_environment_variables = {
+      SECRET = "%[4]s"
+    }
+
+    liveness_probe {
+      exec                  = ["cat", "/etc/hostname"]
+      initial_delay_seconds = 1
+      period_seconds        = 1
+    }
+  }
+
+  tags = {
+    environment = "Testing"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, image, version)
+}
+
 func (ContainerGroupResource) exposedPort(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -2382,6 +2466,32 @@ func (t ContainerGroupResource) Exists(ctx context.Context, clients *clients.Cli
 	return utils.Bool(resp.Model.Id != nil), nil
 }
 
+// ipAddressUnchanged records the `ip_address` of the Container Group the first time it's called, and then checks
+// that it's unchanged on subsequent calls - since a new IP Address is assigned when the Container Group is recreated
+func (ContainerGroupResource) ipAddressUnchanged(resourceName string, ipAddress *string) pluginsdk.TestCheckFunc {
+	return func(state *pluginsdk.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%q was not found in the state", resourceName)
+		}
+
+		actual := rs.Primary.Attributes["ip_address"]
+		if actual == "" {
+			return fmt.Errorf("expected `ip_address` to be set for %q", resourceName)
+		}
+
+		if *ipAddress == "" {
+			*ipAddress = actual
+			return nil
+		}
+		if actual != *ipAddress {
+			return fmt.Errorf("expected `ip_address` to be %q but got %q - the Container Group was recreated rather than updated", *ipAddress, actual)
+		}
+
+		return nil
+	}
+}
+
 func (ContainerGroupResource) withPrivateEmpty(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
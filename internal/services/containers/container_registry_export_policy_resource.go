@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryExportPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryExportPolicyResource{}
+
+type ContainerRegistryExportPolicyModel struct {
+	ContainerRegistryId string `tfschema:"container_registry_id"`
+	Enabled             bool   `tfschema:"enabled"`
+}
+
+func (r ContainerRegistryExportPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+	}
+}
+
+func (r ContainerRegistryExportPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryExportPolicyResource) ResourceType() string {
+	return "azurerm_container_registry_export_policy"
+}
+
+func (r ContainerRegistryExportPolicyResource) ModelObject() interface{} {
+	return &ContainerRegistryExportPolicyModel{}
+}
+
+func (r ContainerRegistryExportPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryExportPolicyID
+}
+
+func (r ContainerRegistryExportPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			var model ContainerRegistryExportPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerRegistryExportPolicyID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, "default")
+
+			locks.ByID(registryId.ID())
+			defer locks.UnlockByID(registryId.ID())
+
+			existing, err := client.Get(ctx, *registryId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *registryId, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *registryId)
+			}
+
+			// the Export Policy is a property of the Container Registry which always exists, so we can only check
+			// whether it's been disabled - either through another instance of this resource or `azurerm_container_registry`
+			if props := existing.Model.Properties; props != nil && props.Policies != nil && props.Policies.ExportPolicy != nil {
+				if pointer.From(props.Policies.ExportPolicy.Status) == registries.ExportPolicyStatusDisabled {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			if !model.Enabled {
+				if err := validateContainerRegistryExportPolicyCanBeDisabled(*existing.Model); err != nil {
+					return err
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, *registryId, expandContainerRegistryExportPolicyUpdate(model.Enabled)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryExportPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			id, err := parse.ContainerRegistryExportPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+			resp, err := client.Get(ctx, registryId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+
+			state := ContainerRegistryExportPolicyModel{
+				ContainerRegistryId: registryId.ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Enabled = flattenExportPolicy(model.Properties.Policies)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryExportPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			id, err := parse.ContainerRegistryExportPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryExportPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+			locks.ByID(registryId.ID())
+			defer locks.UnlockByID(registryId.ID())
+
+			if metadata.ResourceData.HasChange("enabled") {
+				if !model.Enabled {
+					existing, err := client.Get(ctx, registryId)
+					if err != nil {
+						return fmt.Errorf("retrieving %s: %+v", registryId, err)
+					}
+					if existing.Model == nil {
+						return fmt.Errorf("retrieving %s: `model` was nil", registryId)
+					}
+
+					if err := validateContainerRegistryExportPolicyCanBeDisabled(*existing.Model); err != nil {
+						return err
+					}
+				}
+
+				if err := client.UpdateThenPoll(ctx, registryId, expandContainerRegistryExportPolicyUpdate(model.Enabled)); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryExportPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			id, err := parse.ContainerRegistryExportPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+			locks.ByID(registryId.ID())
+			defer locks.UnlockByID(registryId.ID())
+
+			// the Export Policy can't be removed, so we reset it to the default (enabled) state
+			if err := client.UpdateThenPoll(ctx, registryId, expandContainerRegistryExportPolicyUpdate(true)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryExportPolicyUpdate(enabled bool) registries.RegistryUpdateParameters {
+	return registries.RegistryUpdateParameters{
+		Properties: &registries.RegistryPropertiesUpdateParameters{
+			Policies: &registries.Policies{
+				ExportPolicy: expandExportPolicy(enabled),
+			},
+		},
+	}
+}
+
+func validateContainerRegistryExportPolicyCanBeDisabled(registry registries.Registry) error {
+	if !strings.EqualFold(string(registry.Sku.Name), string(registries.SkuNamePremium)) {
+		return fmt.Errorf("the export policy can only be disabled for a Container Registry using the Premium Sku")
+	}
+
+	if props := registry.Properties; props == nil || pointer.From(props.PublicNetworkAccess) != registries.PublicNetworkAccessDisabled {
+		return fmt.Errorf("to disable export of artifacts, `public_network_access_enabled` must be `false` on the Container Registry")
+	}
+
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
@@ -265,6 +266,9 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
+	locks.ByID(id.ID())
+	defer locks.UnlockByID(id.ID())
+
 	sku := d.Get("sku").(string)
 	skuChange := d.HasChange("sku")
 	isBasicSku := strings.EqualFold(sku, string(registries.SkuNameBasic))
@@ -304,9 +308,7 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 			NetworkRuleSet:   networkRuleSet,
 			Policies: &registries.Policies{
 				QuarantinePolicy: expandQuarantinePolicy(d.Get("quarantine_policy_enabled").(bool)),
-				RetentionPolicy:  expandRetentionPolicy(d.Get("retention_policy").([]interface{})),
 				TrustPolicy:      expandTrustPolicy(d.Get("trust_policy").([]interface{})),
-				ExportPolicy:     expandExportPolicy(d.Get("export_policy_enabled").(bool)),
 			},
 			PublicNetworkAccess:      &publicNetworkAccess,
 			Encryption:               expandEncryption(d.Get("encryption").([]interface{})),
@@ -318,6 +320,16 @@ func resourceContainerRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	// the Retention and Export Policies can also be managed using the `azurerm_container_registry_retention_policy`
+	// and `azurerm_container_registry_export_policy` resources, so these are only sent when changed to avoid
+	// overwriting them when other properties of the Container Registry are updated
+	if d.HasChange("retention_policy") {
+		parameters.Properties.Policies.RetentionPolicy = expandRetentionPolicy(d.Get("retention_policy").([]interface{}))
+	}
+	if d.HasChange("export_policy_enabled") {
+		parameters.Properties.Policies.ExportPolicy = expandExportPolicy(d.Get("export_policy_enabled").(bool))
+	}
+
 	var hasGeoReplicationLocationsChanges bool
 	var hasNewGeoReplicationLocations bool
 	oldGeoReplicationLocations := make([]interface{}, 0)
@@ -478,6 +478,7 @@ func TestAccContainerRegistry_zoneRedundancy(t *testing.T) {
 
 func TestAccContainerRegistry_geoReplicationZoneRedundancy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry", "test")
+	data.RequireAvailabilityZones()
 	r := ContainerRegistryResource{}
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
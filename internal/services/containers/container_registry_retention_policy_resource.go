@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerRegistryRetentionPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerRegistryRetentionPolicyResource{}
+
+type ContainerRegistryRetentionPolicyModel struct {
+	ContainerRegistryId string `tfschema:"container_registry_id"`
+	Days                int64  `tfschema:"days"`
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+
+		"days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      7,
+			ValidateFunc: validation.IntBetween(0, 365),
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) ResourceType() string {
+	return "azurerm_container_registry_retention_policy"
+}
+
+func (r ContainerRegistryRetentionPolicyResource) ModelObject() interface{} {
+	return &ContainerRegistryRetentionPolicyModel{}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContainerRegistryRetentionPolicyID
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			var model ContainerRegistryRetentionPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId, err := registries.ParseRegistryID(model.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewContainerRegistryRetentionPolicyID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, "default")
+
+			locks.ByID(registryId.ID())
+			defer locks.UnlockByID(registryId.ID())
+
+			existing, err := client.Get(ctx, *registryId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *registryId, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *registryId)
+			}
+
+			if !strings.EqualFold(string(existing.Model.Sku.Name), string(registries.SkuNamePremium)) {
+				return fmt.Errorf("a retention policy can only be applied to a Container Registry using the Premium Sku")
+			}
+
+			// the Retention Policy is a property of the Container Registry which always exists, so we can only check
+			// whether it's been enabled - either through another instance of this resource or `azurerm_container_registry`
+			if props := existing.Model.Properties; props != nil && props.Policies != nil && props.Policies.RetentionPolicy != nil {
+				if pointer.From(props.Policies.RetentionPolicy.Status) == registries.PolicyStatusEnabled {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			parameters := registries.RegistryUpdateParameters{
+				Properties: &registries.RegistryPropertiesUpdateParameters{
+					Policies: &registries.Policies{
+						RetentionPolicy: &registries.RetentionPolicy{
+							Days:   pointer.To(model.Days),
+							Status: pointer.To(registries.PolicyStatusEnabled),
+						},
+					},
+				},
+			}
+			if err := client.UpdateThenPoll(ctx, *registryId, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			id, err := parse.ContainerRegistryRetentionPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+			resp, err := client.Get(ctx, registryId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", registryId, err)
+			}
+
+			var retentionPolicy *registries.RetentionPolicy
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Policies != nil {
+				retentionPolicy = model.Properties.Policies.RetentionPolicy
+			}
+
+			// a disabled Retention Policy is the default, meaning this has been removed outside of Terraform
+			if retentionPolicy == nil || pointer.From(retentionPolicy.Status) != registries.PolicyStatusEnabled {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ContainerRegistryRetentionPolicyModel{
+				ContainerRegistryId: registryId.ID(),
+				Days:                pointer.From(retentionPolicy.Days),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			id, err := parse.ContainerRegistryRetentionPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerRegistryRetentionPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+			locks.ByID(registryId.ID())
+			defer locks.UnlockByID(registryId.ID())
+
+			if metadata.ResourceData.HasChange("days") {
+				parameters := registries.RegistryUpdateParameters{
+					Properties: &registries.RegistryPropertiesUpdateParameters{
+						Policies: &registries.Policies{
+							RetentionPolicy: &registries.RetentionPolicy{
+								Days:   pointer.To(model.Days),
+								Status: pointer.To(registries.PolicyStatusEnabled),
+							},
+						},
+					},
+				}
+				if err := client.UpdateThenPoll(ctx, registryId, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries
+
+			id, err := parse.ContainerRegistryRetentionPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+
+			locks.ByID(registryId.ID())
+			defer locks.UnlockByID(registryId.ID())
+
+			// the Retention Policy can't be removed, so we reset it to the default (disabled) state
+			parameters := registries.RegistryUpdateParameters{
+				Properties: &registries.RegistryPropertiesUpdateParameters{
+					Policies: &registries.Policies{
+						RetentionPolicy: &registries.RetentionPolicy{
+							Days:   pointer.To(int64(7)),
+							Status: pointer.To(registries.PolicyStatusDisabled),
+						},
+					},
+				},
+			}
+			if err := client.UpdateThenPoll(ctx, registryId, parameters); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryRetentionPolicyResource struct{}
+
+func TestAccContainerRegistryRetentionPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_retention_policy", "test")
+	r := ContainerRegistryRetentionPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("days").HasValue("7"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryRetentionPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_retention_policy", "test")
+	r := ContainerRegistryRetentionPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryRetentionPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_retention_policy", "test")
+	r := ContainerRegistryRetentionPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.days(data, 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("days").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the Container Registry mustn't reset the Retention Policy
+			Config: r.registryUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("days").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryRetentionPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContainerRegistryRetentionPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	registryId := registries.NewRegistryID(id.SubscriptionId, id.ResourceGroup, id.RegistryName)
+	resp, err := clients.Containers.ContainerRegistryClient_v2021_08_01_preview.Registries.Get(ctx, registryId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", registryId, err)
+	}
+
+	enabled := false
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Policies != nil && model.Properties.Policies.RetentionPolicy != nil {
+		enabled = pointer.From(model.Properties.Policies.RetentionPolicy.Status) == registries.PolicyStatusEnabled
+	}
+
+	return pointer.To(enabled), nil
+}
+
+func (ContainerRegistryRetentionPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ContainerRegistryRetentionPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_retention_policy" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+}
+`, r.template(data))
+}
+
+func (r ContainerRegistryRetentionPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_retention_policy" "import" {
+  container_registry_id = azurerm_container_registry_retention_policy.test.container_registry_id
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryRetentionPolicyResource) days(data acceptance.TestData, days int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_retention_policy" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+  days                  = %d
+}
+`, r.template(data), days)
+}
+
+func (r ContainerRegistryRetentionPolicyResource) registryUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+  admin_enabled       = true
+
+  tags = {
+    environment = "Test"
+  }
+}
+
+resource "azurerm_container_registry_retention_policy" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+  days                  = 30
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
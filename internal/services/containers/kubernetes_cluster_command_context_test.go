@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"testing"
+)
+
+func TestExpandKubernetesClusterCommandContext(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    map[string]string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			input:    map[string]string{},
+			expected: []string{},
+		},
+		{
+			name: "single file",
+			input: map[string]string{
+				"deployment.yaml": "apiVersion: apps/v1",
+			},
+			expected: []string{"deployment.yaml"},
+		},
+		{
+			name: "multiple files are sorted by name",
+			input: map[string]string{
+				"service.yaml":       "apiVersion: v1",
+				"deployment.yaml":    "apiVersion: apps/v1",
+				"configs/empty.conf": "",
+			},
+			expected: []string{"configs/empty.conf", "deployment.yaml", "service.yaml"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		encoded, err := expandKubernetesClusterCommandContext(v.input)
+		if err != nil {
+			t.Fatalf("building the context: %+v", err)
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			t.Fatalf("expected the context to be base64 encoded: %+v", err)
+		}
+
+		reader, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+		if err != nil {
+			t.Fatalf("expected the context to be a zip archive: %+v", err)
+		}
+
+		if len(reader.File) != len(v.expected) {
+			t.Fatalf("expected %d files but got %d", len(v.expected), len(reader.File))
+		}
+
+		for i, file := range reader.File {
+			if file.Name != v.expected[i] {
+				t.Fatalf("expected file %d to be %q but got %q", i, v.expected[i], file.Name)
+			}
+
+			contents, err := file.Open()
+			if err != nil {
+				t.Fatalf("opening %q: %+v", file.Name, err)
+			}
+			actual, err := io.ReadAll(contents)
+			contents.Close()
+			if err != nil {
+				t.Fatalf("reading %q: %+v", file.Name, err)
+			}
+
+			if string(actual) != v.input[file.Name] {
+				t.Fatalf("expected the contents of %q to be %q but got %q", file.Name, v.input[file.Name], string(actual))
+			}
+		}
+	}
+}
+
+func TestExpandKubernetesClusterCommandContextIsDeterministic(t *testing.T) {
+	input := map[string]string{
+		"a.yaml": "a",
+		"b.yaml": "b",
+		"c.yaml": "c",
+	}
+
+	first, err := expandKubernetesClusterCommandContext(input)
+	if err != nil {
+		t.Fatalf("building the context: %+v", err)
+	}
+
+	for i := 0; i < 10; i++ {
+		actual, err := expandKubernetesClusterCommandContext(input)
+		if err != nil {
+			t.Fatalf("building the context: %+v", err)
+		}
+		if actual != first {
+			t.Fatalf("expected the context to be the same for the same input")
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesClusterCommandDataSourceModel struct {
+	KubernetesClusterId string            `tfschema:"kubernetes_cluster_id"`
+	Command             string            `tfschema:"command"`
+	ContextFiles        map[string]string `tfschema:"context_files"`
+	ClusterToken        string            `tfschema:"cluster_token"`
+	ExitCode            int64             `tfschema:"exit_code"`
+	Logs                string            `tfschema:"logs"`
+	StartedAt           string            `tfschema:"started_at"`
+	FinishedAt          string            `tfschema:"finished_at"`
+}
+
+type KubernetesClusterCommandDataSource struct{}
+
+var _ sdk.DataSource = KubernetesClusterCommandDataSource{}
+
+func (r KubernetesClusterCommandDataSource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_command"
+}
+
+func (r KubernetesClusterCommandDataSource) ModelObject() interface{} {
+	return &KubernetesClusterCommandDataSourceModel{}
+}
+
+func (r KubernetesClusterCommandDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateKubernetesClusterID,
+		},
+
+		"command": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"context_files": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"cluster_token": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KubernetesClusterCommandDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"exit_code": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"logs": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"started_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"finished_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterCommandDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesClustersClient
+
+			var state KubernetesClusterCommandDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseKubernetesClusterID(state.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			payload := managedclusters.RunCommandRequest{
+				Command: state.Command,
+			}
+
+			if state.ClusterToken != "" {
+				payload.ClusterToken = pointer.To(state.ClusterToken)
+			}
+
+			if len(state.ContextFiles) > 0 {
+				commandContext, err := expandKubernetesClusterCommandContext(state.ContextFiles)
+				if err != nil {
+					return fmt.Errorf("building the context for the command: %+v", err)
+				}
+				payload.Context = pointer.To(commandContext)
+			}
+
+			future, err := client.RunCommand(ctx, *id, payload)
+			if err != nil {
+				return fmt.Errorf("running command on %s: %+v", id, err)
+			}
+			if err := future.Poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the command to complete on %s: %+v", id, err)
+			}
+
+			lastResponse := future.Poller.LatestResponse()
+			if lastResponse == nil {
+				return fmt.Errorf("waiting for the command to complete on %s: last response was nil", id)
+			}
+
+			var result managedclusters.RunCommandResult
+			if err := lastResponse.Unmarshal(&result); err != nil {
+				return fmt.Errorf("retrieving the result of the command run on %s: %+v", id, err)
+			}
+			if result.Properties == nil {
+				return fmt.Errorf("retrieving the result of the command run on %s: `properties` was nil", id)
+			}
+			props := *result.Properties
+
+			if strings.EqualFold(pointer.From(props.ProvisioningState), "Failed") {
+				return fmt.Errorf("running command on %s: %s", id, pointer.From(props.Reason))
+			}
+
+			// the command ID isn't returned in all cases - since the command is run on each read the ID only needs to be unique per run
+			commandResultId := managedclusters.NewCommandResultID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, fmt.Sprintf("%d", time.Now().UTC().UnixNano()))
+			if result.Id != nil {
+				parsed, err := managedclusters.ParseCommandResultIDInsensitively(*result.Id)
+				if err != nil {
+					return err
+				}
+				commandResultId = *parsed
+			}
+
+			state.KubernetesClusterId = id.ID()
+			state.ExitCode = pointer.From(props.ExitCode)
+			state.Logs = pointer.From(props.Logs)
+			state.StartedAt = pointer.From(props.StartedAt)
+			state.FinishedAt = pointer.From(props.FinishedAt)
+
+			metadata.SetID(commandResultId)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// expandKubernetesClusterCommandContext builds the base64 encoded zip archive of files which the API expects as the
+// context of the command, the files are made available in the working directory of the command
+func expandKubernetesClusterCommandContext(input map[string]string) (string, error) {
+	fileNames := make([]string, 0, len(input))
+	for name := range input {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	buf := new(bytes.Buffer)
+	writer := zip.NewWriter(buf)
+	for _, name := range fileNames {
+		file, err := writer.Create(name)
+		if err != nil {
+			return "", fmt.Errorf("adding %q: %+v", name, err)
+		}
+		if _, err := file.Write([]byte(input[name])); err != nil {
+			return "", fmt.Errorf("writing %q: %+v", name, err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesClusterCommandDataSource struct{}
+
+func TestAccDataSourceKubernetesClusterCommand_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_command", "test")
+	r := KubernetesClusterCommandDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("logs").Exists(),
+				check.That(data.ResourceName).Key("finished_at").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceKubernetesClusterCommand_contextFiles(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_command", "test")
+	r := KubernetesClusterCommandDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.contextFiles(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("exit_code").HasValue("0"),
+				check.That(data.ResourceName).Key("logs").Exists(),
+			),
+		},
+	})
+}
+
+func (KubernetesClusterCommandDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_cluster_command" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  command               = "kubectl get nodes"
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data))
+}
+
+func (KubernetesClusterCommandDataSource) contextFiles(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_cluster_command" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  command               = "kubectl apply -f configmap.yaml && kubectl get configmap acctest -o yaml"
+
+  context_files = {
+    "configmap.yaml" = <<YAML
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: acctest
+  namespace: default
+data:
+  key: value
+YAML
+  }
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data))
+}
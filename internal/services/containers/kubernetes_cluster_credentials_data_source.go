@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	kubernetesClusterCredentialTypeAdmin = "Admin"
+	kubernetesClusterCredentialTypeUser  = "User"
+)
+
+type KubernetesClusterCredentialsDataSourceModel struct {
+	KubernetesClusterId string                                        `tfschema:"kubernetes_cluster_id"`
+	CredentialType      string                                        `tfschema:"credential_type"`
+	Format              string                                        `tfschema:"format"`
+	PublicFqdnEnabled   bool                                          `tfschema:"public_fqdn_enabled"`
+	KubeConfig          []KubernetesClusterCredentialsKubeConfigModel `tfschema:"kube_config"`
+	KubeConfigRaw       string                                        `tfschema:"kube_config_raw"`
+}
+
+type KubernetesClusterCredentialsKubeConfigModel struct {
+	Host                 string `tfschema:"host"`
+	Username             string `tfschema:"username"`
+	Password             string `tfschema:"password"`
+	ClientCertificate    string `tfschema:"client_certificate"`
+	ClientKey            string `tfschema:"client_key"`
+	ClusterCaCertificate string `tfschema:"cluster_ca_certificate"`
+	Token                string `tfschema:"token"`
+}
+
+// TODO: add an Ephemeral Resource variant (`azurerm_kubernetes_cluster_credentials`) so that these credentials can be
+// retrieved without being persisted into the state - this requires a version of `terraform-plugin-go` and the Plugin
+// SDK which support Ephemeral Resources, which the versions currently vendored don't
+type KubernetesClusterCredentialsDataSource struct{}
+
+var _ sdk.DataSource = KubernetesClusterCredentialsDataSource{}
+
+func (r KubernetesClusterCredentialsDataSource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_credentials"
+}
+
+func (r KubernetesClusterCredentialsDataSource) ModelObject() interface{} {
+	return &KubernetesClusterCredentialsDataSourceModel{}
+}
+
+func (r KubernetesClusterCredentialsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateKubernetesClusterID,
+		},
+
+		"credential_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  kubernetesClusterCredentialTypeUser,
+			ValidateFunc: validation.StringInSlice([]string{
+				kubernetesClusterCredentialTypeAdmin,
+				kubernetesClusterCredentialTypeUser,
+			}, false),
+		},
+
+		"format": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(managedclusters.PossibleValuesForFormat(), false),
+		},
+
+		"public_fqdn_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r KubernetesClusterCredentialsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kube_config": {
+			Type:      pluginsdk.TypeList,
+			Computed:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"host": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+					"username": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+					"password": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+					"client_certificate": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+					"client_key": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+					"cluster_ca_certificate": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+					"token": {
+						Type:      pluginsdk.TypeString,
+						Computed:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+
+		"kube_config_raw": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r KubernetesClusterCredentialsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.KubernetesClustersClient
+
+			var state KubernetesClusterCredentialsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseKubernetesClusterID(state.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			var serverFqdn *string
+			if state.PublicFqdnEnabled {
+				serverFqdn = pointer.To("public")
+			}
+
+			var credentials *managedclusters.CredentialResults
+			configName := "clusterUser"
+			if state.CredentialType == kubernetesClusterCredentialTypeAdmin {
+				if state.Format != "" {
+					return fmt.Errorf("`format` can only be specified when `credential_type` is `%s`", kubernetesClusterCredentialTypeUser)
+				}
+
+				resp, err := client.ListClusterAdminCredentials(ctx, *id, managedclusters.ListClusterAdminCredentialsOperationOptions{
+					ServerFqdn: serverFqdn,
+				})
+				if err != nil {
+					return fmt.Errorf("retrieving Admin Credentials for %s: %+v", id, err)
+				}
+				credentials = resp.Model
+				configName = "clusterAdmin"
+			} else {
+				options := managedclusters.ListClusterUserCredentialsOperationOptions{
+					ServerFqdn: serverFqdn,
+				}
+				if state.Format != "" {
+					options.Format = pointer.To(managedclusters.Format(state.Format))
+				}
+
+				resp, err := client.ListClusterUserCredentials(ctx, *id, options)
+				if err != nil {
+					return fmt.Errorf("retrieving User Credentials for %s: %+v", id, err)
+				}
+				credentials = resp.Model
+			}
+
+			kubeConfigRaw := kubernetesClusterKubeConfigRaw(credentials, configName)
+			if kubeConfigRaw == nil {
+				return fmt.Errorf("retrieving %s Credentials for %s: no kubeconfig named %q was returned", state.CredentialType, id, configName)
+			}
+
+			state.KubernetesClusterId = id.ID()
+			state.KubeConfigRaw = *kubeConfigRaw
+			state.KubeConfig = flattenKubernetesClusterCredentialsKubeConfig(*kubeConfigRaw)
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenKubernetesClusterCredentialsKubeConfig returns the connection details from the kubeconfig - the `token` is only
+// present when the kubeconfig authenticates using a token (rather than a client certificate, or using `exec`)
+func flattenKubernetesClusterCredentialsKubeConfig(rawConfig string) []KubernetesClusterCredentialsKubeConfigModel {
+	if strings.Contains(rawConfig, "apiserver-id:") || strings.Contains(rawConfig, "exec") {
+		kubeConfig, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+		if err != nil || len(kubeConfig.Clusters) == 0 || len(kubeConfig.Users) == 0 {
+			return []KubernetesClusterCredentialsKubeConfigModel{}
+		}
+
+		return []KubernetesClusterCredentialsKubeConfigModel{
+			{
+				Host:                 kubeConfig.Clusters[0].Cluster.Server,
+				Username:             kubeConfig.Users[0].Name,
+				ClusterCaCertificate: kubeConfig.Clusters[0].Cluster.ClusterAuthorityData,
+			},
+		}
+	}
+
+	kubeConfig, err := kubernetes.ParseKubeConfig(rawConfig)
+	if err != nil || len(kubeConfig.Clusters) == 0 || len(kubeConfig.Users) == 0 {
+		return []KubernetesClusterCredentialsKubeConfigModel{}
+	}
+
+	user := kubeConfig.Users[0].User
+	return []KubernetesClusterCredentialsKubeConfigModel{
+		{
+			Host:                 kubeConfig.Clusters[0].Cluster.Server,
+			Username:             kubeConfig.Users[0].Name,
+			Password:             user.Token,
+			ClientCertificate:    user.ClientCertificteData,
+			ClientKey:            user.ClientKeyData,
+			ClusterCaCertificate: kubeConfig.Clusters[0].Cluster.ClusterAuthorityData,
+			Token:                user.Token,
+		},
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesClusterCredentialsDataSource struct{}
+
+func TestAccDataSourceKubernetesClusterCredentials_user(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.user(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kube_config_raw").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.host").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.cluster_ca_certificate").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.username").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.password").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.token").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceKubernetesClusterCredentials_admin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.admin(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kube_config_raw").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.host").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.cluster_ca_certificate").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.client_certificate").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.client_key").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceKubernetesClusterCredentials_adminWithFormat(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.adminWithFormat(data),
+			ExpectError: regexp.MustCompile("`format` can only be specified when `credential_type` is `User`"),
+		},
+	})
+}
+
+func TestAccDataSourceKubernetesClusterCredentials_exec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.exec(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kube_config_raw").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.host").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.cluster_ca_certificate").Exists(),
+				check.That(data.ResourceName).Key("kube_config.0.password").HasValue(""),
+				check.That(data.ResourceName).Key("kube_config.0.token").HasValue(""),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceKubernetesClusterCredentials_publicFqdn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.publicFqdn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kube_config.0.host").Exists(),
+			),
+		},
+	})
+}
+
+func (KubernetesClusterCredentialsDataSource) user(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data))
+}
+
+func (KubernetesClusterCredentialsDataSource) admin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  credential_type       = "Admin"
+}
+`, KubernetesClusterResource{}.roleBasedAccessControlAADManagedConfig(data, ""))
+}
+
+func (KubernetesClusterCredentialsDataSource) adminWithFormat(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  credential_type       = "Admin"
+  format                = "exec"
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data))
+}
+
+func (KubernetesClusterCredentialsDataSource) exec(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+data "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  format                = "exec"
+}
+`, KubernetesClusterResource{}.roleBasedAccessControlAADManagedConfig(data, ""))
+}
+
+func (KubernetesClusterCredentialsDataSource) publicFqdn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  public_fqdn_enabled   = true
+}
+`, KubernetesClusterResource{}.privateClusterPublicFqdn(data, true))
+}
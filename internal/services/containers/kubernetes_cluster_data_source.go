@@ -17,7 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-06-02-preview/managedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
@@ -972,43 +972,51 @@ func flattenKubernetesClusterDataSourceStorageProfile(input *managedclusters.Man
 }
 
 func flattenKubernetesClusterCredentials(model *managedclusters.CredentialResults, configName string) (*string, []interface{}) {
-	if model == nil || model.Kubeconfigs == nil || len(*model.Kubeconfigs) < 1 {
+	rawConfig := kubernetesClusterKubeConfigRaw(model, configName)
+	if rawConfig == nil {
 		return nil, []interface{}{}
 	}
 
-	for _, c := range *model.Kubeconfigs {
-		if c.Name == nil || *c.Name != configName {
-			continue
+	var flattenedKubeConfig []interface{}
+
+	if strings.Contains(*rawConfig, "apiserver-id:") || strings.Contains(*rawConfig, "exec") {
+		kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(*rawConfig)
+		if err != nil {
+			return rawConfig, []interface{}{}
 		}
-		if kubeConfigRaw := c.Value; kubeConfigRaw != nil {
-			rawConfig := *kubeConfigRaw
-			if base64IsEncoded(*kubeConfigRaw) {
-				rawConfig = base64Decode(*kubeConfigRaw)
-			}
 
-			var flattenedKubeConfig []interface{}
+		flattenedKubeConfig = flattenKubernetesClusterDataSourceKubeConfigAAD(*kubeConfigAAD)
+	} else {
+		kubeConfig, err := kubernetes.ParseKubeConfig(*rawConfig)
+		if err != nil {
+			return rawConfig, []interface{}{}
+		}
 
-			if strings.Contains(rawConfig, "apiserver-id:") || strings.Contains(rawConfig, "exec") {
-				kubeConfigAAD, err := kubernetes.ParseKubeConfigAAD(rawConfig)
-				if err != nil {
-					return utils.String(rawConfig), []interface{}{}
-				}
+		flattenedKubeConfig = flattenKubernetesClusterDataSourceKubeConfig(*kubeConfig)
+	}
 
-				flattenedKubeConfig = flattenKubernetesClusterDataSourceKubeConfigAAD(*kubeConfigAAD)
-			} else {
-				kubeConfig, err := kubernetes.ParseKubeConfig(rawConfig)
-				if err != nil {
-					return utils.String(rawConfig), []interface{}{}
-				}
+	return rawConfig, flattenedKubeConfig
+}
 
-				flattenedKubeConfig = flattenKubernetesClusterDataSourceKubeConfig(*kubeConfig)
-			}
+// kubernetesClusterKubeConfigRaw returns the (decoded) kubeconfig with the specified name, if one was returned
+func kubernetesClusterKubeConfigRaw(model *managedclusters.CredentialResults, configName string) *string {
+	if model == nil || model.Kubeconfigs == nil {
+		return nil
+	}
+
+	for _, c := range *model.Kubeconfigs {
+		if c.Name == nil || *c.Name != configName || c.Value == nil {
+			continue
+		}
 
-			return utils.String(rawConfig), flattenedKubeConfig
+		rawConfig := *c.Value
+		if base64IsEncoded(rawConfig) {
+			rawConfig = base64Decode(rawConfig)
 		}
+		return utils.String(rawConfig)
 	}
 
-	return nil, []interface{}{}
+	return nil
 }
 
 func flattenKubernetesClusterDataSourceAddOns(profile map[string]managedclusters.ManagedClusterAddonProfile) map[string]interface{} {
@@ -1465,18 +1473,15 @@ func flattenKubernetesClusterDataSourceMicrosoftDefender(input *managedclusters.
 }
 
 func flattenKubernetesClusterDataSourceUpgradeSettings(input *managedclusters.AgentPoolUpgradeSettings) []interface{} {
-	maxSurge := ""
-	if input != nil && input.MaxSurge != nil {
-		maxSurge = *input.MaxSurge
-	}
-
-	if maxSurge == "" {
+	if input == nil || input.MaxSurge == nil || *input.MaxSurge == "" {
 		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"max_surge": maxSurge,
+			"max_surge":                     *input.MaxSurge,
+			"drain_timeout_in_minutes":      int(pointer.From(input.DrainTimeoutInMinutes)),
+			"node_soak_duration_in_minutes": int(pointer.From(input.NodeSoakDurationInMinutes)),
 		},
 	}
 }
@@ -0,0 +1,609 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	kubernetesClusterMaintenanceConfigurationDefault               = "default"
+	kubernetesClusterMaintenanceConfigurationAutoUpgradeSchedule   = "aksManagedAutoUpgradeSchedule"
+	kubernetesClusterMaintenanceConfigurationNodeOSUpgradeSchedule = "aksManagedNodeOSUpgradeSchedule"
+)
+
+var _ sdk.Resource = KubernetesClusterMaintenanceConfigurationResource{}
+var _ sdk.ResourceWithUpdate = KubernetesClusterMaintenanceConfigurationResource{}
+var _ sdk.ResourceWithCustomizeDiff = KubernetesClusterMaintenanceConfigurationResource{}
+
+type KubernetesClusterMaintenanceConfigurationResource struct{}
+
+type KubernetesClusterMaintenanceConfigurationModel struct {
+	Name                string                                                     `tfschema:"name"`
+	KubernetesClusterId string                                                     `tfschema:"kubernetes_cluster_id"`
+	Allowed             []KubernetesClusterMaintenanceConfigurationAllowedModel    `tfschema:"allowed"`
+	NotAllowed          []KubernetesClusterMaintenanceConfigurationNotAllowedModel `tfschema:"not_allowed"`
+	MaintenanceWindow   []KubernetesClusterMaintenanceConfigurationWindowModel     `tfschema:"maintenance_window"`
+}
+
+type KubernetesClusterMaintenanceConfigurationAllowedModel struct {
+	Day   string  `tfschema:"day"`
+	Hours []int64 `tfschema:"hours"`
+}
+
+type KubernetesClusterMaintenanceConfigurationNotAllowedModel struct {
+	Start string `tfschema:"start"`
+	End   string `tfschema:"end"`
+}
+
+type KubernetesClusterMaintenanceConfigurationWindowModel struct {
+	Frequency  string                                                     `tfschema:"frequency"`
+	Interval   int64                                                      `tfschema:"interval"`
+	Duration   int64                                                      `tfschema:"duration"`
+	DayOfWeek  string                                                     `tfschema:"day_of_week"`
+	WeekIndex  string                                                     `tfschema:"week_index"`
+	DayOfMonth int64                                                      `tfschema:"day_of_month"`
+	StartDate  string                                                     `tfschema:"start_date"`
+	StartTime  string                                                     `tfschema:"start_time"`
+	UtcOffset  string                                                     `tfschema:"utc_offset"`
+	NotAllowed []KubernetesClusterMaintenanceConfigurationNotAllowedModel `tfschema:"not_allowed"`
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) ModelObject() interface{} {
+	return &KubernetesClusterMaintenanceConfigurationModel{}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return maintenanceconfigurations.ValidateMaintenanceConfigurationID
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_maintenance_configuration"
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				kubernetesClusterMaintenanceConfigurationDefault,
+				kubernetesClusterMaintenanceConfigurationAutoUpgradeSchedule,
+				kubernetesClusterMaintenanceConfigurationNodeOSUpgradeSchedule,
+			}, false),
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateKubernetesClusterID,
+		},
+
+		"allowed": {
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"maintenance_window"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"day": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+					},
+
+					"hours": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeInt,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+					},
+				},
+			},
+		},
+
+		"not_allowed": {
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"maintenance_window"},
+			Elem:          kubernetesClusterMaintenanceConfigurationNotAllowedSchema(),
+		},
+
+		"maintenance_window": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"allowed", "not_allowed"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"frequency": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"Daily",
+							"Weekly",
+							"AbsoluteMonthly",
+							"RelativeMonthly",
+						}, false),
+					},
+
+					"interval": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"duration": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(4, 24),
+					},
+
+					"start_time": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "`start_time` must be in the format `HH:mm`"),
+					},
+
+					"day_of_week": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+					},
+
+					"week_index": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForType(), false),
+					},
+
+					"day_of_month": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 31),
+					},
+
+					"start_date": {
+						Type:             pluginsdk.TypeString,
+						Optional:         true,
+						Computed:         true,
+						DiffSuppressFunc: suppress.RFC3339Time,
+						ValidateFunc:     validation.IsRFC3339Time,
+					},
+
+					"utc_offset": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "+00:00",
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[+-][0-9]{2}:[0-9]{2}$`), "`utc_offset` must be in the format `+HH:mm` or `-HH:mm`"),
+					},
+
+					"not_allowed": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem:     kubernetesClusterMaintenanceConfigurationNotAllowedSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+			name := rd.Get("name").(string)
+			window := rd.Get("maintenance_window").([]interface{})
+
+			if name == kubernetesClusterMaintenanceConfigurationDefault {
+				if len(window) > 0 {
+					return fmt.Errorf("`maintenance_window` cannot be specified when `name` is `%s`, use `allowed` and/or `not_allowed` instead", name)
+				}
+				if rd.Get("allowed").(*pluginsdk.Set).Len() == 0 && rd.Get("not_allowed").(*pluginsdk.Set).Len() == 0 {
+					return fmt.Errorf("at least one of `allowed` or `not_allowed` must be specified when `name` is `%s`", name)
+				}
+				return nil
+			}
+
+			if len(window) == 0 || window[0] == nil {
+				return fmt.Errorf("`maintenance_window` must be specified when `name` is `%s`", name)
+			}
+
+			v := window[0].(map[string]interface{})
+			switch v["frequency"].(string) {
+			case "Daily":
+				if name != kubernetesClusterMaintenanceConfigurationNodeOSUpgradeSchedule {
+					return fmt.Errorf("a `frequency` of `Daily` is only supported when `name` is `%s`", kubernetesClusterMaintenanceConfigurationNodeOSUpgradeSchedule)
+				}
+				if v["day_of_week"].(string) != "" || v["week_index"].(string) != "" || v["day_of_month"].(int) != 0 {
+					return fmt.Errorf("`day_of_week`, `week_index` and `day_of_month` cannot be specified when `frequency` is `Daily`")
+				}
+			case "Weekly":
+				if v["day_of_week"].(string) == "" {
+					return fmt.Errorf("`day_of_week` must be specified when `frequency` is `Weekly`")
+				}
+				if v["week_index"].(string) != "" || v["day_of_month"].(int) != 0 {
+					return fmt.Errorf("`week_index` and `day_of_month` cannot be specified when `frequency` is `Weekly`")
+				}
+			case "AbsoluteMonthly":
+				if v["day_of_month"].(int) == 0 {
+					return fmt.Errorf("`day_of_month` must be specified when `frequency` is `AbsoluteMonthly`")
+				}
+				if v["day_of_week"].(string) != "" || v["week_index"].(string) != "" {
+					return fmt.Errorf("`day_of_week` and `week_index` cannot be specified when `frequency` is `AbsoluteMonthly`")
+				}
+			case "RelativeMonthly":
+				if v["day_of_week"].(string) == "" || v["week_index"].(string) == "" {
+					return fmt.Errorf("`day_of_week` and `week_index` must be specified when `frequency` is `RelativeMonthly`")
+				}
+				if v["day_of_month"].(int) != 0 {
+					return fmt.Errorf("`day_of_month` cannot be specified when `frequency` is `RelativeMonthly`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			var config KubernetesClusterMaintenanceConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := commonids.ParseKubernetesClusterID(config.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := maintenanceconfigurations.NewMaintenanceConfigurationID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ManagedClusterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				// the same Maintenance Configuration can also be managed by the inline blocks of the `azurerm_kubernetes_cluster`
+				// resource, since the two would overwrite one another point out which block conflicts with this resource
+				return fmt.Errorf("%+v\n\nIf this Maintenance Configuration is defined using the `%s` block of the `azurerm_kubernetes_cluster` resource, that block must be removed from the cluster before this resource can be used", metadata.ResourceRequiresImport(r.ResourceType(), id), kubernetesClusterMaintenanceConfigurationInlineBlock(config.Name))
+			}
+
+			payload := maintenanceconfigurations.MaintenanceConfiguration{
+				Properties: expandKubernetesClusterMaintenanceConfigurationProperties(config, nil),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterMaintenanceConfigurationModel{
+				Name:                id.MaintenanceConfigurationName,
+				KubernetesClusterId: commonids.NewKubernetesClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Allowed = flattenKubernetesClusterMaintenanceConfigurationAllowed(props.TimeInWeek)
+					state.NotAllowed = flattenKubernetesClusterMaintenanceConfigurationNotAllowedTimes(props.NotAllowedTime)
+					state.MaintenanceWindow = flattenKubernetesClusterMaintenanceConfigurationWindow(props.MaintenanceWindow)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesClusterMaintenanceConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := maintenanceconfigurations.MaintenanceConfiguration{
+				Properties: expandKubernetesClusterMaintenanceConfigurationProperties(config, existing.Model.Properties),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.MaintenanceConfigurationsClient
+
+			id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func kubernetesClusterMaintenanceConfigurationNotAllowedSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"start": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+
+			"end": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppress.RFC3339Time,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+		},
+	}
+}
+
+// kubernetesClusterMaintenanceConfigurationInlineBlock returns the block within the `azurerm_kubernetes_cluster`
+// resource which manages the Maintenance Configuration with the specified name
+func kubernetesClusterMaintenanceConfigurationInlineBlock(name string) string {
+	switch name {
+	case kubernetesClusterMaintenanceConfigurationAutoUpgradeSchedule:
+		return "maintenance_window_auto_upgrade"
+	case kubernetesClusterMaintenanceConfigurationNodeOSUpgradeSchedule:
+		return "maintenance_window_node_os"
+	}
+	return "maintenance_window"
+}
+
+func expandKubernetesClusterMaintenanceConfigurationProperties(input KubernetesClusterMaintenanceConfigurationModel, existing *maintenanceconfigurations.MaintenanceConfigurationProperties) *maintenanceconfigurations.MaintenanceConfigurationProperties {
+	if input.Name == kubernetesClusterMaintenanceConfigurationDefault {
+		timeInWeek := make([]maintenanceconfigurations.TimeInWeek, 0)
+		for _, v := range input.Allowed {
+			timeInWeek = append(timeInWeek, maintenanceconfigurations.TimeInWeek{
+				Day:       pointer.To(maintenanceconfigurations.WeekDay(v.Day)),
+				HourSlots: pointer.To(v.Hours),
+			})
+		}
+
+		notAllowedTime := make([]maintenanceconfigurations.TimeSpan, 0)
+		for _, v := range input.NotAllowed {
+			start, _ := time.Parse(time.RFC3339, v.Start)
+			end, _ := time.Parse(time.RFC3339, v.End)
+			notAllowedTime = append(notAllowedTime, maintenanceconfigurations.TimeSpan{
+				Start: pointer.To(start.Format(time.RFC3339)),
+				End:   pointer.To(end.Format(time.RFC3339)),
+			})
+		}
+
+		return &maintenanceconfigurations.MaintenanceConfigurationProperties{
+			TimeInWeek:     &timeInWeek,
+			NotAllowedTime: &notAllowedTime,
+		}
+	}
+
+	if len(input.MaintenanceWindow) == 0 {
+		return &maintenanceconfigurations.MaintenanceConfigurationProperties{}
+	}
+	window := input.MaintenanceWindow[0]
+
+	var schedule maintenanceconfigurations.Schedule
+	switch window.Frequency {
+	case "Daily":
+		schedule.Daily = &maintenanceconfigurations.DailySchedule{
+			IntervalDays: window.Interval,
+		}
+	case "Weekly":
+		schedule.Weekly = &maintenanceconfigurations.WeeklySchedule{
+			IntervalWeeks: window.Interval,
+			DayOfWeek:     maintenanceconfigurations.WeekDay(window.DayOfWeek),
+		}
+	case "AbsoluteMonthly":
+		schedule.AbsoluteMonthly = &maintenanceconfigurations.AbsoluteMonthlySchedule{
+			IntervalMonths: window.Interval,
+			DayOfMonth:     window.DayOfMonth,
+		}
+	case "RelativeMonthly":
+		schedule.RelativeMonthly = &maintenanceconfigurations.RelativeMonthlySchedule{
+			IntervalMonths: window.Interval,
+			DayOfWeek:      maintenanceconfigurations.WeekDay(window.DayOfWeek),
+			WeekIndex:      maintenanceconfigurations.Type(window.WeekIndex),
+		}
+	}
+
+	notAllowedDates := make([]maintenanceconfigurations.DateSpan, 0)
+	for _, v := range window.NotAllowed {
+		start, _ := time.Parse(time.RFC3339, v.Start)
+		end, _ := time.Parse(time.RFC3339, v.End)
+		notAllowedDates = append(notAllowedDates, maintenanceconfigurations.DateSpan{
+			Start: start.Format("2006-01-02"),
+			End:   end.Format("2006-01-02"),
+		})
+	}
+
+	output := &maintenanceconfigurations.MaintenanceConfigurationProperties{
+		MaintenanceWindow: &maintenanceconfigurations.MaintenanceWindow{
+			DurationHours:   window.Duration,
+			Schedule:        schedule,
+			StartTime:       window.StartTime,
+			NotAllowedDates: &notAllowedDates,
+		},
+	}
+
+	if window.UtcOffset != "" {
+		output.MaintenanceWindow.UtcOffset = pointer.To(window.UtcOffset)
+	}
+
+	if window.StartDate != "" {
+		startDate, _ := time.Parse(time.RFC3339, window.StartDate)
+		startDateStr := startDate.Format("2006-01-02")
+		// `start_date` is Optional + Computed and the API rejects a start date in the past, so only send it when it's changed
+		if existing == nil || existing.MaintenanceWindow == nil || pointer.From(existing.MaintenanceWindow.StartDate) != startDateStr {
+			output.MaintenanceWindow.StartDate = pointer.To(startDateStr)
+		}
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationAllowed(input *[]maintenanceconfigurations.TimeInWeek) []KubernetesClusterMaintenanceConfigurationAllowedModel {
+	output := make([]KubernetesClusterMaintenanceConfigurationAllowedModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, KubernetesClusterMaintenanceConfigurationAllowedModel{
+			Day:   string(pointer.From(v.Day)),
+			Hours: pointer.From(v.HourSlots),
+		})
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationNotAllowedTimes(input *[]maintenanceconfigurations.TimeSpan) []KubernetesClusterMaintenanceConfigurationNotAllowedModel {
+	output := make([]KubernetesClusterMaintenanceConfigurationNotAllowedModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, KubernetesClusterMaintenanceConfigurationNotAllowedModel{
+			Start: pointer.From(v.Start),
+			End:   pointer.From(v.End),
+		})
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterMaintenanceConfigurationWindow(input *maintenanceconfigurations.MaintenanceWindow) []KubernetesClusterMaintenanceConfigurationWindowModel {
+	if input == nil {
+		return []KubernetesClusterMaintenanceConfigurationWindowModel{}
+	}
+
+	window := KubernetesClusterMaintenanceConfigurationWindowModel{
+		Duration:   input.DurationHours,
+		StartTime:  input.StartTime,
+		UtcOffset:  pointer.From(input.UtcOffset),
+		NotAllowed: make([]KubernetesClusterMaintenanceConfigurationNotAllowedModel, 0),
+	}
+
+	if input.StartDate != nil {
+		window.StartDate = *input.StartDate + "T00:00:00Z"
+	}
+
+	if schedule := input.Schedule.Daily; schedule != nil {
+		window.Frequency = "Daily"
+		window.Interval = schedule.IntervalDays
+	}
+	if schedule := input.Schedule.Weekly; schedule != nil {
+		window.Frequency = "Weekly"
+		window.Interval = schedule.IntervalWeeks
+		window.DayOfWeek = string(schedule.DayOfWeek)
+	}
+	if schedule := input.Schedule.AbsoluteMonthly; schedule != nil {
+		window.Frequency = "AbsoluteMonthly"
+		window.Interval = schedule.IntervalMonths
+		window.DayOfMonth = schedule.DayOfMonth
+	}
+	if schedule := input.Schedule.RelativeMonthly; schedule != nil {
+		window.Frequency = "RelativeMonthly"
+		window.Interval = schedule.IntervalMonths
+		window.DayOfWeek = string(schedule.DayOfWeek)
+		window.WeekIndex = string(schedule.WeekIndex)
+	}
+
+	for _, v := range pointer.From(input.NotAllowedDates) {
+		window.NotAllowed = append(window.NotAllowed, KubernetesClusterMaintenanceConfigurationNotAllowedModel{
+			Start: v.Start + "T00:00:00Z",
+			End:   v.End + "T00:00:00Z",
+		})
+	}
+
+	return []KubernetesClusterMaintenanceConfigurationWindowModel{window}
+}
@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/maintenanceconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KubernetesClusterMaintenanceConfigurationResource struct{}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_default(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_conflictsWithInlineBlock(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.conflictsWithInlineBlock(data),
+			ExpectError: regexp.MustCompile("the `maintenance_window_auto_upgrade` block of the `azurerm_kubernetes_cluster` resource"),
+		},
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_defaultUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.defaultComplete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.defaultConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_autoUpgradeWeekly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoUpgradeWeekly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_autoUpgradeUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoUpgradeWeekly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoUpgradeAbsoluteMonthly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoUpgradeRelativeMonthly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.autoUpgradeWeekly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_nodeOSDaily(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.nodeOSDaily(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterMaintenanceConfiguration_autoUpgradeDailyInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_maintenance_configuration", "test")
+	r := KubernetesClusterMaintenanceConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.autoUpgradeDaily(data),
+			ExpectError: regexp.MustCompile("a `frequency` of `Daily` is only supported when `name` is `aksManagedNodeOSUpgradeSchedule`"),
+		},
+	})
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := maintenanceconfigurations.ParseMaintenanceConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Containers.MaintenanceConfigurationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) defaultConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "default"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  allowed {
+    day   = "Monday"
+    hours = [1, 2]
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "import" {
+  name                  = azurerm_kubernetes_cluster_maintenance_configuration.test.name
+  kubernetes_cluster_id = azurerm_kubernetes_cluster_maintenance_configuration.test.kubernetes_cluster_id
+
+  allowed {
+    day   = "Monday"
+    hours = [1, 2]
+  }
+}
+`, r.defaultConfig(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) defaultComplete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "default"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  allowed {
+    day   = "Monday"
+    hours = [1, 2]
+  }
+
+  allowed {
+    day   = "Sunday"
+    hours = [0]
+  }
+
+  not_allowed {
+    start = "2031-11-26T03:00:00Z"
+    end   = "2031-11-30T12:00:00Z"
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) conflictsWithInlineBlock(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  maintenance_window_auto_upgrade {
+    frequency   = "Weekly"
+    interval    = 1
+    day_of_week = "Monday"
+    start_time  = "07:00"
+    utc_offset  = "+01:00"
+    duration    = 8
+  }
+}
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency   = "Weekly"
+    interval    = 2
+    day_of_week = "Tuesday"
+    start_time  = "07:00"
+    duration    = 8
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) autoUpgradeWeekly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency   = "Weekly"
+    interval    = 1
+    day_of_week = "Monday"
+    start_time  = "07:00"
+    utc_offset  = "+01:00"
+    duration    = 8
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) autoUpgradeAbsoluteMonthly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency    = "AbsoluteMonthly"
+    interval     = 1
+    day_of_month = 5
+    start_date   = "2031-01-01T00:00:00Z"
+    start_time   = "02:00"
+    utc_offset   = "+00:00"
+    duration     = 6
+
+    not_allowed {
+      start = "2031-12-20T00:00:00Z"
+      end   = "2032-01-05T00:00:00Z"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) autoUpgradeRelativeMonthly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency   = "RelativeMonthly"
+    interval    = 2
+    day_of_week = "Tuesday"
+    week_index  = "First"
+    start_time  = "03:00"
+    utc_offset  = "-05:00"
+    duration    = 4
+
+    not_allowed {
+      start = "2031-11-26T00:00:00Z"
+      end   = "2031-11-30T00:00:00Z"
+    }
+
+    not_allowed {
+      start = "2031-12-20T00:00:00Z"
+      end   = "2032-01-05T00:00:00Z"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) autoUpgradeDaily(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedAutoUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency  = "Daily"
+    interval   = 1
+    start_time = "07:00"
+    duration   = 8
+  }
+}
+`, r.template(data))
+}
+
+func (r KubernetesClusterMaintenanceConfigurationResource) nodeOSDaily(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_cluster_maintenance_configuration" "test" {
+  name                  = "aksManagedNodeOSUpgradeSchedule"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+
+  maintenance_window {
+    frequency  = "Daily"
+    interval   = 1
+    start_time = "07:00"
+    utc_offset = "+01:00"
+    duration   = 16
+  }
+}
+`, r.template(data))
+}
+
+func (KubernetesClusterMaintenanceConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  lifecycle {
+    ignore_changes = [maintenance_window, maintenance_window_auto_upgrade, maintenance_window_node_os]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -20,9 +20,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-06-02-preview/agentpools"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-06-02-preview/maintenanceconfigurations"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-06-02-preview/managedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/maintenanceconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
 	dnsValidate "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
@@ -110,9 +110,20 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("network_profile.0.network_policy", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) != "" || new.(string) != string(managedclusters.NetworkPolicyCilium)
 			}),
+			// node auto-provisioning can be enabled on an existing cluster, but can't be disabled once enabled
+			pluginsdk.ForceNewIfChange("node_provisioning_profile.0.mode", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(string) == string(managedclusters.NodeProvisioningModeAuto) && new.(string) != string(managedclusters.NodeProvisioningModeAuto)
+			}),
 			pluginsdk.ForceNewIfChange("custom_ca_trust_certificates_base64", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// the API doesn't support removing the override settings, only disabling them
+				if old, new := d.GetChange("upgrade_override"); len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0 {
+					return fmt.Errorf("`upgrade_override` cannot be removed once set, set `force_upgrade_enabled` to `false` instead")
+				}
+				return nil
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -1262,6 +1273,22 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"node_provisioning_profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(managedclusters.PossibleValuesForNodeProvisioningMode(), false),
+						},
+					},
+				},
+			},
+
 			"node_resource_group": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -1452,6 +1479,27 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"upgrade_override": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"force_upgrade_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"effective_until": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppress.RFC3339Time,
+							ValidateFunc:     validation.IsRFC3339Time,
+						},
+					},
+				},
+			},
+
 			"windows_profile": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1516,12 +1564,14 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							Default:  false,
 						},
 						"vertical_pod_autoscaler_update_mode": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
+							Type:       pluginsdk.TypeString,
+							Computed:   true,
+							Deprecated: "`vertical_pod_autoscaler_update_mode` is no longer returned by the AKS API and will be removed in version 4.0 of the provider.",
 						},
 						"vertical_pod_autoscaler_controlled_values": {
-							Type:     pluginsdk.TypeString,
-							Computed: true,
+							Type:       pluginsdk.TypeString,
+							Computed:   true,
+							Deprecated: "`vertical_pod_autoscaler_controlled_values` is no longer returned by the AKS API and will be removed in version 4.0 of the provider.",
 						},
 					},
 				},
@@ -1749,7 +1799,7 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		ExtendedLocation: expandEdgeZone(d.Get("edge_zone").(string)),
 		Location:         location,
 		Sku: &managedclusters.ManagedClusterSKU{
-			Name: pointer.To(managedclusters.ManagedClusterSKUNameBase), // the only possible value at this point
+			Name: pointer.To(managedclusters.ManagedClusterSKUNameBase), // the only possible value at this point, see the TODO on `SchemaDefaultNodePool`
 			Tier: pointer.To(managedclusters.ManagedClusterSKUTier(d.Get("sku_tier").(string))),
 		},
 		Properties: &managedclusters.ManagedClusterProperties{
@@ -1830,6 +1880,14 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.Properties.SupportPlan = pointer.To(managedclusters.KubernetesSupportPlan(v))
 	}
 
+	if v, ok := d.GetOk("upgrade_override"); ok {
+		parameters.Properties.UpgradeSettings = expandKubernetesClusterUpgradeOverride(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("node_provisioning_profile"); ok {
+		parameters.Properties.NodeProvisioningProfile = expandKubernetesClusterNodeProvisioningProfile(v.([]interface{}))
+	}
+
 	if ingressProfile := expandKubernetesClusterIngressProfile(d, d.Get("web_app_routing").([]interface{})); ingressProfile != nil {
 		parameters.Properties.IngressProfile = ingressProfile
 	}
@@ -2159,6 +2217,11 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 			existing.Model.Properties.NetworkProfile.NetworkDataplane = pointer.To(managedclusters.NetworkDataplane(ebpfDataPlane))
 		}
 	}
+	if d.HasChange("node_provisioning_profile") {
+		updateCluster = true
+		existing.Model.Properties.NodeProvisioningProfile = expandKubernetesClusterNodeProvisioningProfile(d.Get("node_provisioning_profile").([]interface{}))
+	}
+
 	if d.HasChange("service_mesh_profile") {
 		updateCluster = true
 		if serviceMeshProfile := expandKubernetesClusterServiceMeshProfile(d.Get("service_mesh_profile").([]interface{}), existing.Model.Properties.ServiceMeshProfile); serviceMeshProfile != nil {
@@ -2172,6 +2235,11 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		existing.Model.Tags = tags.Expand(t)
 	}
 
+	if d.HasChange("upgrade_override") {
+		updateCluster = true
+		existing.Model.Properties.UpgradeSettings = expandKubernetesClusterUpgradeOverride(d.Get("upgrade_override").([]interface{}))
+	}
+
 	if d.HasChange("windows_profile") {
 		updateCluster = true
 		windowsProfileRaw := d.Get("windows_profile").([]interface{})
@@ -2614,6 +2682,10 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			nodeResourceGroupId := commonids.NewResourceGroupID(id.SubscriptionId, nodeResourceGroup)
 			d.Set("node_resource_group_id", nodeResourceGroupId.ID())
 
+			if err := d.Set("node_provisioning_profile", flattenKubernetesClusterNodeProvisioningProfile(props.NodeProvisioningProfile)); err != nil {
+				return fmt.Errorf("setting `node_provisioning_profile`: %+v", err)
+			}
+
 			upgradeChannel := ""
 			nodeOSUpgradeChannel := ""
 			if profile := props.AutoUpgradeProfile; profile != nil {
@@ -2825,6 +2897,10 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			}
 
 			d.Set("support_plan", pointer.From(props.SupportPlan))
+
+			if err := d.Set("upgrade_override", flattenKubernetesClusterUpgradeOverride(props.UpgradeSettings)); err != nil {
+				return fmt.Errorf("setting `upgrade_override`: %+v", err)
+			}
 		}
 
 		identity, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
@@ -3183,20 +3259,17 @@ func flattenKubernetesClusterWorkloadAutoscalerProfile(profile *managedclusters.
 	}
 
 	vpaEnabled := false
-	controlledValues := ""
-	updateMode := ""
 	if v := profile.VerticalPodAutoscaler; v != nil && v.Enabled {
 		vpaEnabled = v.Enabled
-		controlledValues = string(v.ControlledValues)
-		updateMode = string(v.UpdateMode)
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"keda_enabled":                              kedaEnabled,
-			"vertical_pod_autoscaler_enabled":           vpaEnabled,
-			"vertical_pod_autoscaler_update_mode":       updateMode,
-			"vertical_pod_autoscaler_controlled_values": controlledValues,
+			"keda_enabled":                    kedaEnabled,
+			"vertical_pod_autoscaler_enabled": vpaEnabled,
+			// these are no longer returned by the API as of 2023-09-02-preview
+			"vertical_pod_autoscaler_update_mode":       "",
+			"vertical_pod_autoscaler_controlled_values": "",
 		},
 	}
 }
@@ -4286,6 +4359,64 @@ func flattenKubernetesClusterMaintenanceConfigurationTimeInWeeks(input *[]mainte
 	return results
 }
 
+func expandKubernetesClusterUpgradeOverride(input []interface{}) *managedclusters.ClusterUpgradeSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	output := &managedclusters.ClusterUpgradeSettings{
+		OverrideSettings: &managedclusters.UpgradeOverrideSettings{
+			ForceUpgrade: pointer.To(raw["force_upgrade_enabled"].(bool)),
+		},
+	}
+
+	if v := raw["effective_until"].(string); v != "" {
+		output.OverrideSettings.Until = pointer.To(v)
+	}
+
+	return output
+}
+
+func flattenKubernetesClusterUpgradeOverride(input *managedclusters.ClusterUpgradeSettings) []interface{} {
+	if input == nil || input.OverrideSettings == nil || (input.OverrideSettings.ForceUpgrade == nil && input.OverrideSettings.Until == nil) {
+		return []interface{}{}
+	}
+
+	forceUpgrade := pointer.From(input.OverrideSettings.ForceUpgrade)
+	effectiveUntil := pointer.From(input.OverrideSettings.Until)
+
+	return []interface{}{
+		map[string]interface{}{
+			"force_upgrade_enabled": forceUpgrade,
+			"effective_until":       effectiveUntil,
+		},
+	}
+}
+
+func expandKubernetesClusterNodeProvisioningProfile(input []interface{}) *managedclusters.ManagedClusterNodeProvisioningProfile {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	raw := input[0].(map[string]interface{})
+
+	return &managedclusters.ManagedClusterNodeProvisioningProfile{
+		Mode: pointer.To(managedclusters.NodeProvisioningMode(raw["mode"].(string))),
+	}
+}
+
+func flattenKubernetesClusterNodeProvisioningProfile(input *managedclusters.ManagedClusterNodeProvisioningProfile) []interface{} {
+	if input == nil || input.Mode == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode": string(*input.Mode),
+		},
+	}
+}
+
 func expandKubernetesClusterHttpProxyConfig(input []interface{}) *managedclusters.ManagedClusterHTTPProxyConfig {
 	httpProxyConfig := managedclusters.ManagedClusterHTTPProxyConfig{}
 	if len(input) == 0 || input[0] == nil {
@@ -4516,7 +4647,7 @@ func expandKubernetesClusterIngressProfile(d *pluginsdk.ResourceData, input []in
 		config := input[0].(map[string]interface{})
 		dnsZoneResourceId := config["dns_zone_id"].(string)
 		if dnsZoneResourceId != "" {
-			out.WebAppRouting.DnsZoneResourceId = utils.String(dnsZoneResourceId)
+			out.WebAppRouting.DnsZoneResourceIds = &[]string{dnsZoneResourceId}
 		}
 	}
 	return &out
@@ -4528,8 +4659,8 @@ func flattenKubernetesClusterIngressProfile(input *managedclusters.ManagedCluste
 	}
 
 	dnsZoneId := ""
-	if v := input.WebAppRouting.DnsZoneResourceId; v != nil {
-		dnsZoneId = *v
+	if v := input.WebAppRouting.DnsZoneResourceIds; v != nil && len(*v) > 0 {
+		dnsZoneId = (*v)[0]
 	}
 
 	webAppRoutingIdentity := []interface{}{}
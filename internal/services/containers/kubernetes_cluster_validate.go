@@ -12,8 +12,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-06-02-preview/agentpools"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-06-02-preview/managedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/agentpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/managedclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -56,6 +57,12 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *managedcluste
 		}
 	}
 
+	if mode := d.Get("node_provisioning_profile.0.mode").(string); mode == string(managedclusters.NodeProvisioningModeAuto) {
+		if err := validateKubernetesClusterNodeAutoProvisioning(d); err != nil {
+			return err
+		}
+	}
+
 	// @tombuildsstuff: As of 2020-03-30 it's no longer possible to create a cluster using a Service Principal
 	// for authentication (albeit this worked on 2020-03-27 via API version 2019-10-01 :shrug:). However it's
 	// possible to rotate the Service Principal for an existing Cluster - so this needs to be supported via
@@ -135,6 +142,27 @@ func validateKubernetesCluster(d *pluginsdk.ResourceData, cluster *managedcluste
 	return nil
 }
 
+// validateKubernetesClusterNodeAutoProvisioning checks the prerequisites for node auto-provisioning, which requires
+// Azure CNI Overlay powered by Cilium and can't be used alongside the Cluster Autoscaler
+func validateKubernetesClusterNodeAutoProvisioning(d *pluginsdk.ResourceData) error {
+	networkPlugin := d.Get("network_profile.0.network_plugin").(string)
+	networkPluginMode := d.Get("network_profile.0.network_plugin_mode").(string)
+	networkDataPlane := d.Get("network_profile.0.ebpf_data_plane").(string)
+	if !strings.EqualFold(networkPlugin, string(managedclusters.NetworkPluginAzure)) || !strings.EqualFold(networkPluginMode, string(managedclusters.NetworkPluginModeOverlay)) || !strings.EqualFold(networkDataPlane, string(managedclusters.NetworkDataplaneCilium)) {
+		return fmt.Errorf("`node_provisioning_profile.0.mode` can only be set to `%s` when `network_profile.0.network_plugin` is `%s`, `network_profile.0.network_plugin_mode` is `%s` and `network_profile.0.ebpf_data_plane` is `%s`", managedclusters.NodeProvisioningModeAuto, managedclusters.NetworkPluginAzure, managedclusters.NetworkPluginModeOverlay, managedclusters.NetworkDataplaneCilium)
+	}
+
+	autoScalingKey := "default_node_pool.0.enable_auto_scaling"
+	if features.FourPointOhBeta() {
+		autoScalingKey = "default_node_pool.0.auto_scaling_enabled"
+	}
+	if d.Get(autoScalingKey).(bool) {
+		return fmt.Errorf("`%s` must be `false` when `node_provisioning_profile.0.mode` is `%s`", autoScalingKey, managedclusters.NodeProvisioningModeAuto)
+	}
+
+	return nil
+}
+
 var existingClusterCommonErr = `
 Azure Kubernetes Service has recently made several breaking changes to Cluster Authentication as
 the Managed Identity Preview has concluded and entered General Availability.
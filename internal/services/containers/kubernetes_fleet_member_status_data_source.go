@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/fleetmembers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesFleetMemberStatusDataSourceModel struct {
+	Name                       string `tfschema:"name"`
+	KubernetesFleetId          string `tfschema:"kubernetes_fleet_id"`
+	KubernetesFleetUpdateRunId string `tfschema:"kubernetes_fleet_update_run_id"`
+	KubernetesClusterId        string `tfschema:"kubernetes_cluster_id"`
+	Group                      string `tfschema:"group"`
+	ProvisioningState          string `tfschema:"provisioning_state"`
+	UpdateStageName            string `tfschema:"update_stage_name"`
+	UpdateState                string `tfschema:"update_state"`
+	UpdateMessage              string `tfschema:"update_message"`
+	UpdateStartTime            string `tfschema:"update_start_time"`
+	UpdateCompletedTime        string `tfschema:"update_completed_time"`
+}
+
+type KubernetesFleetMemberStatusDataSource struct{}
+
+var _ sdk.DataSource = KubernetesFleetMemberStatusDataSource{}
+
+func (r KubernetesFleetMemberStatusDataSource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_member_status"
+}
+
+func (r KubernetesFleetMemberStatusDataSource) ModelObject() interface{} {
+	return &KubernetesFleetMemberStatusDataSourceModel{}
+}
+
+func (r KubernetesFleetMemberStatusDataSource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleetmembers.ValidateMemberID
+}
+
+func (r KubernetesFleetMemberStatusDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kubernetes_fleet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateKubernetesFleetID,
+		},
+
+		"kubernetes_fleet_update_run_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: updateruns.ValidateUpdateRunID,
+		},
+	}
+}
+
+func (r KubernetesFleetMemberStatusDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_cluster_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"group": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"update_stage_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"update_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"update_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"update_start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"update_completed_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesFleetMemberStatusDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			membersClient := metadata.Client.ContainerService.V20231015.FleetMembers
+			updateRunsClient := metadata.Client.ContainerService.V20231015.UpdateRuns
+
+			var state KubernetesFleetMemberStatusDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := commonids.ParseKubernetesFleetID(state.KubernetesFleetId)
+			if err != nil {
+				return err
+			}
+
+			id := fleetmembers.NewMemberID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, state.Name)
+
+			resp, err := membersClient.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					clusterId, err := commonids.ParseKubernetesClusterIDInsensitively(props.ClusterResourceId)
+					if err != nil {
+						return err
+					}
+					state.KubernetesClusterId = clusterId.ID()
+					state.Group = pointer.From(props.Group)
+					state.ProvisioningState = string(pointer.From(props.ProvisioningState))
+				}
+			}
+
+			if state.KubernetesFleetUpdateRunId != "" {
+				updateRunId, err := updateruns.ParseUpdateRunID(state.KubernetesFleetUpdateRunId)
+				if err != nil {
+					return err
+				}
+
+				if !strings.EqualFold(updateRunId.FleetName, id.FleetName) || !strings.EqualFold(updateRunId.ResourceGroupName, id.ResourceGroupName) {
+					return fmt.Errorf("%s does not belong to %s", *updateRunId, *fleetId)
+				}
+
+				updateRun, err := updateRunsClient.Get(ctx, *updateRunId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %v", *updateRunId, err)
+				}
+
+				if model := updateRun.Model; model != nil && model.Properties != nil && model.Properties.Status != nil {
+					flattenKubernetesFleetMemberUpdateStatus(&state, id.MemberName, model.Properties.Status.Stages)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenKubernetesFleetMemberUpdateStatus sets the status of the named member within the Update Run, if it's included
+// in any of the stages - members which aren't part of the Update Run leave these fields empty.
+func flattenKubernetesFleetMemberUpdateStatus(state *KubernetesFleetMemberStatusDataSourceModel, memberName string, stages *[]updateruns.UpdateStageStatus) {
+	if stages == nil {
+		return
+	}
+
+	for _, stage := range *stages {
+		if stage.Groups == nil {
+			continue
+		}
+		for _, group := range *stage.Groups {
+			if group.Members == nil {
+				continue
+			}
+			for _, member := range *group.Members {
+				if !strings.EqualFold(pointer.From(member.Name), memberName) {
+					continue
+				}
+
+				state.UpdateStageName = pointer.From(stage.Name)
+				state.UpdateMessage = pointer.From(member.Message)
+				if status := member.Status; status != nil {
+					state.UpdateState = string(pointer.From(status.State))
+					state.UpdateStartTime = pointer.From(status.StartTime)
+					state.UpdateCompletedTime = pointer.From(status.CompletedTime)
+				}
+				return
+			}
+		}
+	}
+}
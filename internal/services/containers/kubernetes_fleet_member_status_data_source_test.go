@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesFleetMemberStatusDataSource struct{}
+
+func TestAccKubernetesFleetMemberStatusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_fleet_member_status", "test")
+	r := KubernetesFleetMemberStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kubernetes_cluster_id").Exists(),
+				check.That(data.ResourceName).Key("group").HasValue(fmt.Sprintf("acctestfur-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+			),
+		},
+	})
+}
+
+func TestAccKubernetesFleetMemberStatusDataSource_updateRun(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_fleet_member_status", "test")
+	r := KubernetesFleetMemberStatusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.updateRun(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("update_stage_name").Exists(),
+				check.That(data.ResourceName).Key("update_state").Exists(),
+			),
+		},
+	})
+}
+
+func (KubernetesFleetMemberStatusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_fleet_member_status" "test" {
+  name                = azurerm_kubernetes_fleet_member.test.name
+  kubernetes_fleet_id = azurerm_kubernetes_fleet_manager.test.id
+}
+`, KubernetesFleetUpdateRunTestResource{}.template(data))
+}
+
+func (KubernetesFleetMemberStatusDataSource) updateRun(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_fleet_member_status" "test" {
+  name                           = azurerm_kubernetes_fleet_member.test.name
+  kubernetes_fleet_id            = azurerm_kubernetes_fleet_manager.test.id
+  kubernetes_fleet_update_run_id = azurerm_kubernetes_fleet_update_run.test.id
+}
+`, KubernetesFleetUpdateRunTestResource{}.started(data))
+}
@@ -0,0 +1,524 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.Resource = KubernetesFleetUpdateRunResource{}
+var _ sdk.ResourceWithUpdate = KubernetesFleetUpdateRunResource{}
+var _ sdk.ResourceWithCustomizeDiff = KubernetesFleetUpdateRunResource{}
+
+type KubernetesFleetUpdateRunResource struct{}
+
+func (r KubernetesFleetUpdateRunResource) ModelObject() interface{} {
+	return &KubernetesFleetUpdateRunResourceSchema{}
+}
+
+type KubernetesFleetUpdateRunResourceSchema struct {
+	KubernetesFleetManagerId string                                                 `tfschema:"kubernetes_fleet_manager_id"`
+	Name                     string                                                 `tfschema:"name"`
+	FleetUpdateStrategyId    string                                                 `tfschema:"fleet_update_strategy_id"`
+	ManagedClusterUpdate     []KubernetesFleetUpdateRunResourceManagedClusterUpdate `tfschema:"managed_cluster_update"`
+	Stage                    []KubernetesFleetUpdateRunResourceUpdateStageSchema    `tfschema:"stage"`
+	StartEnabled             bool                                                   `tfschema:"start_enabled"`
+	Status                   string                                                 `tfschema:"status"`
+}
+
+type KubernetesFleetUpdateRunResourceManagedClusterUpdate struct {
+	Upgrade            []KubernetesFleetUpdateRunResourceUpgrade            `tfschema:"upgrade"`
+	NodeImageSelection []KubernetesFleetUpdateRunResourceNodeImageSelection `tfschema:"node_image_selection"`
+}
+
+type KubernetesFleetUpdateRunResourceUpgrade struct {
+	Type              string `tfschema:"type"`
+	KubernetesVersion string `tfschema:"kubernetes_version"`
+}
+
+type KubernetesFleetUpdateRunResourceNodeImageSelection struct {
+	Type string `tfschema:"type"`
+}
+
+type KubernetesFleetUpdateRunResourceUpdateGroupSchema struct {
+	Name string `tfschema:"name"`
+}
+
+type KubernetesFleetUpdateRunResourceUpdateStageSchema struct {
+	AfterStageWaitInSeconds int64                                               `tfschema:"after_stage_wait_in_seconds"`
+	Group                   []KubernetesFleetUpdateRunResourceUpdateGroupSchema `tfschema:"group"`
+	Name                    string                                              `tfschema:"name"`
+}
+
+func (r KubernetesFleetUpdateRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return updateruns.ValidateUpdateRunID
+}
+
+func (r KubernetesFleetUpdateRunResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_update_run"
+}
+
+func (r KubernetesFleetUpdateRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			ForceNew:     true,
+			Required:     true,
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kubernetes_fleet_manager_id": commonschema.ResourceIDReferenceRequiredForceNew(&updateruns.FleetId{}),
+
+		"managed_cluster_update": {
+			Required: true,
+			Type:     pluginsdk.TypeList,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"upgrade": {
+						Required: true,
+						Type:     pluginsdk.TypeList,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Required:     true,
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForManagedClusterUpgradeType(), false),
+								},
+
+								"kubernetes_version": {
+									Optional:     true,
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"node_image_selection": {
+						Optional: true,
+						Type:     pluginsdk.TypeList,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Required:     true,
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice(updateruns.PossibleValuesForNodeImageSelectionType(), false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"fleet_update_strategy_id": {
+			Optional:      true,
+			Type:          pluginsdk.TypeString,
+			ValidateFunc:  fleetupdatestrategies.ValidateUpdateStrategyID,
+			ConflictsWith: []string{"stage"},
+		},
+
+		"stage": {
+			Optional:      true,
+			Type:          pluginsdk.TypeList,
+			ConflictsWith: []string{"fleet_update_strategy_id"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Required:     true,
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"group": {
+						Required: true,
+						Type:     pluginsdk.TypeList,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Required:     true,
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"after_stage_wait_in_seconds": {
+						Optional: true,
+						Type:     pluginsdk.TypeInt,
+					},
+				},
+			},
+		},
+
+		"start_enabled": {
+			Optional: true,
+			Type:     pluginsdk.TypeBool,
+			Default:  false,
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Computed: true,
+			Type:     pluginsdk.TypeString,
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config KubernetesFleetUpdateRunResourceSchema
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			for _, update := range config.ManagedClusterUpdate {
+				for _, upgrade := range update.Upgrade {
+					switch updateruns.ManagedClusterUpgradeType(upgrade.Type) {
+					case updateruns.ManagedClusterUpgradeTypeFull:
+						if upgrade.KubernetesVersion == "" && metadata.ResourceDiff.NewValueKnown("managed_cluster_update.0.upgrade.0.kubernetes_version") {
+							return fmt.Errorf("`kubernetes_version` must be specified when the upgrade `type` is `%s`", updateruns.ManagedClusterUpgradeTypeFull)
+						}
+					case updateruns.ManagedClusterUpgradeTypeNodeImageOnly:
+						if upgrade.KubernetesVersion != "" {
+							return fmt.Errorf("`kubernetes_version` cannot be specified when the upgrade `type` is `%s`", updateruns.ManagedClusterUpgradeTypeNodeImageOnly)
+						}
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.V20231015.UpdateRuns
+
+			var config KubernetesFleetUpdateRunResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := commonids.ParseKubernetesFleetID(config.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := updateruns.NewUpdateRunID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := updateruns.UpdateRun{
+				Properties: &updateruns.UpdateRunProperties{
+					ManagedClusterUpdate: expandKubernetesFleetUpdateRunManagedClusterUpdate(config.ManagedClusterUpdate),
+				},
+			}
+
+			if config.FleetUpdateStrategyId != "" {
+				payload.Properties.UpdateStrategyId = pointer.To(config.FleetUpdateStrategyId)
+			}
+
+			if len(config.Stage) > 0 {
+				payload.Properties.Strategy = &updateruns.UpdateRunStrategy{
+					Stages: expandKubernetesFleetUpdateRunStage(config.Stage),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload, updateruns.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if config.StartEnabled {
+				if err := client.StartThenPoll(ctx, id, updateruns.DefaultStartOperationOptions()); err != nil {
+					return fmt.Errorf("starting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.V20231015.UpdateRuns
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesFleetUpdateRunResourceSchema
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("managed_cluster_update", "fleet_update_strategy_id", "stage") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving existing %s: %+v", *id, err)
+				}
+				if existing.Model == nil || existing.Model.Properties == nil {
+					return fmt.Errorf("retrieving existing %s: properties was nil", *id)
+				}
+				payload := *existing.Model
+
+				// the status is read-only and is rejected by the API if sent back
+				payload.Properties.Status = nil
+
+				if metadata.ResourceData.HasChange("managed_cluster_update") {
+					payload.Properties.ManagedClusterUpdate = expandKubernetesFleetUpdateRunManagedClusterUpdate(config.ManagedClusterUpdate)
+				}
+
+				if metadata.ResourceData.HasChange("fleet_update_strategy_id") {
+					payload.Properties.UpdateStrategyId = nil
+					if config.FleetUpdateStrategyId != "" {
+						payload.Properties.UpdateStrategyId = pointer.To(config.FleetUpdateStrategyId)
+					}
+				}
+
+				if metadata.ResourceData.HasChange("stage") {
+					payload.Properties.Strategy = nil
+					if len(config.Stage) > 0 {
+						payload.Properties.Strategy = &updateruns.UpdateRunStrategy{
+							Stages: expandKubernetesFleetUpdateRunStage(config.Stage),
+						}
+					}
+				}
+
+				if err := client.CreateOrUpdateThenPoll(ctx, *id, payload, updateruns.DefaultCreateOrUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("start_enabled") {
+				if config.StartEnabled {
+					if err := client.StartThenPoll(ctx, *id, updateruns.DefaultStartOperationOptions()); err != nil {
+						return fmt.Errorf("starting %s: %+v", *id, err)
+					}
+				} else {
+					if err := client.StopThenPoll(ctx, *id, updateruns.DefaultStopOperationOptions()); err != nil {
+						return fmt.Errorf("stopping %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.V20231015.UpdateRuns
+			schema := KubernetesFleetUpdateRunResourceSchema{}
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			schema.Name = id.UpdateRunName
+			schema.KubernetesFleetManagerId = commonids.NewKubernetesFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID()
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					schema.ManagedClusterUpdate = flattenKubernetesFleetUpdateRunManagedClusterUpdate(props.ManagedClusterUpdate)
+
+					if props.UpdateStrategyId != nil {
+						strategyId, err := fleetupdatestrategies.ParseUpdateStrategyIDInsensitively(*props.UpdateStrategyId)
+						if err != nil {
+							return err
+						}
+						schema.FleetUpdateStrategyId = strategyId.ID()
+					} else if props.Strategy != nil {
+						// when a Fleet Update Strategy is referenced the API also returns its stages, so these are only
+						// flattened when the stages have been specified inline
+						schema.Stage = flattenKubernetesFleetUpdateRunStage(props.Strategy.Stages)
+					}
+
+					state := updateruns.UpdateStateNotStarted
+					if props.Status != nil && props.Status.Status != nil && props.Status.Status.State != nil {
+						state = *props.Status.Status.State
+					}
+					schema.Status = string(state)
+					schema.StartEnabled = state != updateruns.UpdateStateNotStarted && state != updateruns.UpdateStateStopping && state != updateruns.UpdateStateStopped
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r KubernetesFleetUpdateRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.V20231015.UpdateRuns
+
+			id, err := updateruns.ParseUpdateRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// an Update Run which is in progress must be stopped before it can be deleted
+			if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Status != nil {
+				if status := model.Properties.Status.Status; status != nil && pointer.From(status.State) == updateruns.UpdateStateRunning {
+					if err := client.StopThenPoll(ctx, *id, updateruns.DefaultStopOperationOptions()); err != nil {
+						return fmt.Errorf("stopping %s: %+v", *id, err)
+					}
+				}
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, updateruns.DefaultDeleteOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetUpdateRunManagedClusterUpdate(input []KubernetesFleetUpdateRunResourceManagedClusterUpdate) updateruns.ManagedClusterUpdate {
+	output := updateruns.ManagedClusterUpdate{}
+	if len(input) == 0 {
+		return output
+	}
+	update := input[0]
+
+	if len(update.Upgrade) > 0 {
+		output.Upgrade = updateruns.ManagedClusterUpgradeSpec{
+			Type: updateruns.ManagedClusterUpgradeType(update.Upgrade[0].Type),
+		}
+		if v := update.Upgrade[0].KubernetesVersion; v != "" {
+			output.Upgrade.KubernetesVersion = pointer.To(v)
+		}
+	}
+
+	if len(update.NodeImageSelection) > 0 {
+		output.NodeImageSelection = &updateruns.NodeImageSelection{
+			Type: updateruns.NodeImageSelectionType(update.NodeImageSelection[0].Type),
+		}
+	}
+
+	return output
+}
+
+func flattenKubernetesFleetUpdateRunManagedClusterUpdate(input updateruns.ManagedClusterUpdate) []KubernetesFleetUpdateRunResourceManagedClusterUpdate {
+	output := KubernetesFleetUpdateRunResourceManagedClusterUpdate{
+		Upgrade: []KubernetesFleetUpdateRunResourceUpgrade{
+			{
+				Type:              string(input.Upgrade.Type),
+				KubernetesVersion: pointer.From(input.Upgrade.KubernetesVersion),
+			},
+		},
+	}
+
+	if input.NodeImageSelection != nil {
+		output.NodeImageSelection = []KubernetesFleetUpdateRunResourceNodeImageSelection{
+			{
+				Type: string(input.NodeImageSelection.Type),
+			},
+		}
+	}
+
+	return []KubernetesFleetUpdateRunResourceManagedClusterUpdate{output}
+}
+
+func expandKubernetesFleetUpdateRunStage(input []KubernetesFleetUpdateRunResourceUpdateStageSchema) []updateruns.UpdateStage {
+	output := make([]updateruns.UpdateStage, 0)
+	for _, stage := range input {
+		groups := make([]updateruns.UpdateGroup, 0)
+		for _, group := range stage.Group {
+			groups = append(groups, updateruns.UpdateGroup{
+				Name: group.Name,
+			})
+		}
+
+		output = append(output, updateruns.UpdateStage{
+			Name:                    stage.Name,
+			AfterStageWaitInSeconds: pointer.FromInt64(stage.AfterStageWaitInSeconds),
+			Groups:                  &groups,
+		})
+	}
+	return output
+}
+
+func flattenKubernetesFleetUpdateRunStage(input []updateruns.UpdateStage) []KubernetesFleetUpdateRunResourceUpdateStageSchema {
+	output := make([]KubernetesFleetUpdateRunResourceUpdateStageSchema, 0)
+	for _, stage := range input {
+		groups := make([]KubernetesFleetUpdateRunResourceUpdateGroupSchema, 0)
+		if stage.Groups != nil {
+			for _, group := range *stage.Groups {
+				groups = append(groups, KubernetesFleetUpdateRunResourceUpdateGroupSchema{
+					Name: group.Name,
+				})
+			}
+		}
+
+		output = append(output, KubernetesFleetUpdateRunResourceUpdateStageSchema{
+			Name:                    stage.Name,
+			AfterStageWaitInSeconds: pointer.ToInt64(stage.AfterStageWaitInSeconds),
+			Group:                   groups,
+		})
+	}
+	return output
+}
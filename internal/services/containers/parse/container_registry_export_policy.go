@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerRegistryExportPolicyId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	RegistryName     string
+	ExportPolicyName string
+}
+
+func NewContainerRegistryExportPolicyID(subscriptionId, resourceGroup, registryName, exportPolicyName string) ContainerRegistryExportPolicyId {
+	return ContainerRegistryExportPolicyId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		RegistryName:     registryName,
+		ExportPolicyName: exportPolicyName,
+	}
+}
+
+func (id ContainerRegistryExportPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Export Policy Name %q", id.ExportPolicyName),
+		fmt.Sprintf("Registry Name %q", id.RegistryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Registry Export Policy", segmentsStr)
+}
+
+func (id ContainerRegistryExportPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s/exportPolicy/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.ExportPolicyName)
+}
+
+// ContainerRegistryExportPolicyID parses a ContainerRegistryExportPolicy ID into an ContainerRegistryExportPolicyId struct
+func ContainerRegistryExportPolicyID(input string) (*ContainerRegistryExportPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ContainerRegistryExportPolicy ID: %+v", input, err)
+	}
+
+	resourceId := ContainerRegistryExportPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+		return nil, err
+	}
+	if resourceId.ExportPolicyName, err = id.PopSegment("exportPolicy"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
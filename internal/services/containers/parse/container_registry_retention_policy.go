@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ContainerRegistryRetentionPolicyId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	RegistryName        string
+	RetentionPolicyName string
+}
+
+func NewContainerRegistryRetentionPolicyID(subscriptionId, resourceGroup, registryName, retentionPolicyName string) ContainerRegistryRetentionPolicyId {
+	return ContainerRegistryRetentionPolicyId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		RegistryName:        registryName,
+		RetentionPolicyName: retentionPolicyName,
+	}
+}
+
+func (id ContainerRegistryRetentionPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Retention Policy Name %q", id.RetentionPolicyName),
+		fmt.Sprintf("Registry Name %q", id.RegistryName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Container Registry Retention Policy", segmentsStr)
+}
+
+func (id ContainerRegistryRetentionPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerRegistry/registries/%s/retentionPolicy/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RegistryName, id.RetentionPolicyName)
+}
+
+// ContainerRegistryRetentionPolicyID parses a ContainerRegistryRetentionPolicy ID into an ContainerRegistryRetentionPolicyId struct
+func ContainerRegistryRetentionPolicyID(input string) (*ContainerRegistryRetentionPolicyId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ContainerRegistryRetentionPolicy ID: %+v", input, err)
+	}
+
+	resourceId := ContainerRegistryRetentionPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+		return nil, err
+	}
+	if resourceId.RetentionPolicyName, err = id.PopSegment("retentionPolicy"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -14,14 +14,12 @@ func SchemaContainerGroupProbe() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
 		Optional: true,
-		ForceNew: true,
 		MaxItems: 1,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
 				"exec": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
-					ForceNew: true,
 					Elem: &pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						ValidateFunc: validation.NoZeroValues,
@@ -32,25 +30,21 @@ func SchemaContainerGroupProbe() *pluginsdk.Schema {
 				"http_get": {
 					Type:     pluginsdk.TypeList,
 					Optional: true,
-					ForceNew: true,
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"path": {
 								Type:         pluginsdk.TypeString,
 								Optional:     true,
-								ForceNew:     true,
 								ValidateFunc: validation.StringIsNotEmpty,
 							},
 							"port": {
 								Type:         pluginsdk.TypeInt,
 								Optional:     true,
-								ForceNew:     true,
 								ValidateFunc: validate.PortNumber,
 							},
 							"scheme": {
 								Type:     pluginsdk.TypeString,
 								Optional: true,
-								ForceNew: true,
 								ValidateFunc: validation.StringInSlice([]string{
 									"Http",
 									"Https",
@@ -59,7 +53,6 @@ func SchemaContainerGroupProbe() *pluginsdk.Schema {
 							"http_headers": {
 								Type:     pluginsdk.TypeMap,
 								Optional: true,
-								ForceNew: true,
 								Elem: &pluginsdk.Schema{
 									Type: pluginsdk.TypeString,
 								},
@@ -71,31 +64,26 @@ func SchemaContainerGroupProbe() *pluginsdk.Schema {
 				"initial_delay_seconds": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
 				},
 
 				"period_seconds": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
 				},
 
 				"failure_threshold": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
 				},
 
 				"success_threshold": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
 				},
 
 				"timeout_seconds": {
 					Type:     pluginsdk.TypeInt,
 					Optional: true,
-					ForceNew: true,
 				},
 			},
 		},
@@ -61,6 +61,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	dataSources := []sdk.DataSource{
 		KubernetesNodePoolSnapshotDataSource{},
+		KubernetesFleetMemberStatusDataSource{},
+		KubernetesClusterCredentialsDataSource{},
+		KubernetesClusterCommandDataSource{},
 	}
 	dataSources = append(dataSources, r.autoRegistration.DataSources()...)
 	return dataSources
@@ -72,9 +75,15 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		ContainerConnectedRegistryResource{},
+		ContainerRegistryCacheRuleResource{},
+		ContainerRegistryCredentialSetResource{},
+		ContainerRegistryExportPolicyResource{},
+		ContainerRegistryRetentionPolicyResource{},
 		KubernetesClusterExtensionResource{},
+		KubernetesClusterMaintenanceConfigurationResource{},
 		KubernetesFluxConfigurationResource{},
 		KubernetesFleetUpdateStrategyResource{},
+		KubernetesFleetUpdateRunResource{},
 	}
 	resources = append(resources, r.autoRegistration.Resources()...)
 	return resources
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package containers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestContainerRegistry_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, containers.Registration{}, "azurerm_container_registry")
+}
+
+func TestContainerRegistryWebhook_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, containers.Registration{}, "azurerm_container_registry_webhook")
+}
+
+func TestKubernetesCluster_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, containers.Registration{}, "azurerm_kubernetes_cluster")
+}
+
+func TestKubernetesClusterNodePool_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, containers.Registration{}, "azurerm_kubernetes_cluster_node_pool")
+}
@@ -354,7 +354,7 @@ func resourceCassandraClusterDelete(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	if err := future.Poller.PollUntilDone(); err != nil {
+	if err := future.Poller.PollUntilDone(ctx); err != nil {
 		return fmt.Errorf("waiting on delete future for %q: %+v", id, err)
 	}
 
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package cosmos_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestCosmosdbCassandraKeyspace_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_cassandra_keyspace")
+}
+
+func TestCosmosdbGremlinDatabase_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_gremlin_database")
+}
+
+func TestCosmosdbGremlinGraph_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_gremlin_graph")
+}
+
+func TestCosmosdbMongoCollection_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_mongo_collection")
+}
+
+func TestCosmosdbMongoDatabase_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_mongo_database")
+}
+
+func TestCosmosdbSqlContainer_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_sql_container")
+}
+
+func TestCosmosdbSqlDatabase_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_sql_database")
+}
+
+func TestCosmosdbTable_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, cosmos.Registration{}, "azurerm_cosmosdb_table")
+}
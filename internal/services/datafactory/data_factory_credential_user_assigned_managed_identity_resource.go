@@ -113,17 +113,20 @@ func (DataFactoryCredentialUserAssignedManagedIdentityResource) Read() sdk.Resou
 			state.Name = credentialId.CredentialName
 
 			if existing.Model != nil {
-				if existing.Model.Properties.Description != nil {
-					state.Description = *existing.Model.Properties.Description
-				}
+				if props, ok := existing.Model.Properties.(credentials.ManagedIdentityCredential); ok {
+					if props.Description != nil {
+						state.Description = *props.Description
+					}
+
+					if props.TypeProperties != nil && props.TypeProperties.ResourceId != nil {
+						state.IdentityId = *props.TypeProperties.ResourceId
+					}
 
-				if existing.Model.Properties.TypeProperties != nil && existing.Model.Properties.TypeProperties.ResourceId != nil {
-					state.IdentityId = *existing.Model.Properties.TypeProperties.ResourceId
+					state.Annotations = flattenDataFactoryAnnotations(props.Annotations)
 				}
 			}
 
 			state.DataFactoryId = factories.NewFactoryID(credentialId.SubscriptionId, credentialId.ResourceGroupName, credentialId.FactoryName).ID()
-			state.Annotations = flattenDataFactoryAnnotations(existing.Model.Properties.Annotations)
 
 			return metadata.Encode(&state)
 		},
@@ -162,12 +165,9 @@ func (r DataFactoryCredentialUserAssignedManagedIdentityResource) Create() sdk.R
 				return tf.ImportAsExistsError("azurerm_data_factory_dataset_http", id.ID())
 			}
 
-			credential := credentials.ManagedIdentityCredentialResource{
-				Type: utils.String(IDENTITY_TYPE),
-				Properties: credentials.ManagedIdentityCredential{
-					TypeProperties: &credentials.ManagedIdentityTypeProperties{
-						ResourceId: &data.IdentityId,
-					},
+			props := credentials.ManagedIdentityCredential{
+				TypeProperties: &credentials.ManagedIdentityTypeProperties{
+					ResourceId: &data.IdentityId,
 				},
 			}
 
@@ -176,11 +176,16 @@ func (r DataFactoryCredentialUserAssignedManagedIdentityResource) Create() sdk.R
 				for i, v := range data.Annotations {
 					annotations[i] = v
 				}
-				credential.Properties.Annotations = &annotations
+				props.Annotations = &annotations
 			}
 
 			if data.Description != "" {
-				credential.Properties.Description = &data.Description
+				props.Description = &data.Description
+			}
+
+			credential := credentials.CredentialResource{
+				Type:       utils.String(IDENTITY_TYPE),
+				Properties: props,
 			}
 
 			_, err = client.CredentialOperationsCreateOrUpdate(ctx, id, credential, credentials.CredentialOperationsCreateOrUpdateOperationOptions{})
@@ -220,9 +225,13 @@ func (r DataFactoryCredentialUserAssignedManagedIdentityResource) Update() sdk.R
 			}
 
 			credential := *existing.Model
+			props, ok := credential.Properties.(credentials.ManagedIdentityCredential)
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected a Managed Identity Credential but got %T", id, credential.Properties)
+			}
 
 			if metadata.ResourceData.HasChange("description") {
-				credential.Properties.Description = &data.Description
+				props.Description = &data.Description
 			}
 
 			if metadata.ResourceData.HasChange("annotations") {
@@ -231,11 +240,12 @@ func (r DataFactoryCredentialUserAssignedManagedIdentityResource) Update() sdk.R
 					for i, v := range data.Annotations {
 						annotations[i] = v
 					}
-					credential.Properties.Annotations = &annotations
+					props.Annotations = &annotations
 				} else {
-					credential.Properties.Annotations = nil
+					props.Annotations = nil
 				}
 			}
+			credential.Properties = props
 
 			_, err = client.CredentialOperationsCreateOrUpdate(ctx, *id, credential, credentials.CredentialOperationsCreateOrUpdateOperationOptions{})
 			if err != nil {
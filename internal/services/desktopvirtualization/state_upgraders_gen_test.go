@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package desktopvirtualization_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestVirtualDesktopApplicationGroup_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, desktopvirtualization.Registration{}, "azurerm_virtual_desktop_application_group")
+}
+
+func TestVirtualDesktopHostPool_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, desktopvirtualization.Registration{}, "azurerm_virtual_desktop_host_pool")
+}
+
+func TestVirtualDesktopWorkspace_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, desktopvirtualization.Registration{}, "azurerm_virtual_desktop_workspace")
+}
+
+func TestVirtualDesktopWorkspaceApplicationGroupAssociation_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, desktopvirtualization.Registration{}, "azurerm_virtual_desktop_workspace_application_group_association")
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package devtestlabs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestDevTestLab_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, devtestlabs.Registration{}, "azurerm_dev_test_lab")
+}
+
+func TestDevTestLinuxVirtualMachine_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, devtestlabs.Registration{}, "azurerm_dev_test_linux_virtual_machine")
+}
+
+func TestDevTestPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, devtestlabs.Registration{}, "azurerm_dev_test_policy")
+}
+
+func TestDevTestSchedule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, devtestlabs.Registration{}, "azurerm_dev_test_schedule")
+}
+
+func TestDevTestVirtualNetwork_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, devtestlabs.Registration{}, "azurerm_dev_test_virtual_network")
+}
+
+func TestDevTestWindowsVirtualMachine_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, devtestlabs.Registration{}, "azurerm_dev_test_windows_virtual_machine")
+}
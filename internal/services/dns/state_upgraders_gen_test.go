@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package dns_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestDnsARecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_a_record")
+}
+
+func TestDnsAaaaRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_aaaa_record")
+}
+
+func TestDnsCaaRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_caa_record")
+}
+
+func TestDnsCnameRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_cname_record")
+}
+
+func TestDnsMxRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_mx_record")
+}
+
+func TestDnsNsRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_ns_record")
+}
+
+func TestDnsPtrRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_ptr_record")
+}
+
+func TestDnsSrvRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_srv_record")
+}
+
+func TestDnsTxtRecord_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_txt_record")
+}
+
+func TestDnsZone_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, dns.Registration{}, "azurerm_dns_zone")
+}
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package eventhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestEventhubAuthorizationRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, eventhub.Registration{}, "azurerm_eventhub_authorization_rule")
+}
+
+func TestEventhubConsumerGroup_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, eventhub.Registration{}, "azurerm_eventhub_consumer_group")
+}
+
+func TestEventhubNamespaceAuthorizationRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, eventhub.Registration{}, "azurerm_eventhub_namespace_authorization_rule")
+}
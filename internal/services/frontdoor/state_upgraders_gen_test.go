@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package frontdoor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestFrontdoor_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, frontdoor.Registration{}, "azurerm_frontdoor")
+}
+
+func TestFrontdoorCustomHttpsConfiguration_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, frontdoor.Registration{}, "azurerm_frontdoor_custom_https_configuration")
+}
+
+func TestFrontdoorFirewallPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, frontdoor.Registration{}, "azurerm_frontdoor_firewall_policy")
+}
+
+func TestFrontdoorRulesEngine_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, frontdoor.Registration{}, "azurerm_frontdoor_rules_engine")
+}
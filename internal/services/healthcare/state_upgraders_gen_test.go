@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package healthcare_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestHealthcareDicomService_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, healthcare.Registration{}, "azurerm_healthcare_dicom_service")
+}
+
+func TestHealthcareFhirService_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, healthcare.Registration{}, "azurerm_healthcare_fhir_service")
+}
+
+func TestHealthcareMedtechService_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, healthcare.Registration{}, "azurerm_healthcare_medtech_service")
+}
+
+func TestHealthcareMedtechServiceFhirDestination_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, healthcare.Registration{}, "azurerm_healthcare_medtech_service_fhir_destination")
+}
@@ -170,7 +170,7 @@ func resourceDedicatedHardwareSecurityModuleCreate(d *pluginsdk.ResourceData, me
 			NetworkProfile:           expandDedicatedHsmNetworkProfile(d.Get("network_profile").([]interface{})),
 			ManagementNetworkProfile: expandDedicatedHsmNetworkProfile(d.Get("management_network_profile").([]interface{})),
 		},
-		Sku: dedicatedhsms.Sku{
+		Sku: &dedicatedhsms.Sku{
 			Name: &skuName,
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
@@ -235,7 +235,7 @@ func resourceDedicatedHardwareSecurityModuleRead(d *pluginsdk.ResourceData, meta
 		d.Set("stamp_id", props.StampId)
 
 		skuName := ""
-		if model.Sku.Name != nil {
+		if model.Sku != nil && model.Sku.Name != nil {
 			skuName = string(*model.Sku.Name)
 		}
 		d.Set("sku_name", skuName)
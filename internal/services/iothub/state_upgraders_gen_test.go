@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package iothub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestIothub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub")
+}
+
+func TestIothubCertificate_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_certificate")
+}
+
+func TestIothubConsumerGroup_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_consumer_group")
+}
+
+func TestIothubEndpointEventhub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_endpoint_eventhub")
+}
+
+func TestIothubEndpointServicebusQueue_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_endpoint_servicebus_queue")
+}
+
+func TestIothubEndpointServicebusTopic_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_endpoint_servicebus_topic")
+}
+
+func TestIothubEndpointStorageContainer_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_endpoint_storage_container")
+}
+
+func TestIothubEnrichment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_enrichment")
+}
+
+func TestIothubFallbackRoute_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_fallback_route")
+}
+
+func TestIothubRoute_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_route")
+}
+
+func TestIothubSharedAccessPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iothub.Registration{}, "azurerm_iothub_shared_access_policy")
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package iottimeseriesinsights_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iottimeseriesinsights"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestIotTimeSeriesInsightsAccessPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, iottimeseriesinsights.Registration{}, "azurerm_iot_time_series_insights_access_policy")
+}
@@ -4,7 +4,11 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2022-10-01/resources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	dataplane "github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
 )
@@ -20,17 +24,36 @@ type Client struct {
 	VaultsClient *vaults.VaultsClient
 
 	ManagementClient *dataplane.BaseClient
+
+	// ResourceGraphClient and SubscriptionsClient are used to resolve the Resource ID of a Key Vault
+	// from its Data Plane URI, see `KeyVaultIDFromBaseUrl`
+	ResourceGraphClient *resources.ResourcesClient
+	SubscriptionsClient *subscriptions.SubscriptionsClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	managementClient := dataplane.New()
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
 	vaultsClient := vaults.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
-	return &Client{
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
+	resourceGraphClient, err := resources.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resource Graph client: %+v", err)
 	}
+	o.Configure(resourceGraphClient.Client, o.Authorizers.ResourceManager)
+
+	subscriptionsClient, err := subscriptions.NewSubscriptionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Subscriptions client: %+v", err)
+	}
+	o.Configure(subscriptionsClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		ManagementClient:    &managementClient,
+		ResourceGraphClient: resourceGraphClient,
+		SubscriptionsClient: subscriptionsClient,
+		VaultsClient:        &vaultsClient,
+	}, nil
 }
@@ -6,19 +6,32 @@ package client
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/url"
+	"regexp"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2022-10-01/resources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 )
 
 var (
 	keyVaultsCache = map[string]keyVaultDetails{}
 	keysmith       = &sync.RWMutex{}
 	lock           = map[string]*sync.RWMutex{}
+
+	// listedSubscriptions tracks the Subscriptions whose Key Vaults have been listed into the cache, and
+	// accessibleSubscriptionIdsCache the Subscriptions which are accessible, see `KeyVaultIDFromBaseUrl`
+	listedSubscriptions            = map[string]bool{}
+	accessibleSubscriptionIdsCache []string
+
+	keyVaultNameRegex = regexp.MustCompile("^[a-zA-Z0-9-]{3,24}$")
 )
 
 type keyVaultDetails struct {
@@ -130,6 +143,105 @@ func (c *Client) KeyVaultIDFromBaseUrl(ctx context.Context, subscriptionId commo
 		return &v.keyVaultId, nil
 	}
 
+	// The Resource ID is resolved using the following strategies, in order:
+	//
+	// 1. Using the Resource Graph, which can locate the Key Vault across every Subscription the
+	//    credentials have access to using a single request. Whilst this is the fastest option, the
+	//    Resource Graph is eventually consistent - so a recently created Key Vault may not be present.
+	// 2. Listing ALL the Key Vaults within the Provider's Subscription, see `keyVaultIDFromSubscription`.
+	// 3. Listing ALL the Key Vaults within each of the other Subscriptions that the credentials have
+	//    access to, which allows a Key Vault in another Subscription to be found.
+	//
+	// Each Key Vault that's found is added to the (process-wide) cache, which is shared between Keys,
+	// Secrets, Certificates and Managed Storage Accounts.
+	start := time.Now()
+	strategies := []struct {
+		name   string
+		lookup func() (*string, error)
+	}{
+		{
+			name: "Resource Graph",
+			lookup: func() (*string, error) {
+				return c.keyVaultIDFromResourceGraph(ctx, *keyVaultName, keyVaultBaseUrl)
+			},
+		},
+		{
+			name: subscriptionId.String(),
+			lookup: func() (*string, error) {
+				return c.keyVaultIDFromSubscription(ctx, subscriptionId, cacheKey)
+			},
+		},
+		{
+			name: "other accessible Subscriptions",
+			lookup: func() (*string, error) {
+				return c.keyVaultIDFromAccessibleSubscriptions(ctx, subscriptionId, cacheKey)
+			},
+		},
+	}
+	for _, strategy := range strategies {
+		keyVaultId, err := strategy.lookup()
+		if err != nil {
+			return nil, err
+		}
+		if keyVaultId != nil {
+			log.Printf("[DEBUG] Resolved the Key Vault at URL %q to %q using the %s in %s", keyVaultBaseUrl, *keyVaultId, strategy.name, time.Since(start))
+			return keyVaultId, nil
+		}
+		log.Printf("[DEBUG] The Key Vault at URL %q was not found using the %s after %s", keyVaultBaseUrl, strategy.name, time.Since(start))
+	}
+
+	// We haven't found it, but Data Sources and Resources need to handle this error separately
+	return nil, nil
+}
+
+// keyVaultIDFromResourceGraph looks up the Key Vault using the Resource Graph - any error is logged rather than
+// returned, since the credentials may not have access to the Resource Graph, in which case we fall back to
+// listing the Key Vaults within each Subscription.
+func (c *Client) keyVaultIDFromResourceGraph(ctx context.Context, keyVaultName string, keyVaultBaseUrl string) (*string, error) {
+	// the name is interpolated into the query, so only use the Resource Graph when it's a valid Key Vault name
+	if !keyVaultNameRegex.MatchString(keyVaultName) {
+		return nil, nil
+	}
+
+	query := fmt.Sprintf(`resources
+| where type =~ 'Microsoft.KeyVault/vaults' and name =~ '%s'
+| project id, vaultUri = tostring(properties.vaultUri)`, keyVaultName)
+	input := resources.QueryRequest{
+		Query: query,
+		Options: &resources.QueryRequestOptions{
+			ResultFormat: pointer.To(resources.ResultFormatObjectArray),
+		},
+	}
+	resp, err := c.ResourceGraphClient.Resources(ctx, input)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to query the Resource Graph for the Key Vault at URL %q: %+v", keyVaultBaseUrl, err)
+		return nil, nil
+	}
+	if resp.Model == nil {
+		return nil, nil
+	}
+
+	for _, item := range findKeyVaultsInResourceGraphData(resp.Model.Data) {
+		keyVaultId, err := commonids.ParseKeyVaultIDInsensitively(item.keyVaultId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a Key Vault ID: %+v", item.keyVaultId, err)
+		}
+
+		// Key Vault names are globally unique within a cloud, however we check the URI matches since
+		// the Resource Graph may also return soft-deleted or otherwise stale Key Vaults
+		if !c.baseUrlsMatch(item.dataPlaneBaseUri, keyVaultBaseUrl) {
+			continue
+		}
+
+		c.AddToCache(*keyVaultId, item.dataPlaneBaseUri)
+		return pointer.To(keyVaultId.ID()), nil
+	}
+
+	return nil, nil
+}
+
+// keyVaultIDFromSubscription lists ALL the Key Vaults within the specified Subscription to populate the cache.
+func (c *Client) keyVaultIDFromSubscription(ctx context.Context, subscriptionId commonids.SubscriptionId, cacheKey string) (*string, error) {
 	// Pull out the list of Key Vaults available within the Subscription to re-populate the cache
 	//
 	// Whilst we've historically used the Resources API to query the single Key Vault in question
@@ -175,15 +287,119 @@ func (c *Client) KeyVaultIDFromBaseUrl(ctx context.Context, subscriptionId commo
 		c.AddToCache(*keyVaultId, vaultUri)
 	}
 
+	keysmith.Lock()
+	listedSubscriptions[strings.ToLower(subscriptionId.SubscriptionId)] = true
+	keysmith.Unlock()
+
 	// Now that the cache has been repopulated, check if we have the key vault or not
 	if v, ok := keyVaultsCache[cacheKey]; ok {
 		return &v.keyVaultId, nil
 	}
 
-	// We haven't found it, but Data Sources and Resources need to handle this error separately
 	return nil, nil
 }
 
+// keyVaultIDFromAccessibleSubscriptions lists the Key Vaults within each of the other Subscriptions that the
+// credentials have access to, until the Key Vault is found. Each Subscription is only listed once per process,
+// since Key Vaults created by the Provider (in any Subscription) are added to the cache when they're created.
+func (c *Client) keyVaultIDFromAccessibleSubscriptions(ctx context.Context, providerSubscriptionId commonids.SubscriptionId, cacheKey string) (*string, error) {
+	subscriptionIds, err := c.accessibleSubscriptionIds(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to list the accessible Subscriptions: %+v", err)
+		return nil, nil
+	}
+
+	for _, subscriptionId := range subscriptionIds {
+		if strings.EqualFold(subscriptionId, providerSubscriptionId.SubscriptionId) {
+			continue
+		}
+
+		keysmith.RLock()
+		listed := listedSubscriptions[strings.ToLower(subscriptionId)]
+		keysmith.RUnlock()
+		if listed {
+			continue
+		}
+
+		// the credentials may only have access to a subset of the Subscriptions, or the `Microsoft.KeyVault`
+		// Resource Provider may not be registered - so these errors are logged and skipped
+		keyVaultId, err := c.keyVaultIDFromSubscription(ctx, commonids.NewSubscriptionID(subscriptionId), cacheKey)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to list the Key Vaults within Subscription %q: %+v", subscriptionId, err)
+			continue
+		}
+		if keyVaultId != nil {
+			return keyVaultId, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *Client) accessibleSubscriptionIds(ctx context.Context) ([]string, error) {
+	keysmith.RLock()
+	cached := accessibleSubscriptionIdsCache
+	keysmith.RUnlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	resp, err := c.SubscriptionsClient.ListComplete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing Subscriptions: %+v", err)
+	}
+
+	subscriptionIds := make([]string, 0)
+	for _, item := range resp.Items {
+		if item.SubscriptionId == nil || item.State == nil || *item.State != subscriptions.SubscriptionStateEnabled {
+			continue
+		}
+		subscriptionIds = append(subscriptionIds, *item.SubscriptionId)
+	}
+
+	keysmith.Lock()
+	accessibleSubscriptionIdsCache = subscriptionIds
+	keysmith.Unlock()
+
+	return subscriptionIds, nil
+}
+
+type keyVaultResourceGraphResult struct {
+	keyVaultId       string
+	dataPlaneBaseUri string
+}
+
+// findKeyVaultsInResourceGraphData parses the `data` returned from the Resource Graph query (in the
+// `objectArray` format) in `keyVaultIDFromResourceGraph`
+func findKeyVaultsInResourceGraphData(input interface{}) []keyVaultResourceGraphResult {
+	output := make([]keyVaultResourceGraphResult, 0)
+
+	rows, ok := input.([]interface{})
+	if !ok {
+		return output
+	}
+
+	for _, raw := range rows {
+		row, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, _ := row["id"].(string)
+		vaultUri, _ := row["vaultUri"].(string)
+		if id == "" || vaultUri == "" {
+			continue
+		}
+
+		output = append(output, keyVaultResourceGraphResult{
+			keyVaultId:       id,
+			dataPlaneBaseUri: vaultUri,
+		})
+	}
+
+	return output
+}
+
 func (c *Client) Purge(keyVaultId commonids.KeyVaultId) {
 	cacheKey := c.cacheKeyForKeyVault(keyVaultId.VaultName)
 	keysmith.Lock()
@@ -200,6 +416,19 @@ func (c *Client) cacheKeyForKeyVault(name string) string {
 	return strings.ToLower(name)
 }
 
+func (c *Client) baseUrlsMatch(first, second string) bool {
+	firstUri, err := url.Parse(first)
+	if err != nil {
+		return false
+	}
+	secondUri, err := url.Parse(second)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(firstUri.Hostname(), secondUri.Hostname())
+}
+
 func (c *Client) parseNameFromBaseUrl(input string) (*string, error) {
 	uri, err := url.Parse(input)
 	if err != nil {
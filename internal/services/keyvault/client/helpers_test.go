@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindKeyVaultsInResourceGraphData(t *testing.T) {
+	testData := []struct {
+		input    interface{}
+		expected []keyVaultResourceGraphResult
+	}{
+		{
+			input:    nil,
+			expected: []keyVaultResourceGraphResult{},
+		},
+		{
+			// the `table` result format isn't supported
+			input: map[string]interface{}{
+				"columns": []interface{}{},
+				"rows":    []interface{}{},
+			},
+			expected: []keyVaultResourceGraphResult{},
+		},
+		{
+			input: []interface{}{
+				map[string]interface{}{
+					"id":       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+					"vaultUri": "https://vault1.vault.azure.net/",
+				},
+				map[string]interface{}{
+					// rows without a URI are skipped
+					"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault2",
+				},
+				"not a row",
+			},
+			expected: []keyVaultResourceGraphResult{
+				{
+					keyVaultId:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+					dataPlaneBaseUri: "https://vault1.vault.azure.net/",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.input)
+
+		actual := findKeyVaultsInResourceGraphData(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestBaseUrlsMatch(t *testing.T) {
+	testData := []struct {
+		first    string
+		second   string
+		expected bool
+	}{
+		{
+			first:    "https://vault1.vault.azure.net/",
+			second:   "https://vault1.vault.azure.net",
+			expected: true,
+		},
+		{
+			first:    "https://VAULT1.vault.azure.net/",
+			second:   "https://vault1.vault.azure.net:443/",
+			expected: true,
+		},
+		{
+			first:    "https://vault1.vault.azure.net/",
+			second:   "https://vault1.vault.azure.cn/",
+			expected: false,
+		},
+		{
+			first:    "https://vault1.vault.azure.net/",
+			second:   "https://vault2.vault.azure.net/",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q and %q", v.first, v.second)
+
+		if actual := (&Client{}).baseUrlsMatch(v.first, v.second); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
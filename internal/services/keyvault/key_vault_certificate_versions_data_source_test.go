@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KeyVaultCertificateVersionsDataSource struct{}
+
+func TestAccDataSourceKeyVaultCertificateVersions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_key_vault_certificate_versions", "test")
+	r := KeyVaultCertificateVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("versions.0.id").MatchesOtherKey(check.That("azurerm_key_vault_certificate.test").Key("id")),
+				check.That(data.ResourceName).Key("versions.0.version").MatchesOtherKey(check.That("azurerm_key_vault_certificate.test").Key("version")),
+				check.That(data.ResourceName).Key("versions.0.enabled").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (KeyVaultCertificateVersionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_certificate_versions" "test" {
+  name         = azurerm_key_vault_certificate.test.name
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, KeyVaultCertificateResource{}.basicGenerate(data))
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+// expandKeyVaultKeyMaterial parses the `key_material` field, which is either a PEM encoded private key
+// (PKCS#1, PKCS#8 or SEC 1) or a JSON Web Key, into a JSON Web Key which can be imported into Key Vault.
+func expandKeyVaultKeyMaterial(input string) (*keyvault.JSONWebKey, error) {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(input, "{") {
+		var key keyvault.JSONWebKey
+		if err := json.Unmarshal([]byte(input), &key); err != nil {
+			return nil, fmt.Errorf("parsing JSON Web Key: %+v", err)
+		}
+
+		switch key.Kty {
+		case keyvault.JSONWebKeyTypeRSA, keyvault.JSONWebKeyTypeRSAHSM:
+			if key.N == nil || key.E == nil || key.D == nil {
+				return nil, fmt.Errorf("an RSA JSON Web Key must contain the `n`, `e` and `d` components")
+			}
+			key.Kty = keyvault.JSONWebKeyTypeRSA
+		case keyvault.JSONWebKeyTypeEC, keyvault.JSONWebKeyTypeECHSM:
+			if key.Crv == "" || key.X == nil || key.Y == nil || key.D == nil {
+				return nil, fmt.Errorf("an EC JSON Web Key must contain the `crv`, `x`, `y` and `d` components")
+			}
+			key.Kty = keyvault.JSONWebKeyTypeEC
+		default:
+			return nil, fmt.Errorf("unsupported JSON Web Key type %q - only `RSA` and `EC` keys can be imported", string(key.Kty))
+		}
+
+		// these are set from the Resource rather than from the imported key
+		key.Kid = nil
+		key.KeyOps = nil
+		key.T = nil
+		return &key, nil
+	}
+
+	block, _ := pem.Decode([]byte(input))
+	if block == nil {
+		return nil, fmt.Errorf("expected either a PEM encoded private key or a JSON Web Key")
+	}
+
+	var privateKey interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		privateKey, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q - expected `RSA PRIVATE KEY`, `EC PRIVATE KEY` or `PRIVATE KEY`", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %+v", block.Type, err)
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return flattenRSAPrivateKeyToJSONWebKey(key), nil
+	case *ecdsa.PrivateKey:
+		return flattenECPrivateKeyToJSONWebKey(key)
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T - only RSA and EC keys can be imported", privateKey)
+	}
+}
+
+func flattenRSAPrivateKeyToJSONWebKey(input *rsa.PrivateKey) *keyvault.JSONWebKey {
+	input.Precompute()
+
+	encode := func(v *big.Int) *string {
+		return pointer.To(base64.RawURLEncoding.EncodeToString(v.Bytes()))
+	}
+
+	key := &keyvault.JSONWebKey{
+		Kty: keyvault.JSONWebKeyTypeRSA,
+		N:   encode(input.N),
+		E:   encode(big.NewInt(int64(input.E))),
+		D:   encode(input.D),
+		DP:  encode(input.Precomputed.Dp),
+		DQ:  encode(input.Precomputed.Dq),
+		QI:  encode(input.Precomputed.Qinv),
+	}
+	if len(input.Primes) == 2 {
+		key.P = encode(input.Primes[0])
+		key.Q = encode(input.Primes[1])
+	}
+
+	return key
+}
+
+func flattenECPrivateKeyToJSONWebKey(input *ecdsa.PrivateKey) (*keyvault.JSONWebKey, error) {
+	var curve keyvault.JSONWebKeyCurveName
+	switch input.Curve.Params().Name {
+	case "P-256":
+		curve = keyvault.JSONWebKeyCurveNameP256
+	case "P-384":
+		curve = keyvault.JSONWebKeyCurveNameP384
+	case "P-521":
+		curve = keyvault.JSONWebKeyCurveNameP521
+	default:
+		return nil, fmt.Errorf("unsupported elliptic curve %q", input.Curve.Params().Name)
+	}
+
+	// EC components are fixed-length and must be left-padded to the size of the curve
+	size := (input.Curve.Params().BitSize + 7) / 8
+	encode := func(v *big.Int) *string {
+		return pointer.To(base64.RawURLEncoding.EncodeToString(v.FillBytes(make([]byte, size))))
+	}
+
+	return &keyvault.JSONWebKey{
+		Kty: keyvault.JSONWebKeyTypeEC,
+		Crv: curve,
+		X:   encode(input.X),
+		Y:   encode(input.Y),
+		D:   encode(input.D),
+	}, nil
+}
+
+// expandKeyVaultKeyByokTransferBlob converts the (standard) base64 encoded contents of a BYOK transfer blob
+// into the URL-encoded base64 format expected by the `key_hsm` field of a JSON Web Key.
+func expandKeyVaultKeyByokTransferBlob(input string) (*string, error) {
+	blob, err := base64.StdEncoding.DecodeString(strings.TrimSpace(input))
+	if err != nil {
+		return nil, fmt.Errorf("decoding the BYOK transfer blob: %+v", err)
+	}
+
+	return pointer.To(base64.RawURLEncoding.EncodeToString(blob)), nil
+}
+
+// keyVaultKeyComponentMatches compares two URL-encoded base64 key components by value, since leading zeros
+// may be included or omitted depending on where the value came from.
+func keyVaultKeyComponentMatches(first, second string) bool {
+	firstBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(first, "="))
+	if err != nil {
+		return false
+	}
+	secondBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(second, "="))
+	if err != nil {
+		return false
+	}
+
+	return new(big.Int).SetBytes(firstBytes).Cmp(new(big.Int).SetBytes(secondBytes)) == 0
+}
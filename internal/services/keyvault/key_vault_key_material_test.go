@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
+)
+
+func TestExpandKeyVaultKeyMaterialRSA(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %+v", err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("marshalling PKCS#8 key: %+v", err)
+	}
+
+	inputs := map[string]string{
+		"PKCS#1": string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		"PKCS#8": string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})),
+	}
+
+	expectedN := base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes())
+	for name, input := range inputs {
+		t.Logf("[DEBUG] Testing %s", name)
+
+		key, err := expandKeyVaultKeyMaterial(input)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if key.Kty != keyvault.JSONWebKeyTypeRSA {
+			t.Fatalf("expected `kty` to be %q but got %q", keyvault.JSONWebKeyTypeRSA, key.Kty)
+		}
+		if key.N == nil || *key.N != expectedN {
+			t.Fatalf("expected `n` to be %q but got %v", expectedN, key.N)
+		}
+		if key.E == nil || *key.E != "AQAB" {
+			t.Fatalf("expected `e` to be %q but got %v", "AQAB", key.E)
+		}
+		for component, v := range map[string]*string{"d": key.D, "p": key.P, "q": key.Q, "dp": key.DP, "dq": key.DQ, "qi": key.QI} {
+			if v == nil || *v == "" {
+				t.Fatalf("expected the `%s` component to be set", component)
+			}
+		}
+	}
+}
+
+func TestExpandKeyVaultKeyMaterialEC(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating EC key: %+v", err)
+	}
+	sec1, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("marshalling SEC 1 key: %+v", err)
+	}
+
+	key, err := expandKeyVaultKeyMaterial(string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: sec1})))
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if key.Kty != keyvault.JSONWebKeyTypeEC {
+		t.Fatalf("expected `kty` to be %q but got %q", keyvault.JSONWebKeyTypeEC, key.Kty)
+	}
+	if key.Crv != keyvault.JSONWebKeyCurveNameP384 {
+		t.Fatalf("expected `crv` to be %q but got %q", keyvault.JSONWebKeyCurveNameP384, key.Crv)
+	}
+	for component, v := range map[string]*string{"x": key.X, "y": key.Y, "d": key.D} {
+		decoded, err := base64.RawURLEncoding.DecodeString(*v)
+		if err != nil {
+			t.Fatalf("decoding `%s`: %+v", component, err)
+		}
+		if len(decoded) != 48 {
+			t.Fatalf("expected the `%s` component to be padded to 48 bytes but got %d", component, len(decoded))
+		}
+	}
+}
+
+func TestExpandKeyVaultKeyMaterialJSONWebKey(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected keyvault.JSONWebKeyType
+		error    bool
+	}{
+		{
+			input:    `{"kty": "RSA", "n": "AQAB", "e": "AQAB", "d": "AQAB", "kid": "https://example.vault.azure.net/keys/example"}`,
+			expected: keyvault.JSONWebKeyTypeRSA,
+		},
+		{
+			// the HSM key types are normalized since the key material itself is always a plain key
+			input:    `{"kty": "EC-HSM", "crv": "P-256", "x": "AQAB", "y": "AQAB", "d": "AQAB"}`,
+			expected: keyvault.JSONWebKeyTypeEC,
+		},
+		{
+			// a public key can't be imported
+			input: `{"kty": "RSA", "n": "AQAB", "e": "AQAB"}`,
+			error: true,
+		},
+		{
+			input: `{"kty": "oct", "k": "AQAB"}`,
+			error: true,
+		},
+		{
+			input: `{"kty": "RSA"`,
+			error: true,
+		},
+		{
+			input: "not a key",
+			error: true,
+		},
+		{
+			input: "-----BEGIN PUBLIC KEY-----\nAQAB\n-----END PUBLIC KEY-----\n",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := expandKeyVaultKeyMaterial(v.input)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual.Kty != v.expected {
+			t.Fatalf("expected `kty` to be %q but got %q", v.expected, actual.Kty)
+		}
+		if actual.Kid != nil {
+			t.Fatalf("expected `kid` to be removed but got %q", *actual.Kid)
+		}
+	}
+}
+
+func TestExpandKeyVaultKeyByokTransferBlob(t *testing.T) {
+	actual, err := expandKeyVaultKeyByokTransferBlob(base64.StdEncoding.EncodeToString([]byte{0xfb, 0xff, 0x01}))
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if *actual != "-_8B" {
+		t.Fatalf("expected %q but got %q", "-_8B", *actual)
+	}
+
+	if _, err := expandKeyVaultKeyByokTransferBlob("not base64!"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestKeyVaultKeyComponentMatches(t *testing.T) {
+	testData := []struct {
+		first    string
+		second   string
+		expected bool
+	}{
+		{
+			first:    "AQAB",
+			second:   "AQAB",
+			expected: true,
+		},
+		{
+			// leading zeros are ignored
+			first:    "AAEAAQ",
+			second:   "AQAB",
+			expected: true,
+		},
+		{
+			// as is padding
+			first:    "AQAB",
+			second:   "AQAB==",
+			expected: true,
+		},
+		{
+			first:    "AQAB",
+			second:   "AQAC",
+			expected: false,
+		},
+		{
+			first:    "",
+			second:   "AQAB",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q and %q", v.first, v.second)
+
+		if actual := keyVaultKeyComponentMatches(v.first, v.second); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -82,8 +82,10 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 			},
 
 			"key_size": {
-				Type:          pluginsdk.TypeInt,
-				Optional:      true,
+				Type:     pluginsdk.TypeInt,
+				Optional: true,
+				// Computed since this is determined from the `key_material` when importing a key
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"curve"},
 			},
@@ -129,6 +131,26 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ConflictsWith: []string{"key_size"},
 			},
 
+			// the key material is never persisted into the state, changes are instead detected using the
+			// public components of the key (`n`, `e`, `x` and `y`) within the CustomizeDiff
+			"key_material": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: suppressKeyVaultKeyImportMaterialDiff,
+				ConflictsWith:    []string{"byok_transfer_blob"},
+			},
+
+			"byok_transfer_blob": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.StringIsBase64,
+				DiffSuppressFunc: suppressKeyVaultKeyImportMaterialDiff,
+				ConflictsWith:    []string{"key_material", "key_size"},
+			},
+
 			"not_before_date": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -261,6 +283,7 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("expiration_date", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) != "" && new.(string) == ""
 			}),
+			keyVaultKeyImportCustomizeDiff,
 		),
 	}
 }
@@ -298,8 +321,6 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
 
-	// TODO: support Importing Keys once this is fixed:
-	// https://github.com/Azure/azure-rest-api-specs/issues/1747
 	parameters := keyvault.KeyCreateParameters{
 		Kty:    keyvault.JSONWebKeyType(keyType),
 		KeyOps: keyOptions,
@@ -315,10 +336,12 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
 	} else if parameters.Kty == keyvault.JSONWebKeyTypeRSA || parameters.Kty == keyvault.JSONWebKeyTypeRSAHSM {
 		keySize, ok := d.GetOk("key_size")
-		if !ok {
+		if !ok && !isKeyVaultKeyImport(d) {
 			return fmt.Errorf("Key size is required when creating an RSA key")
 		}
-		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+		if ok {
+			parameters.KeySize = utils.Int32(int32(keySize.(int)))
+		}
 	}
 	// TODO: support `oct` once this is fixed
 	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
@@ -335,7 +358,22 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.KeyAttributes.Expires = &expirationUnixTime
 	}
 
-	if resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
+	var importParameters *keyvault.KeyImportParameters
+	if isKeyVaultKeyImport(d) {
+		importParameters, err = expandKeyVaultKeyImportParameters(d, parameters)
+		if err != nil {
+			return err
+		}
+	}
+
+	createOrImportKey := func() (keyvault.KeyBundle, error) {
+		if importParameters != nil {
+			return client.ImportKey(ctx, *keyVaultBaseUri, name, *importParameters)
+		}
+		return client.CreateKey(ctx, *keyVaultBaseUri, name, parameters)
+	}
+
+	if resp, err := createOrImportKey(); err != nil {
 		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeys && utils.ResponseWasConflict(resp.Response) {
 			recoveredKey, err := client.RecoverDeletedKey(ctx, *keyVaultBaseUri, name)
 			if err != nil {
@@ -358,6 +396,13 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 				}
 				log.Printf("[DEBUG] Key %q recovered with ID: %q", name, *kid)
 			}
+
+			// the recovered Key contains the previous key material, so the specified key material is imported as a new version
+			if importParameters != nil {
+				if _, err := createOrImportKey(); err != nil {
+					return fmt.Errorf("importing Key %q into the recovered Key: %+v", name, err)
+				}
+			}
 		} else {
 			return fmt.Errorf("Creating Key: %+v", err)
 		}
@@ -387,6 +432,10 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 	d.SetId(keyId.ID())
 
+	// the imported key material mustn't be persisted into the state
+	d.Set("key_material", "")
+	d.Set("byok_transfer_blob", "")
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
@@ -708,6 +757,142 @@ func expandKeyVaultKeyOptions(d *pluginsdk.ResourceData) *[]keyvault.JSONWebKeyO
 	return &results
 }
 
+func isKeyVaultKeyImport(d *pluginsdk.ResourceData) bool {
+	return d.Get("key_material").(string) != "" || d.Get("byok_transfer_blob").(string) != ""
+}
+
+func expandKeyVaultKeyImportParameters(d *pluginsdk.ResourceData, input keyvault.KeyCreateParameters) (*keyvault.KeyImportParameters, error) {
+	keyOps := make([]string, 0)
+	if input.KeyOps != nil {
+		for _, op := range *input.KeyOps {
+			keyOps = append(keyOps, string(op))
+		}
+	}
+
+	var key *keyvault.JSONWebKey
+	if v := d.Get("byok_transfer_blob").(string); v != "" {
+		if input.Kty != keyvault.JSONWebKeyTypeRSAHSM && input.Kty != keyvault.JSONWebKeyTypeECHSM {
+			return nil, fmt.Errorf("`byok_transfer_blob` can only be specified when `key_type` is `RSA-HSM` or `EC-HSM`")
+		}
+
+		transferBlob, err := expandKeyVaultKeyByokTransferBlob(v)
+		if err != nil {
+			return nil, err
+		}
+
+		key = &keyvault.JSONWebKey{
+			Kty: input.Kty,
+			Crv: input.Curve,
+			T:   transferBlob,
+		}
+	} else {
+		material, err := expandKeyVaultKeyMaterial(d.Get("key_material").(string))
+		if err != nil {
+			return nil, fmt.Errorf("parsing `key_material`: %+v", err)
+		}
+		if err := validateKeyVaultKeyMaterialMatchesKeyType(material, string(input.Kty)); err != nil {
+			return nil, err
+		}
+		key = material
+	}
+	key.KeyOps = &keyOps
+
+	return &keyvault.KeyImportParameters{
+		Hsm:           utils.Bool(input.Kty == keyvault.JSONWebKeyTypeRSAHSM || input.Kty == keyvault.JSONWebKeyTypeECHSM),
+		Key:           key,
+		KeyAttributes: input.KeyAttributes,
+		Tags:          input.Tags,
+	}, nil
+}
+
+func validateKeyVaultKeyMaterialMatchesKeyType(material *keyvault.JSONWebKey, keyType string) error {
+	switch material.Kty {
+	case keyvault.JSONWebKeyTypeRSA:
+		if keyType != string(keyvault.JSONWebKeyTypeRSA) && keyType != string(keyvault.JSONWebKeyTypeRSAHSM) {
+			return fmt.Errorf("`key_material` contains an RSA key but `key_type` is %q", keyType)
+		}
+	case keyvault.JSONWebKeyTypeEC:
+		if keyType != string(keyvault.JSONWebKeyTypeEC) && keyType != string(keyvault.JSONWebKeyTypeECHSM) {
+			return fmt.Errorf("`key_material` contains an EC key but `key_type` is %q", keyType)
+		}
+	}
+
+	return nil
+}
+
+// suppressKeyVaultKeyImportMaterialDiff suppresses the diff for `key_material` and `byok_transfer_blob` once the
+// Key exists, since these are never persisted into the state - changes to `key_material` are instead detected
+// by comparing the public components of the key within `keyVaultKeyImportCustomizeDiff`.
+func suppressKeyVaultKeyImportMaterialDiff(_, old, _ string, d *pluginsdk.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+func keyVaultKeyImportCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	raw := d.Get("key_material").(string)
+	if raw == "" {
+		return nil
+	}
+
+	// the key material isn't known until apply time when it's sourced from another resource
+	if !d.NewValueKnown("key_material") {
+		return nil
+	}
+
+	material, err := expandKeyVaultKeyMaterial(raw)
+	if err != nil {
+		return fmt.Errorf("parsing `key_material`: %+v", err)
+	}
+
+	if d.NewValueKnown("key_type") {
+		if err := validateKeyVaultKeyMaterialMatchesKeyType(material, d.Get("key_type").(string)); err != nil {
+			return err
+		}
+	}
+
+	if material.Kty == keyvault.JSONWebKeyTypeEC {
+		if v := d.Get("curve").(string); v != "" && d.NewValueKnown("curve") && v != string(material.Crv) {
+			return fmt.Errorf("`curve` is %q but the `key_material` contains a key using the curve %q", v, string(material.Crv))
+		}
+	}
+
+	if d.Id() == "" {
+		if v, ok := d.GetOk("key_size"); ok && material.Kty == keyvault.JSONWebKeyTypeRSA && d.NewValueKnown("key_size") {
+			nBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*material.N, "="))
+			if err != nil {
+				return fmt.Errorf("decoding the `n` component of the `key_material`: %+v", err)
+			}
+			if keySize := new(big.Int).SetBytes(nBytes).BitLen(); keySize != v.(int) {
+				return fmt.Errorf("`key_size` is %d but the `key_material` contains a %d bit key", v.(int), keySize)
+			}
+		}
+		return nil
+	}
+
+	components := map[string]*string{
+		"n": material.N,
+		"e": material.E,
+		"x": material.X,
+		"y": material.Y,
+	}
+	for key, value := range components {
+		if value == nil {
+			continue
+		}
+
+		if existing := d.Get(key).(string); !keyVaultKeyComponentMatches(existing, *value) {
+			log.Printf("[DEBUG] the `%s` component of the `key_material` differs from the imported Key - recreating", key)
+			if err := d.SetNew(key, *value); err != nil {
+				return fmt.Errorf("setting `%s`: %+v", key, err)
+			}
+			if err := d.ForceNew(key); err != nil {
+				return fmt.Errorf("forcing new for `%s`: %+v", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandKeyVaultKeyRotationPolicy(v []interface{}) keyvault.KeyRotationPolicy {
 	if len(v) == 0 {
 		return keyvault.KeyRotationPolicy{LifetimeActions: &[]keyvault.LifetimeActions{}}
@@ -5,6 +5,12 @@ package keyvault_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"regexp"
 	"testing"
@@ -381,6 +387,63 @@ func TestAccKeyVaultKey_RotationPolicyUnauthorized(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_importRSA(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+	first := r.generateRSAKeyMaterial(t)
+	second := r.generateRSAKeyMaterial(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importRSA(data, first),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_size").HasValue("2048"),
+				check.That(data.ResourceName).Key("key_material").IsEmpty(),
+			),
+		},
+		data.ImportStep("key_material", "key_vault_id"),
+		{
+			// changing the key material is detected from the public components of the key
+			Config: r.importRSA(data, second),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_material").IsEmpty(),
+			),
+		},
+		data.ImportStep("key_material", "key_vault_id"),
+	})
+}
+
+func TestAccKeyVaultKey_importEC(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importEC(data, r.generateECKeyMaterial(t)),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("curve").HasValue("P-384"),
+				check.That(data.ResourceName).Key("key_material").IsEmpty(),
+			),
+		},
+		data.ImportStep("key_material", "key_vault_id"),
+	})
+}
+
+func TestAccKeyVaultKey_importMismatchedKeyType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.importMismatchedKeyType(data, r.generateRSAKeyMaterial(t)),
+			ExpectError: regexp.MustCompile("`key_material` contains an RSA key but `key_type` is \"EC\""),
+		},
+	})
+}
+
 func (r KeyVaultKeyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	client := clients.KeyVault
 	subscriptionId := clients.Account.SubscriptionId
@@ -569,6 +632,105 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (KeyVaultKeyResource) generateRSAKeyMaterial(t *testing.T) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %+v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+}
+
+func (KeyVaultKeyResource) generateECKeyMaterial(t *testing.T) string {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating EC key: %+v", err)
+	}
+
+	encoded, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("marshalling EC key: %+v", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: encoded}))
+}
+
+func (r KeyVaultKeyResource) importRSA(data acceptance.TestData, keyMaterial string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+
+  key_material = <<EOT
+%sEOT
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, r.templateStandard(data), data.RandomString, keyMaterial)
+}
+
+func (r KeyVaultKeyResource) importEC(data acceptance.TestData, keyMaterial string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "EC"
+
+  key_material = <<EOT
+%sEOT
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+`, r.templateStandard(data), data.RandomString, keyMaterial)
+}
+
+func (r KeyVaultKeyResource) importMismatchedKeyType(data acceptance.TestData, keyMaterial string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "EC"
+
+  key_material = <<EOT
+%sEOT
+
+  key_opts = [
+    "sign",
+    "verify",
+  ]
+}
+`, r.templateStandard(data), data.RandomString, keyMaterial)
+}
+
 func (r KeyVaultKeyResource) basicRSAHSM(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
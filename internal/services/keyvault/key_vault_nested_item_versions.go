@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// KeyVaultNestedItemVersionsDataSourceModel is the model shared by the Key, Secret and Certificate Versions Data Sources
+type KeyVaultNestedItemVersionsDataSourceModel struct {
+	Name        string                           `tfschema:"name"`
+	KeyVaultId  string                           `tfschema:"key_vault_id"`
+	EnabledOnly bool                             `tfschema:"enabled_only"`
+	MaxResults  int64                            `tfschema:"max_results"`
+	Versions    []KeyVaultNestedItemVersionModel `tfschema:"versions"`
+}
+
+type KeyVaultNestedItemVersionModel struct {
+	Id             string            `tfschema:"id"`
+	VersionlessId  string            `tfschema:"versionless_id"`
+	Version        string            `tfschema:"version"`
+	Enabled        bool              `tfschema:"enabled"`
+	CreatedDate    string            `tfschema:"created_date"`
+	UpdatedDate    string            `tfschema:"updated_date"`
+	ExpirationDate string            `tfschema:"expiration_date"`
+	NotBeforeDate  string            `tfschema:"not_before_date"`
+	Tags           map[string]string `tfschema:"tags"`
+}
+
+// keyVaultNestedItemVersion is the common representation of a version of a Key, Secret or Certificate
+// returned from the Key Vault data plane, since each has its own (otherwise identical) set of attributes.
+type keyVaultNestedItemVersion struct {
+	id        string
+	enabled   *bool
+	created   *date.UnixTime
+	updated   *date.UnixTime
+	expires   *date.UnixTime
+	notBefore *date.UnixTime
+	tags      map[string]*string
+}
+
+// keyVaultNestedItemVersionsLister lists every version of the named Nested Item within the Key Vault
+type keyVaultNestedItemVersionsLister func(ctx context.Context, metadata sdk.ResourceMetaData, keyVaultBaseUri string, name string) ([]keyVaultNestedItemVersion, error)
+
+func keyVaultNestedItemVersionsDataSourceArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: keyVaultValidate.NestedItemName,
+		},
+
+		"key_vault_id": commonschema.ResourceIDReferenceRequired(&commonids.KeyVaultId{}),
+
+		"enabled_only": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"max_results": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func keyVaultNestedItemVersionsDataSourceAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"versions": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"versionless_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"version": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"created_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"updated_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"expiration_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"not_before_date": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tags": tags.SchemaDataSource(),
+				},
+			},
+		},
+	}
+}
+
+// keyVaultNestedItemVersionsDataSourceRead is the Read function shared by the Key, Secret and Certificate Versions
+// Data Sources, which only differ in the type of Nested Item and the data plane operation used to list the versions.
+func keyVaultNestedItemVersionsDataSourceRead(nestedItemType parse.NestedItemObjectType, displayName string, list keyVaultNestedItemVersionsLister) sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			keyVaultsClient := metadata.Client.KeyVault
+
+			var state KeyVaultNestedItemVersionsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			keyVaultId, err := commonids.ParseKeyVaultID(state.KeyVaultId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNestedItemVersionsID(*keyVaultId, nestedItemType, state.Name)
+
+			keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+			if err != nil {
+				return fmt.Errorf("looking up base uri for %s %q in %s: %+v", displayName, state.Name, *keyVaultId, err)
+			}
+
+			versions, err := list(ctx, metadata, *keyVaultBaseUri, state.Name)
+			if err != nil {
+				return err
+			}
+
+			// a Nested Item which doesn't exist returns an empty list rather than a 404
+			if len(versions) == 0 {
+				return fmt.Errorf("%s %q was not found in %s", displayName, state.Name, *keyVaultId)
+			}
+
+			state.KeyVaultId = keyVaultId.ID()
+			state.Versions, err = flattenKeyVaultNestedItemVersions(versions, state.EnabledOnly, int(state.MaxResults))
+			if err != nil {
+				return fmt.Errorf("flattening versions for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenKeyVaultNestedItemVersions returns the versions ordered from newest to oldest, optionally
+// filtering out disabled versions and limiting the number of versions returned.
+func flattenKeyVaultNestedItemVersions(input []keyVaultNestedItemVersion, enabledOnly bool, maxResults int) ([]KeyVaultNestedItemVersionModel, error) {
+	versions := make([]keyVaultNestedItemVersion, 0)
+	for _, v := range input {
+		if enabledOnly && (v.enabled == nil || !*v.enabled) {
+			continue
+		}
+		versions = append(versions, v)
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		first := keyVaultNestedItemVersionTime(versions[i].created)
+		second := keyVaultNestedItemVersionTime(versions[j].created)
+		if first.Equal(second) {
+			return versions[i].id > versions[j].id
+		}
+		return first.After(second)
+	})
+
+	if maxResults > 0 && len(versions) > maxResults {
+		versions = versions[:maxResults]
+	}
+
+	output := make([]KeyVaultNestedItemVersionModel, 0)
+	for _, v := range versions {
+		id, err := parse.ParseNestedItemID(v.id)
+		if err != nil {
+			return nil, err
+		}
+
+		enabled := false
+		if v.enabled != nil {
+			enabled = *v.enabled
+		}
+
+		output = append(output, KeyVaultNestedItemVersionModel{
+			Id:             id.ID(),
+			VersionlessId:  id.VersionlessID(),
+			Version:        id.Version,
+			Enabled:        enabled,
+			CreatedDate:    formatKeyVaultNestedItemVersionTime(v.created),
+			UpdatedDate:    formatKeyVaultNestedItemVersionTime(v.updated),
+			ExpirationDate: formatKeyVaultNestedItemVersionTime(v.expires),
+			NotBeforeDate:  formatKeyVaultNestedItemVersionTime(v.notBefore),
+			Tags:           tags.ToTypedObject(v.tags),
+		})
+	}
+
+	return output, nil
+}
+
+func keyVaultNestedItemVersionTime(input *date.UnixTime) time.Time {
+	if input == nil {
+		return time.Time{}
+	}
+	return time.Time(*input)
+}
+
+func formatKeyVaultNestedItemVersionTime(input *date.UnixTime) string {
+	if input == nil {
+		return ""
+	}
+	return time.Time(*input).Format(time.RFC3339)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestFlattenKeyVaultNestedItemVersions(t *testing.T) {
+	unixTime := func(input string) *date.UnixTime {
+		v, _ := time.Parse(time.RFC3339, input)
+		return pointer.To(date.UnixTime(v))
+	}
+
+	input := []keyVaultNestedItemVersion{
+		{
+			id:      "https://example.vault.azure.net/secrets/example/first",
+			enabled: pointer.To(false),
+			created: unixTime("2023-01-01T00:00:00Z"),
+		},
+		{
+			id:      "https://example.vault.azure.net/secrets/example/third",
+			enabled: pointer.To(true),
+			created: unixTime("2023-03-01T00:00:00Z"),
+		},
+		{
+			id:      "https://example.vault.azure.net/secrets/example/second",
+			enabled: pointer.To(true),
+			created: unixTime("2023-02-01T00:00:00Z"),
+			expires: unixTime("2024-02-01T00:00:00Z"),
+		},
+	}
+
+	testData := []struct {
+		enabledOnly bool
+		maxResults  int
+		expected    []string
+	}{
+		{
+			expected: []string{"third", "second", "first"},
+		},
+		{
+			enabledOnly: true,
+			expected:    []string{"third", "second"},
+		},
+		{
+			maxResults: 2,
+			expected:   []string{"third", "second"},
+		},
+		{
+			enabledOnly: true,
+			maxResults:  5,
+			expected:    []string{"third", "second"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing enabledOnly %t / maxResults %d", v.enabledOnly, v.maxResults)
+
+		actual, err := flattenKeyVaultNestedItemVersions(input, v.enabledOnly, v.maxResults)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		versions := make([]string, 0)
+		for _, item := range actual {
+			versions = append(versions, item.Version)
+		}
+		if !reflect.DeepEqual(versions, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, versions)
+		}
+	}
+
+	actual, err := flattenKeyVaultNestedItemVersions(input, false, 0)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	second := actual[1]
+	if second.ExpirationDate != "2024-02-01T00:00:00Z" {
+		t.Fatalf("expected `expiration_date` to be %q but got %q", "2024-02-01T00:00:00Z", second.ExpirationDate)
+	}
+	if second.NotBeforeDate != "" {
+		t.Fatalf("expected `not_before_date` to be empty but got %q", second.NotBeforeDate)
+	}
+	if second.VersionlessId != "https://example.vault.azure.net/secrets/example" {
+		t.Fatalf("expected `versionless_id` to be %q but got %q", "https://example.vault.azure.net/secrets/example", second.VersionlessId)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultSecretVersionsDataSource struct{}
+
+var _ sdk.DataSource = KeyVaultSecretVersionsDataSource{}
+
+func (KeyVaultSecretVersionsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return keyVaultNestedItemVersionsDataSourceArguments()
+}
+
+func (KeyVaultSecretVersionsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return keyVaultNestedItemVersionsDataSourceAttributes()
+}
+
+func (KeyVaultSecretVersionsDataSource) ModelObject() interface{} {
+	return &KeyVaultNestedItemVersionsDataSourceModel{}
+}
+
+func (KeyVaultSecretVersionsDataSource) ResourceType() string {
+	return "azurerm_key_vault_secret_versions"
+}
+
+func (KeyVaultSecretVersionsDataSource) Read() sdk.ResourceFunc {
+	return keyVaultNestedItemVersionsDataSourceRead(parse.NestedItemTypeSecret, "Secret", func(ctx context.Context, metadata sdk.ResourceMetaData, keyVaultBaseUri string, name string) ([]keyVaultNestedItemVersion, error) {
+		client := metadata.Client.KeyVault.ManagementClient
+
+		iterator, err := client.GetSecretVersionsComplete(ctx, keyVaultBaseUri, name, utils.Int32(25))
+		if err != nil {
+			if utils.ResponseWasNotFound(iterator.Response().Response) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("listing versions of Secret %q in %q: %+v", name, keyVaultBaseUri, err)
+		}
+
+		versions := make([]keyVaultNestedItemVersion, 0)
+		for iterator.NotDone() {
+			v := iterator.Value()
+			if v.ID != nil {
+				version := keyVaultNestedItemVersion{
+					id:   *v.ID,
+					tags: v.Tags,
+				}
+				if attributes := v.Attributes; attributes != nil {
+					version.enabled = attributes.Enabled
+					version.created = attributes.Created
+					version.updated = attributes.Updated
+					version.expires = attributes.Expires
+					version.notBefore = attributes.NotBefore
+				}
+				versions = append(versions, version)
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("listing versions of Secret %q in %q: %+v", name, keyVaultBaseUri, err)
+			}
+		}
+
+		return versions, nil
+	})
+}
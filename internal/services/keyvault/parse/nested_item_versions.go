@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &NestedItemVersionsId{}
+
+// NestedItemVersionsId identifies the list of versions of a Key, Secret or Certificate within a Key Vault
+type NestedItemVersionsId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	VaultName         string
+	NestedItemType    NestedItemObjectType
+	NestedItemName    string
+}
+
+func NewNestedItemVersionsID(keyVaultId commonids.KeyVaultId, nestedItemType NestedItemObjectType, nestedItemName string) NestedItemVersionsId {
+	return NestedItemVersionsId{
+		SubscriptionId:    keyVaultId.SubscriptionId,
+		ResourceGroupName: keyVaultId.ResourceGroupName,
+		VaultName:         keyVaultId.VaultName,
+		NestedItemType:    nestedItemType,
+		NestedItemName:    nestedItemName,
+	}
+}
+
+// KeyVaultId returns the ID of the Key Vault which the Nested Item belongs to
+func (id NestedItemVersionsId) KeyVaultId() commonids.KeyVaultId {
+	return commonids.NewKeyVaultID(id.SubscriptionId, id.ResourceGroupName, id.VaultName)
+}
+
+func (id NestedItemVersionsId) String() string {
+	segments := []string{
+		fmt.Sprintf("Nested Item Name %q", id.NestedItemName),
+		fmt.Sprintf("Nested Item Type %q", string(id.NestedItemType)),
+		fmt.Sprintf("Vault Name %q", id.VaultName),
+		fmt.Sprintf("Resource Group Name %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Nested Item Versions", segmentsStr)
+}
+
+func (id NestedItemVersionsId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/vaults/%s/%s/%s/versions"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.VaultName, string(id.NestedItemType), id.NestedItemName)
+}
+
+func (id NestedItemVersionsId) Segments() []resourceids.Segment {
+	return append(commonids.KeyVaultId{}.Segments(),
+		resourceids.ConstantSegment("nestedItemType", []string{
+			string(NestedItemTypeCertificate),
+			string(NestedItemTypeKey),
+			string(NestedItemTypeSecret),
+		}, string(NestedItemTypeSecret)),
+		resourceids.UserSpecifiedSegment("nestedItemName", "nestedItemValue"),
+		resourceids.StaticSegment("staticVersions", "versions", "versions"),
+	)
+}
+
+// NestedItemVersionsID parses a NestedItemVersions ID into an NestedItemVersionsId struct
+func NestedItemVersionsID(input string) (*NestedItemVersionsId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&NestedItemVersionsId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := NestedItemVersionsId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *NestedItemVersionsId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.VaultName, ok = input.Parsed["vaultName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "vaultName", input)
+	}
+
+	nestedItemType, ok := input.Parsed["nestedItemType"]
+	if !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "nestedItemType", input)
+	}
+	id.NestedItemType = NestedItemObjectType(nestedItemType)
+
+	if id.NestedItemName, ok = input.Parsed["nestedItemName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "nestedItemName", input)
+	}
+
+	return nil
+}
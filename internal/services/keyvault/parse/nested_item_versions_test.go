@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = NestedItemVersionsId{}
+
+func TestNestedItemVersionsIDFormatter(t *testing.T) {
+	keyVaultId := commonids.NewKeyVaultID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1")
+	actual := NewNestedItemVersionsID(keyVaultId, NestedItemTypeSecret, "secret1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1/versions"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNestedItemVersionsID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *NestedItemVersionsId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// key vault id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+			Error: true,
+		},
+
+		{
+			// missing versions suffix
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1",
+			Error: true,
+		},
+
+		{
+			// unsupported nested item type
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/storage/account1/versions",
+			Error: true,
+		},
+
+		{
+			// valid secret
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/secrets/secret1/versions",
+			Expected: &NestedItemVersionsId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroupName: "resGroup1",
+				VaultName:         "vault1",
+				NestedItemType:    NestedItemTypeSecret,
+				NestedItemName:    "secret1",
+			},
+		},
+
+		{
+			// valid key
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/keys/key1/versions",
+			Expected: &NestedItemVersionsId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroupName: "resGroup1",
+				VaultName:         "vault1",
+				NestedItemType:    NestedItemTypeKey,
+				NestedItemName:    "key1",
+			},
+		},
+
+		{
+			// valid certificate
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1/certificates/certificate1/versions",
+			Expected: &NestedItemVersionsId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroupName: "resGroup1",
+				VaultName:         "vault1",
+				NestedItemType:    NestedItemTypeCertificate,
+				NestedItemName:    "certificate1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.KEYVAULT/VAULTS/VAULT1/SECRETS/SECRET1/VERSIONS",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NestedItemVersionsID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroupName != v.Expected.ResourceGroupName {
+			t.Fatalf("Expected %q but got %q for ResourceGroupName", v.Expected.ResourceGroupName, actual.ResourceGroupName)
+		}
+		if actual.VaultName != v.Expected.VaultName {
+			t.Fatalf("Expected %q but got %q for VaultName", v.Expected.VaultName, actual.VaultName)
+		}
+		if actual.NestedItemType != v.Expected.NestedItemType {
+			t.Fatalf("Expected %q but got %q for NestedItemType", v.Expected.NestedItemType, actual.NestedItemType)
+		}
+		if actual.NestedItemName != v.Expected.NestedItemName {
+			t.Fatalf("Expected %q but got %q for NestedItemName", v.Expected.NestedItemName, actual.NestedItemName)
+		}
+	}
+}
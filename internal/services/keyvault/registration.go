@@ -63,6 +63,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		EncryptedValueDataSource{},
+		KeyVaultCertificateVersionsDataSource{},
+		KeyVaultKeyVersionsDataSource{},
+		KeyVaultSecretVersionsDataSource{},
 	}
 }
 
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package kusto_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestKustoAttachedDatabaseConfiguration_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_attached_database_configuration")
+}
+
+func TestKustoCluster_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_cluster")
+}
+
+func TestKustoClusterCustomerManagedKey_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_cluster_customer_managed_key")
+}
+
+func TestKustoClusterManagedPrivateEndpoint_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_cluster_managed_private_endpoint")
+}
+
+func TestKustoClusterPrincipalAssignment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_cluster_principal_assignment")
+}
+
+func TestKustoDatabase_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_database")
+}
+
+func TestKustoDatabasePrincipalAssignment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_database_principal_assignment")
+}
+
+func TestKustoEventgridDataConnection_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_eventgrid_data_connection")
+}
+
+func TestKustoEventhubDataConnection_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_eventhub_data_connection")
+}
+
+func TestKustoIothubDataConnection_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_iothub_data_connection")
+}
+
+func TestKustoScript_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, kusto.Registration{}, "azurerm_kusto_script")
+}
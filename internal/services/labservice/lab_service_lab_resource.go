@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -489,13 +490,13 @@ func (r LabServiceLabResource) Create() sdk.ResourceFunc {
 			props := &lab.Lab{
 				Location: location.Normalize(model.Location),
 				Properties: lab.LabProperties{
-					AutoShutdownProfile:   expandAutoShutdownProfile(model.AutoShutdown),
-					ConnectionProfile:     expandConnectionProfile(model.ConnectionSetting),
+					AutoShutdownProfile:   pointer.To(expandAutoShutdownProfile(model.AutoShutdown)),
+					ConnectionProfile:     pointer.To(expandConnectionProfile(model.ConnectionSetting)),
 					NetworkProfile:        expandNetworkProfile(model.Network, false, nil),
 					RosterProfile:         expandRosterProfile(model.Roster),
-					SecurityProfile:       expandSecurityProfile(model.Security),
+					SecurityProfile:       pointer.To(expandSecurityProfile(model.Security)),
 					Title:                 &model.Title,
-					VirtualMachineProfile: expandVirtualMachineProfile(model.VirtualMachine, false),
+					VirtualMachineProfile: pointer.To(expandVirtualMachineProfile(model.VirtualMachine, false)),
 				},
 				Tags: &model.Tags,
 			}
@@ -545,15 +546,15 @@ func (r LabServiceLabResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("auto_shutdown") {
-				props.Properties.AutoShutdownProfile = expandAutoShutdownProfile(model.AutoShutdown)
+				props.Properties.AutoShutdownProfile = pointer.To(expandAutoShutdownProfile(model.AutoShutdown))
 			}
 
 			if metadata.ResourceData.HasChange("connection_setting") {
-				props.Properties.ConnectionProfile = expandConnectionProfile(model.ConnectionSetting)
+				props.Properties.ConnectionProfile = pointer.To(expandConnectionProfile(model.ConnectionSetting))
 			}
 
 			if metadata.ResourceData.HasChange("security") {
-				props.Properties.SecurityProfile = expandSecurityProfile(model.Security)
+				props.Properties.SecurityProfile = pointer.To(expandSecurityProfile(model.Security))
 			}
 
 			if metadata.ResourceData.HasChange("title") {
@@ -561,7 +562,7 @@ func (r LabServiceLabResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("virtual_machine") {
-				props.Properties.VirtualMachineProfile = expandVirtualMachineProfile(model.VirtualMachine, true)
+				props.Properties.VirtualMachineProfile = pointer.To(expandVirtualMachineProfile(model.VirtualMachine, true))
 			}
 
 			if metadata.ResourceData.HasChange("network") {
@@ -625,12 +626,12 @@ func (r LabServiceLabResource) Read() sdk.ResourceFunc {
 				props := model.Properties
 
 				state.Location = location.Normalize(model.Location)
-				state.AutoShutdown = flattenAutoShutdownProfile(props.AutoShutdownProfile)
-				state.ConnectionSetting = flattenConnectionProfile(props.ConnectionProfile)
+				state.AutoShutdown = flattenAutoShutdownProfile(pointer.From(props.AutoShutdownProfile))
+				state.ConnectionSetting = flattenConnectionProfile(pointer.From(props.ConnectionProfile))
 				state.Network = flattenNetworkProfile(props.NetworkProfile)
 				state.Roster = flattenRosterProfile(props.RosterProfile)
-				state.Security = flattenSecurityProfile(props.SecurityProfile)
-				state.VirtualMachine = flattenVirtualMachineProfile(props.VirtualMachineProfile, metadata.ResourceData.Get("virtual_machine.0.admin_user.0.password").(string))
+				state.Security = flattenSecurityProfile(pointer.From(props.SecurityProfile))
+				state.VirtualMachine = flattenVirtualMachineProfile(pointer.From(props.VirtualMachineProfile), metadata.ResourceData.Get("virtual_machine.0.admin_user.0.password").(string))
 
 				if props.Description != nil {
 					state.Description = *props.Description
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package loganalytics_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestLogAnalyticsClusterCustomerManagedKey_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_cluster_customer_managed_key")
+}
+
+func TestLogAnalyticsDataExportRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_data_export_rule")
+}
+
+func TestLogAnalyticsDatasourceWindowsEvent_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_datasource_windows_event")
+}
+
+func TestLogAnalyticsDatasourceWindowsPerformanceCounter_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_datasource_windows_performance_counter")
+}
+
+func TestLogAnalyticsLinkedStorageAccount_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_linked_storage_account")
+}
+
+func TestLogAnalyticsSavedSearch_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_saved_search")
+}
+
+func TestLogAnalyticsSolution_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_solution")
+}
+
+func TestLogAnalyticsWorkspace_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, loganalytics.Registration{}, "azurerm_log_analytics_workspace")
+}
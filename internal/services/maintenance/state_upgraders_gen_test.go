@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package maintenance_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/maintenance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestMaintenanceAssignmentDedicatedHost_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, maintenance.Registration{}, "azurerm_maintenance_assignment_dedicated_host")
+}
+
+func TestMaintenanceAssignmentVirtualMachine_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, maintenance.Registration{}, "azurerm_maintenance_assignment_virtual_machine")
+}
+
+func TestMaintenanceAssignmentVirtualMachineScaleSet_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, maintenance.Registration{}, "azurerm_maintenance_assignment_virtual_machine_scale_set")
+}
+
+func TestMaintenanceConfiguration_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, maintenance.Registration{}, "azurerm_maintenance_configuration")
+}
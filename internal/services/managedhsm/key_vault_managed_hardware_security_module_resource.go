@@ -196,15 +196,35 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 		return tf.ImportAsExistsError("azurerm_key_vault_managed_hardware_security_module", id.ID())
 	}
 
+	loc := azure.NormalizeLocation(d.Get("location").(string))
+
+	createMode := managedhsms.CreateModeDefault
+	deletedId := managedhsms.NewDeletedManagedHSMID(subscriptionId, loc, id.ManagedHSMName)
+	deleted, err := hsmClient.GetDeleted(ctx, deletedId)
+	if err != nil {
+		if !response.WasNotFound(deleted.HttpResponse) && !response.WasForbidden(deleted.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing soft-deleted %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(deleted.HttpResponse) && !response.WasForbidden(deleted.HttpResponse) {
+		if !meta.(*clients.Client).Features.ManagedHSM.RecoverSoftDeleted {
+			// this could be a Managed HSM which was deleted outside of Terraform, so we'll surface this rather than purging it
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedManagedHSMErrorFmt(id.ManagedHSMName, loc))
+		}
+
+		log.Printf("[DEBUG] Soft-deleted %s exists, marked for recovery", id)
+		createMode = managedhsms.CreateModeRecover
+	}
+
 	publicNetworkAccessEnabled := managedhsms.PublicNetworkAccessEnabled
 	if !d.Get("public_network_access_enabled").(bool) {
 		publicNetworkAccessEnabled = managedhsms.PublicNetworkAccessDisabled
 	}
 	hsm := managedhsms.ManagedHsm{
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Location: utils.String(loc),
 		Properties: &managedhsms.ManagedHsmProperties{
 			InitialAdminObjectIds:     utils.ExpandStringSlice(d.Get("admin_object_ids").(*pluginsdk.Set).List()),
-			CreateMode:                pointer.To(managedhsms.CreateModeDefault),
+			CreateMode:                pointer.To(createMode),
 			EnableSoftDelete:          utils.Bool(true),
 			SoftDeleteRetentionInDays: utils.Int64(int64(d.Get("soft_delete_retention_days").(int))),
 			EnablePurgeProtection:     utils.Bool(d.Get("purge_protection_enabled").(bool)),
@@ -234,6 +254,13 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 		if err != nil || resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.HsmUri == nil {
 			return fmt.Errorf("got nil HSMUri for %s: %+v", id, err)
 		}
+
+		// a recovered Managed HSM retains its Security Domain, so is already activated and can't be downloaded again
+		if isManagedHSMActivated(resp.Model.Properties) {
+			log.Printf("[DEBUG] %s is already activated - skipping the security domain download", id)
+			return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
+		}
+
 		encData, err := securityDomainDownload(ctx, kvClient, *resp.Model.Properties.HsmUri, d.Get("security_domain_key_vault_certificate_ids").([]interface{}), d.Get("security_domain_quorum").(int))
 		if err != nil {
 			return fmt.Errorf("downloading security domain for %q: %+v", id, err)
@@ -386,11 +413,17 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.Resourc
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
-	if meta.(*clients.Client).Features.KeyVault.PurgeSoftDeletedHSMsOnDestroy {
-		if purgeProtectionEnabled {
-			log.Printf("[DEBUG] cannot purge %s because purge protection is enabled", id)
-			return nil
-		}
+	// NOTE: `purge_soft_deleted_hardware_security_modules_on_destroy` within the `key_vault` features block
+	// predates the `managed_hsm` block, so purging can be disabled through either
+	features := meta.(*clients.Client).Features
+	if !features.ManagedHSM.PurgeSoftDeleteOnDestroy || !features.KeyVault.PurgeSoftDeletedHSMsOnDestroy {
+		log.Printf("[DEBUG] skipping purging of %s as opted-out", id)
+		return nil
+	}
+
+	if purgeProtectionEnabled {
+		log.Printf("[DEBUG] cannot purge %s because purge protection is enabled", id)
+		return nil
 	}
 
 	// the polling operation of purge can not terminate correctly, so we use the custom polling operation of polling delete
@@ -518,6 +551,30 @@ func securityDomainDownload(ctx context.Context, cli *client.Client, vaultBaseUr
 	return encData.Value, err
 }
 
+func isManagedHSMActivated(input *managedhsms.ManagedHsmProperties) bool {
+	if input == nil || input.SecurityDomainProperties == nil || input.SecurityDomainProperties.ActivationStatus == nil {
+		return false
+	}
+	return *input.SecurityDomainProperties.ActivationStatus == managedhsms.ActivationStatusActive
+}
+
+func optedOutOfRecoveringSoftDeletedManagedHSMErrorFmt(name, location string) string {
+	message := `
+An existing soft-deleted Managed HSM exists with the Name %q in the location %q, however
+automatically recovering this Managed HSM has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted Managed HSM when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/features-block
+
+Alternatively you can manually recover or purge this (e.g. using the Azure CLI) and then
+import this into Terraform via "terraform import", or pick a different name/location.
+`
+	return fmt.Sprintf(message, name, location)
+}
+
 func keyVaultHSMCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
 	if oldVal, newVal := d.GetChange("security_domain_key_vault_certificate_ids"); len(oldVal.([]interface{})) != 0 && len(newVal.([]interface{})) == 0 {
 		if err := d.ForceNew("security_domain_key_vault_certificate_ids"); err != nil {
@@ -28,6 +28,7 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"update":      testAccKeyVaultManagedHardwareSecurityModule_updateAndRequiresImport,
 			"complete":    testAccKeyVaultManagedHardwareSecurityModule_complete,
 			"download":    testAccKeyVaultManagedHardwareSecurityModule_download,
+			"recovery":    testAccKeyVaultManagedHardwareSecurityModule_softDeleteRecovery,
 			"role_define": testAccKeyVaultManagedHardwareSecurityModule_roleDefinition,
 			"role_assign": testAccKeyVaultManagedHardwareSecurityModule_roleAssignment,
 		},
@@ -78,6 +79,34 @@ func testAccKeyVaultManagedHardwareSecurityModule_download(t *testing.T) {
 	})
 }
 
+func testAccKeyVaultManagedHardwareSecurityModule_softDeleteRecovery(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module", "test")
+	r := KeyVaultManagedHardwareSecurityModuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			// create it regularly
+			Config: r.softDelete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// delete the managed hsm, leaving it soft-deleted
+			Config: r.softDeleteAbsent(data),
+		},
+		{
+			// attempting to re-create it requires recovery, which is enabled by default
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func testAccKeyVaultManagedHardwareSecurityModule_roleDefinition(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_definition", "test")
 	r := KeyVaultMHSMRoleDefinitionResource{}
@@ -195,6 +224,47 @@ resource "azurerm_key_vault_managed_hardware_security_module" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r KeyVaultManagedHardwareSecurityModuleResource) softDelete(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    managed_hsm {
+      purge_soft_delete_on_destroy = false
+      recover_soft_deleted         = false
+    }
+  }
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module" "test" {
+  name                     = "kvHsm%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  sku_name                 = "Standard_B1"
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+  admin_object_ids         = [data.azurerm_client_config.current.object_id]
+  purge_protection_enabled = false
+}
+`, template, data.RandomInteger)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleResource) softDeleteAbsent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    managed_hsm {
+      purge_soft_delete_on_destroy = false
+      recover_soft_deleted         = false
+    }
+  }
+}
+
+%s
+`, r.template(data))
+}
+
 func (r KeyVaultManagedHardwareSecurityModuleResource) basicUpdate(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package media_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/media"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestMediaAsset_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_asset")
+}
+
+func TestMediaAssetFilter_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_asset_filter")
+}
+
+func TestMediaContentKeyPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_content_key_policy")
+}
+
+func TestMediaJob_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_job")
+}
+
+func TestMediaLiveEvent_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_live_event")
+}
+
+func TestMediaLiveEventOutput_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_live_event_output")
+}
+
+func TestMediaServicesAccount_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_services_account")
+}
+
+func TestMediaStreamingEndpoint_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_streaming_endpoint")
+}
+
+func TestMediaStreamingLocator_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_streaming_locator")
+}
+
+func TestMediaStreamingPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_streaming_policy")
+}
+
+func TestMediaTransform_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, media.Registration{}, "azurerm_media_transform")
+}
@@ -67,8 +67,11 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(aadDiagnosticSettingsClient.Client, o.Authorizers.ResourceManager)
 
-	AutoscaleSettingsClient := autoscalesettings.NewAutoScaleSettingsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&AutoscaleSettingsClient.Client, o.ResourceManagerAuthorizer)
+	AutoscaleSettingsClient, err := autoscalesettings.NewAutoScaleSettingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building AutoScaleSettings client: %+v", err)
+	}
+	o.Configure(AutoscaleSettingsClient.Client, o.Authorizers.ResourceManager)
 
 	ActionRulesClient := alertsmanagement.NewActionRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ActionRulesClient.Client, o.ResourceManagerAuthorizer)
@@ -82,14 +85,23 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	SmartDetectorAlertRulesClient := alertsmanagement.NewSmartDetectorAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SmartDetectorAlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
-	ActionGroupsClient := actiongroupsapis.NewActionGroupsAPIsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&ActionGroupsClient.Client, o.ResourceManagerAuthorizer)
+	ActionGroupsClient, err := actiongroupsapis.NewActionGroupsAPIsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ActionGroupsAPIs client: %+v", err)
+	}
+	o.Configure(ActionGroupsClient.Client, o.Authorizers.ResourceManager)
 
-	activityLogsClient := activitylogs.NewActivityLogsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&activityLogsClient.Client, o.ResourceManagerAuthorizer)
+	activityLogsClient, err := activitylogs.NewActivityLogsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ActivityLogs client: %+v", err)
+	}
+	o.Configure(activityLogsClient.Client, o.Authorizers.ResourceManager)
 
-	ActivityLogAlertsClient := activitylogalertsapis.NewActivityLogAlertsAPIsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&ActivityLogAlertsClient.Client, o.ResourceManagerAuthorizer)
+	ActivityLogAlertsClient, err := activitylogalertsapis.NewActivityLogAlertsAPIsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ActivityLogAlertsAPIs client: %+v", err)
+	}
+	o.Configure(ActivityLogAlertsClient.Client, o.Authorizers.ResourceManager)
 
 	alertPrometheusRuleGroupClient, err := prometheusrulegroups.NewPrometheusRuleGroupsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
@@ -97,63 +109,99 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(alertPrometheusRuleGroupClient.Client, o.Authorizers.ResourceManager)
 
-	DataCollectionEndpointsClient := datacollectionendpoints.NewDataCollectionEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&DataCollectionEndpointsClient.Client, o.ResourceManagerAuthorizer)
+	DataCollectionEndpointsClient, err := datacollectionendpoints.NewDataCollectionEndpointsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DataCollectionEndpoints client: %+v", err)
+	}
+	o.Configure(DataCollectionEndpointsClient.Client, o.Authorizers.ResourceManager)
 
-	DataCollectionRuleAssociationsClient := datacollectionruleassociations.NewDataCollectionRuleAssociationsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&DataCollectionRuleAssociationsClient.Client, o.ResourceManagerAuthorizer)
+	DataCollectionRuleAssociationsClient, err := datacollectionruleassociations.NewDataCollectionRuleAssociationsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DataCollectionRuleAssociations client: %+v", err)
+	}
+	o.Configure(DataCollectionRuleAssociationsClient.Client, o.Authorizers.ResourceManager)
 
-	DataCollectionRulesClient := datacollectionrules.NewDataCollectionRulesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&DataCollectionRulesClient.Client, o.ResourceManagerAuthorizer)
+	DataCollectionRulesClient, err := datacollectionrules.NewDataCollectionRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DataCollectionRules client: %+v", err)
+	}
+	o.Configure(DataCollectionRulesClient.Client, o.Authorizers.ResourceManager)
 
-	DiagnosticSettingsClient := diagnosticSettingClient.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&DiagnosticSettingsClient.Client, o.ResourceManagerAuthorizer)
+	DiagnosticSettingsClient, err := diagnosticSettingClient.NewDiagnosticSettingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DiagnosticSettings client: %+v", err)
+	}
+	o.Configure(DiagnosticSettingsClient.Client, o.Authorizers.ResourceManager)
 
-	DiagnosticSettingsCategoryClient := diagnosticCategoryClient.NewDiagnosticSettingsCategoriesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&DiagnosticSettingsCategoryClient.Client, o.ResourceManagerAuthorizer)
+	DiagnosticSettingsCategoryClient, err := diagnosticCategoryClient.NewDiagnosticSettingsCategoriesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DiagnosticSettingsCategories client: %+v", err)
+	}
+	o.Configure(DiagnosticSettingsCategoryClient.Client, o.Authorizers.ResourceManager)
 
-	LogProfilesClient := logprofiles.NewLogProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&LogProfilesClient.Client, o.ResourceManagerAuthorizer)
+	LogProfilesClient, err := logprofiles.NewLogProfilesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building LogProfiles client: %+v", err)
+	}
+	o.Configure(LogProfilesClient.Client, o.Authorizers.ResourceManager)
 
-	MetricAlertsClient := metricalerts.NewMetricAlertsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&MetricAlertsClient.Client, o.ResourceManagerAuthorizer)
+	MetricAlertsClient, err := metricalerts.NewMetricAlertsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building MetricAlerts client: %+v", err)
+	}
+	o.Configure(MetricAlertsClient.Client, o.Authorizers.ResourceManager)
 
-	PrivateLinkScopesClient := privatelinkscopesapis.NewPrivateLinkScopesAPIsClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&PrivateLinkScopesClient.Client, o.ResourceManagerAuthorizer)
+	PrivateLinkScopesClient, err := privatelinkscopesapis.NewPrivateLinkScopesAPIsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building PrivateLinkScopesAPIs client: %+v", err)
+	}
+	o.Configure(PrivateLinkScopesClient.Client, o.Authorizers.ResourceManager)
 
-	PrivateLinkScopedResourcesClient := privatelinkscopedresources.NewPrivateLinkScopedResourcesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&PrivateLinkScopedResourcesClient.Client, o.ResourceManagerAuthorizer)
+	PrivateLinkScopedResourcesClient, err := privatelinkscopedresources.NewPrivateLinkScopedResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building PrivateLinkScopedResources client: %+v", err)
+	}
+	o.Configure(PrivateLinkScopedResourcesClient.Client, o.Authorizers.ResourceManager)
 
-	ScheduledQueryRulesClient := scheduledqueryrules2018.NewScheduledQueryRulesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&ScheduledQueryRulesClient.Client, o.ResourceManagerAuthorizer)
+	ScheduledQueryRulesClient, err := scheduledqueryrules2018.NewScheduledQueryRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ScheduledQueryRules client: %+v", err)
+	}
+	o.Configure(ScheduledQueryRulesClient.Client, o.Authorizers.ResourceManager)
 
-	ScheduledQueryRulesV2Client := scheduledqueryrules.NewScheduledQueryRulesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&ScheduledQueryRulesV2Client.Client, o.ResourceManagerAuthorizer)
+	ScheduledQueryRulesV2Client, err := scheduledqueryrules.NewScheduledQueryRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ScheduledQueryRules client: %+v", err)
+	}
+	o.Configure(ScheduledQueryRulesV2Client.Client, o.Authorizers.ResourceManager)
 
-	WorkspacesClient := azuremonitorworkspaces.NewAzureMonitorWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&WorkspacesClient.Client, o.ResourceManagerAuthorizer)
+	WorkspacesClient, err := azuremonitorworkspaces.NewAzureMonitorWorkspacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building AzureMonitorWorkspaces client: %+v", err)
+	}
+	o.Configure(WorkspacesClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
 		AADDiagnosticSettingsClient:          aadDiagnosticSettingsClient,
-		AutoscaleSettingsClient:              &AutoscaleSettingsClient,
+		AutoscaleSettingsClient:              AutoscaleSettingsClient,
 		ActionRulesClient:                    &ActionRulesClient,
 		SmartDetectorAlertRulesClient:        &SmartDetectorAlertRulesClient,
-		ActionGroupsClient:                   &ActionGroupsClient,
-		ActivityLogsClient:                   &activityLogsClient,
-		ActivityLogAlertsClient:              &ActivityLogAlertsClient,
+		ActionGroupsClient:                   ActionGroupsClient,
+		ActivityLogsClient:                   activityLogsClient,
+		ActivityLogAlertsClient:              ActivityLogAlertsClient,
 		AlertPrometheusRuleGroupClient:       alertPrometheusRuleGroupClient,
 		AlertProcessingRulesClient:           alertProcessingRulesClient,
-		DataCollectionEndpointsClient:        &DataCollectionEndpointsClient,
-		DataCollectionRuleAssociationsClient: &DataCollectionRuleAssociationsClient,
-		DataCollectionRulesClient:            &DataCollectionRulesClient,
-		DiagnosticSettingsClient:             &DiagnosticSettingsClient,
-		DiagnosticSettingsCategoryClient:     &DiagnosticSettingsCategoryClient,
-		LogProfilesClient:                    &LogProfilesClient,
-		MetricAlertsClient:                   &MetricAlertsClient,
-		PrivateLinkScopesClient:              &PrivateLinkScopesClient,
-		PrivateLinkScopedResourcesClient:     &PrivateLinkScopedResourcesClient,
-		ScheduledQueryRulesClient:            &ScheduledQueryRulesClient,
-		ScheduledQueryRulesV2Client:          &ScheduledQueryRulesV2Client,
-		WorkspacesClient:                     &WorkspacesClient,
+		DataCollectionEndpointsClient:        DataCollectionEndpointsClient,
+		DataCollectionRuleAssociationsClient: DataCollectionRuleAssociationsClient,
+		DataCollectionRulesClient:            DataCollectionRulesClient,
+		DiagnosticSettingsClient:             DiagnosticSettingsClient,
+		DiagnosticSettingsCategoryClient:     DiagnosticSettingsCategoryClient,
+		LogProfilesClient:                    LogProfilesClient,
+		MetricAlertsClient:                   MetricAlertsClient,
+		PrivateLinkScopesClient:              PrivateLinkScopesClient,
+		PrivateLinkScopedResourcesClient:     PrivateLinkScopedResourcesClient,
+		ScheduledQueryRulesClient:            ScheduledQueryRulesClient,
+		ScheduledQueryRulesV2Client:          ScheduledQueryRulesV2Client,
+		WorkspacesClient:                     WorkspacesClient,
 	}, nil
 }
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestMonitorActionGroup_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_action_group")
+}
+
+func TestMonitorActivityLogAlert_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_activity_log_alert")
+}
+
+func TestMonitorAutoscaleSetting_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_autoscale_setting")
+}
+
+func TestMonitorLogProfile_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_log_profile")
+}
+
+func TestMonitorMetricAlert_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_metric_alert")
+}
+
+func TestMonitorScheduledQueryRulesAlert_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_scheduled_query_rules_alert")
+}
+
+func TestMonitorScheduledQueryRulesLog_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_scheduled_query_rules_log")
+}
+
+func TestMonitorSmartDetectorAlertRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, monitor.Registration{}, "azurerm_monitor_smart_detector_alert_rule")
+}
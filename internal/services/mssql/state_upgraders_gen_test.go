@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package mssql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestMssqlDatabase_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, mssql.Registration{}, "azurerm_mssql_database")
+}
+
+func TestMssqlServerTransparentDataEncryption_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, mssql.Registration{}, "azurerm_mssql_server_transparent_data_encryption")
+}
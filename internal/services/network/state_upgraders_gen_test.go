@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestNetworkInterfaceApplicationSecurityGroupAssociation_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, network.Registration{}, "azurerm_network_interface_application_security_group_association")
+}
+
+func TestNetworkPacketCapture_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, network.Registration{}, "azurerm_network_packet_capture")
+}
+
+func TestNetworkWatcherFlowLog_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, network.Registration{}, "azurerm_network_watcher_flow_log")
+}
+
+func TestWebApplicationFirewallPolicy_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, network.Registration{}, "azurerm_web_application_firewall_policy")
+}
@@ -118,7 +118,7 @@ func resourceNotificationHubNamespaceCreateUpdate(d *pluginsdk.ResourceData, met
 	namespaceType := namespaces.NamespaceType(d.Get("namespace_type").(string))
 	location := location.Normalize(d.Get("location").(string))
 	parameters := namespaces.NamespaceCreateOrUpdateParameters{
-		Location: location,
+		Location: utils.String(location),
 		Sku: &namespaces.Sku{
 			Name: namespaces.SkuName(d.Get("sku_name").(string)),
 		},
@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package notificationhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestNotificationHub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, notificationhub.Registration{}, "azurerm_notification_hub")
+}
+
+func TestNotificationHubAuthorizationRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, notificationhub.Registration{}, "azurerm_notification_hub_authorization_rule")
+}
+
+func TestNotificationHubNamespace_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, notificationhub.Registration{}, "azurerm_notification_hub_namespace")
+}
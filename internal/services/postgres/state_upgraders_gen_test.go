@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestPostgresqlActiveDirectoryAdministrator_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, postgres.Registration{}, "azurerm_postgresql_active_directory_administrator")
+}
+
+func TestPostgresqlDatabase_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, postgres.Registration{}, "azurerm_postgresql_database")
+}
+
+func TestPostgresqlServer_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, postgres.Registration{}, "azurerm_postgresql_server")
+}
@@ -891,7 +891,7 @@ func expandRedisConfiguration(d *pluginsdk.ResourceData) (*redis.RedisCommonProp
 	}
 
 	if v := raw["notify_keyspace_events"].(string); v != "" {
-		output.NotifyKeyspaceEvents = utils.String(v)
+		output.NotifyKeyspaceEvents = v
 	}
 
 	// AOF Backup
@@ -1051,9 +1051,7 @@ func flattenRedisConfiguration(input *redis.RedisCommonPropertiesRedisConfigurat
 	if input.RdbStorageConnectionString != nil {
 		outputs["rdb_storage_connection_string"] = *input.RdbStorageConnectionString
 	}
-	if v := input.NotifyKeyspaceEvents; v != nil {
-		outputs["notify_keyspace_events"] = v
-	}
+	outputs["notify_keyspace_events"] = input.NotifyKeyspaceEvents
 
 	if v := input.AofBackupEnabled; v != nil {
 		b, err := strconv.ParseBool(*v)
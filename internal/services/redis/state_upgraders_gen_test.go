@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package redis_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestRedisCache_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, redis.Registration{}, "azurerm_redis_cache")
+}
+
+func TestRedisFirewallRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, redis.Registration{}, "azurerm_redis_firewall_rule")
+}
+
+func TestRedisLinkedServer_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, redis.Registration{}, "azurerm_redis_linked_server")
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package securitycenter_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestAdvancedThreatProtection_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, securitycenter.Registration{}, "azurerm_advanced_threat_protection")
+}
+
+func TestIotSecuritySolution_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, securitycenter.Registration{}, "azurerm_iot_security_solution")
+}
+
+func TestSecurityCenterAutoProvisioning_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, securitycenter.Registration{}, "azurerm_security_center_auto_provisioning")
+}
+
+func TestSecurityCenterSubscriptionPricing_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, securitycenter.Registration{}, "azurerm_security_center_subscription_pricing")
+}
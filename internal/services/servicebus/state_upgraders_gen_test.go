@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package servicebus_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestServicebusNamespace_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, servicebus.Registration{}, "azurerm_servicebus_namespace")
+}
+
+func TestServicebusNamespaceAuthorizationRule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, servicebus.Registration{}, "azurerm_servicebus_namespace_authorization_rule")
+}
+
+func TestServicebusNamespaceNetworkRuleSet_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, servicebus.Registration{}, "azurerm_servicebus_namespace_network_rule_set")
+}
+
+func TestServicebusSubscription_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, servicebus.Registration{}, "azurerm_servicebus_subscription")
+}
@@ -4,6 +4,8 @@
 package client
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabric/2021-06-01/cluster"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -12,11 +14,14 @@ type Client struct {
 	ClustersClient *cluster.ClusterClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
-	clustersClient := cluster.NewClusterClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&clustersClient.Client, o.ResourceManagerAuthorizer)
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	clustersClient, err := cluster.NewClusterClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Cluster client: %+v", err)
+	}
+	o.Configure(clustersClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		ClustersClient: &clustersClient,
-	}
+		ClustersClient: clustersClient,
+	}, nil
 }
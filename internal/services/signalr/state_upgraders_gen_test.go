@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package signalr_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestSignalrService_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, signalr.Registration{}, "azurerm_signalr_service")
+}
+
+func TestSignalrServiceNetworkAcl_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, signalr.Registration{}, "azurerm_signalr_service_network_acl")
+}
+
+func TestWebPubsub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, signalr.Registration{}, "azurerm_web_pubsub")
+}
+
+func TestWebPubsubHub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, signalr.Registration{}, "azurerm_web_pubsub_hub")
+}
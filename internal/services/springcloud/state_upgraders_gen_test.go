@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package springcloud_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestSpringCloudAccelerator_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_accelerator")
+}
+
+func TestSpringCloudActiveDeployment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_active_deployment")
+}
+
+func TestSpringCloudApiPortal_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_api_portal")
+}
+
+func TestSpringCloudApiPortalCustomDomain_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_api_portal_custom_domain")
+}
+
+func TestSpringCloudApp_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_app")
+}
+
+func TestSpringCloudAppCosmosdbAssociation_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_app_cosmosdb_association")
+}
+
+func TestSpringCloudAppMysqlAssociation_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_app_mysql_association")
+}
+
+func TestSpringCloudAppRedisAssociation_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_app_redis_association")
+}
+
+func TestSpringCloudBuildDeployment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_build_deployment")
+}
+
+func TestSpringCloudBuildPackBinding_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_build_pack_binding")
+}
+
+func TestSpringCloudBuilder_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_builder")
+}
+
+func TestSpringCloudCertificate_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_certificate")
+}
+
+func TestSpringCloudConfigurationService_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_configuration_service")
+}
+
+func TestSpringCloudContainerDeployment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_container_deployment")
+}
+
+func TestSpringCloudCustomDomain_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_custom_domain")
+}
+
+func TestSpringCloudCustomizedAccelerator_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_customized_accelerator")
+}
+
+func TestSpringCloudGateway_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_gateway")
+}
+
+func TestSpringCloudGatewayCustomDomain_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_gateway_custom_domain")
+}
+
+func TestSpringCloudGatewayRouteConfig_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_gateway_route_config")
+}
+
+func TestSpringCloudJavaDeployment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_java_deployment")
+}
+
+func TestSpringCloudService_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_service")
+}
+
+func TestSpringCloudStorage_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, springcloud.Registration{}, "azurerm_spring_cloud_storage")
+}
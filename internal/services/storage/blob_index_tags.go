@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+// Giovanni doesn't expose the Index Tags of a Blob, nor the Properties of a specific Version of a Blob - however
+// both are supported by the API Version used by Giovanni, as such these requests are sent using the Giovanni Blobs
+// Client (and API Version) rather than a separate client.
+
+type blobIndexTags struct {
+	XMLName xml.Name       `xml:"Tags"`
+	Tags    []blobIndexTag `xml:"TagSet>Tag"`
+}
+
+type blobIndexTag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+// getBlobProperties retrieves the Properties of the Blob, or of the specific Snapshot or Version of the Blob
+func getBlobProperties(ctx context.Context, client *blobs.Client, id parse.StorageBlobDataPlaneId) (result blobs.GetPropertiesResult, err error) {
+	if id.Snapshot != "" {
+		return client.GetSnapshotProperties(ctx, id.AccountName, id.ContainerName, id.Name, blobs.GetSnapshotPropertiesInput{
+			SnapshotID: id.Snapshot,
+		})
+	}
+
+	req, err := client.GetPropertiesPreparer(ctx, id.AccountName, id.ContainerName, id.Name, blobs.GetPropertiesInput{})
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "blobs.Client", "GetProperties", nil, "Failure preparing request")
+	}
+	if id.VersionID != "" {
+		query := req.URL.Query()
+		query.Set("versionid", id.VersionID)
+		req.URL.RawQuery = query.Encode()
+	}
+
+	resp, err := client.GetPropertiesSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "blobs.Client", "GetProperties", resp, "Failure sending request")
+	}
+
+	result, err = client.GetPropertiesResponder(resp)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "blobs.Client", "GetProperties", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// getBlobIndexTags returns the Index Tags for the Blob (or the specific Snapshot or Version of the Blob) described by
+// the Properties - which are only retrieved when the Blob has Index Tags, since they're unsupported for some types of
+// Storage Account (e.g. those with a Hierarchical Namespace)
+func getBlobIndexTags(ctx context.Context, client *blobs.Client, id parse.StorageBlobDataPlaneId, props blobs.GetPropertiesResult) (map[string]string, error) {
+	output := make(map[string]string)
+
+	if props.Response.Response == nil {
+		return output, nil
+	}
+	if count, _ := strconv.Atoi(props.Header.Get("x-ms-tag-count")); count == 0 {
+		return output, nil
+	}
+
+	req, err := blobIndexTagsPreparer(ctx, client, id, autorest.AsGet())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "blobs.Client", "GetTags", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "blobs.Client", "GetTags", resp, "Failure sending request")
+	}
+
+	var tags blobIndexTags
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&tags),
+		autorest.ByClosing())
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "blobs.Client", "GetTags", resp, "Failure responding to request")
+	}
+
+	for _, tag := range tags.Tags {
+		output[tag.Key] = tag.Value
+	}
+
+	return output, nil
+}
+
+// setBlobIndexTags replaces the Index Tags for the Blob (or the specific Version of the Blob)
+func setBlobIndexTags(ctx context.Context, client *blobs.Client, id parse.StorageBlobDataPlaneId, input map[string]string) error {
+	tags := blobIndexTags{
+		Tags: make([]blobIndexTag, 0),
+	}
+	for k, v := range input {
+		tags.Tags = append(tags.Tags, blobIndexTag{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	req, err := blobIndexTagsPreparer(ctx, client, id, autorest.AsPut(), autorest.WithXML(tags))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "blobs.Client", "SetTags", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "blobs.Client", "SetTags", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "blobs.Client", "SetTags", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func blobIndexTagsPreparer(ctx context.Context, client *blobs.Client, id parse.StorageBlobDataPlaneId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"containerName": autorest.Encode("path", id.ContainerName),
+		"blobName":      autorest.Encode("path", id.Name),
+	}
+
+	queryParameters := map[string]interface{}{
+		"comp": autorest.Encode("query", "tags"),
+	}
+	if id.Snapshot != "" {
+		queryParameters["snapshot"] = autorest.Encode("query", id.Snapshot)
+	}
+	if id.VersionID != "" {
+		queryParameters["versionid"] = autorest.Encode("query", id.VersionID)
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": blobs.APIVersion,
+	}
+
+	decorators = append([]autorest.PrepareDecorator{
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.blob.%s", id.AccountName, client.BaseURI)),
+		autorest.WithPathParameters("/{containerName}/{blobName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers),
+	}, decorators...)
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
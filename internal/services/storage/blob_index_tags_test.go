@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+func TestBlobIndexTagsPreparer(t *testing.T) {
+	client := blobs.NewWithEnvironment(azure.PublicCloud)
+	id := parse.NewStorageBlobDataPlaneId("account1", "core.windows.net", "container1", "blob1")
+	id.VersionID = "2023-11-03T00:00:00.0000000Z"
+
+	tags := blobIndexTags{
+		Tags: []blobIndexTag{
+			{
+				Key:   "project",
+				Value: "example",
+			},
+		},
+	}
+	req, err := blobIndexTagsPreparer(context.TODO(), &client, id, autorest.AsPut(), autorest.WithXML(tags))
+	if err != nil {
+		t.Fatalf("preparing the request: %+v", err)
+	}
+
+	if req.Method != http.MethodPut {
+		t.Fatalf("expected the Method to be %q but got %q", http.MethodPut, req.Method)
+	}
+	if req.URL.Host != "account1.blob.core.windows.net" || req.URL.Path != "/container1/blob1" {
+		t.Fatalf("expected the request to be for the blob but got %q", req.URL.String())
+	}
+	if v := req.URL.Query().Get("comp"); v != "tags" {
+		t.Fatalf("expected `comp` to be `tags` but got %q", v)
+	}
+	if v := req.URL.Query().Get("versionid"); v != id.VersionID {
+		t.Fatalf("expected `versionid` to be %q but got %q", id.VersionID, v)
+	}
+	if v := req.Header.Get("x-ms-version"); v != blobs.APIVersion {
+		t.Fatalf("expected `x-ms-version` to be %q but got %q", blobs.APIVersion, v)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("reading the body: %+v", err)
+	}
+	expected := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<Tags><TagSet><Tag><Key>project</Key><Value>example</Value></Tag></TagSet></Tags>"
+	if string(body) != expected {
+		t.Fatalf("expected the body to be %q but got %q", expected, string(body))
+	}
+}
+
+func TestBlobIndexTagsUnmarshal(t *testing.T) {
+	body := `<?xml version="1.0" encoding="utf-8"?>
+<Tags>
+  <TagSet>
+    <Tag><Key>project</Key><Value>example</Value></Tag>
+    <Tag><Key>environment</Key><Value></Value></Tag>
+  </TagSet>
+</Tags>`
+
+	var tags blobIndexTags
+	if err := xml.Unmarshal([]byte(body), &tags); err != nil {
+		t.Fatalf("unmarshalling the Index Tags: %+v", err)
+	}
+
+	expected := []blobIndexTag{
+		{
+			Key:   "project",
+			Value: "example",
+		},
+		{
+			Key:   "environment",
+			Value: "",
+		},
+	}
+	if !reflect.DeepEqual(tags.Tags, expected) {
+		t.Fatalf("expected the Tags to be %+v but got %+v", expected, tags.Tags)
+	}
+}
@@ -6,10 +6,12 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"runtime"
 	"strings"
@@ -30,6 +32,7 @@ type BlobUpload struct {
 	ContainerName string
 
 	BlobType      string
+	BlockSize     int64
 	CacheControl  string
 	ContentType   string
 	ContentMD5    string
@@ -154,15 +157,46 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
-	input := blobs.PutBlockBlobInput{
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+	fileSize := info.Size()
+
+	blockSize, err := blockSizeForFile(sbu.BlockSize, fileSize)
+	if err != nil {
+		return err
+	}
+
+	// the MD5 of the file is used both as the `content_md5` when one isn't specified, and to determine which
+	// blocks (from a previous attempt at uploading this file) can be reused
+	contentMD5, err := fileContentMD5(file)
+	if err != nil {
+		return fmt.Errorf("computing the MD5 of %q: %s", sbu.Source, err)
+	}
+	if sbu.ContentMD5 == "" {
+		sbu.ContentMD5 = contentMD5
+	}
+
+	// files smaller than the block size are uploaded as a single block, rather than being read into memory in full
+	blockList, err := sbu.blockUploadFromSource(ctx, file, fileSize, blockSize, contentMD5)
+	if err != nil {
+		return fmt.Errorf("uploading blocks: %s", err)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockList,
+		},
+		ContentMD5:  utils.String(sbu.ContentMD5),
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
 	}
-	if sbu.ContentMD5 != "" {
-		input.ContentMD5 = utils.String(sbu.ContentMD5)
+	if sbu.CacheControl != "" {
+		input.CacheControl = utils.String(sbu.CacheControl)
 	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("PutBlockBlobFromFile: %s", err)
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
 	}
 
 	return nil
@@ -383,6 +417,212 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+const (
+	defaultBlockSize int64 = 4 * 1024 * 1024
+	maxBlockSize     int64 = 4000 * 1024 * 1024
+	maxBlockCount    int64 = 50000
+
+	// maxBufferedBytes is the maximum amount of memory used to buffer the blocks being uploaded, across all workers
+	maxBufferedBytes int64 = 1024 * 1024 * 1024
+)
+
+// blockSizeForFile returns the size of the blocks used to upload the file - when a block size isn't specified this
+// is the default block size, doubled as required so that the file can be uploaded within the maximum number of blocks
+func blockSizeForFile(blockSize int64, fileSize int64) (int64, error) {
+	if blockSize == 0 {
+		blockSize = defaultBlockSize
+		for blockSize*maxBlockCount < fileSize && blockSize < maxBlockSize {
+			blockSize *= 2
+		}
+		if blockSize > maxBlockSize {
+			blockSize = maxBlockSize
+		}
+	}
+
+	if blockSize*maxBlockCount < fileSize {
+		return 0, fmt.Errorf("a file of %d bytes cannot be uploaded using a block size of %d bytes, since a Block blob can contain at most %d blocks", fileSize, blockSize, maxBlockCount)
+	}
+
+	return blockSize, nil
+}
+
+// fileContentMD5 returns the Base64 encoded MD5 of the file, leaving the file positioned at the start
+func fileContentMD5(file io.ReadSeeker) (string, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// contentMD5ForSource returns the Base64 encoded MD5 of either the file at `source` (which is streamed, rather than
+// being read into memory) or the `sourceContent`
+func contentMD5ForSource(source, sourceContent string) (string, error) {
+	if source == "" {
+		hash := md5.Sum([]byte(sourceContent))
+		return base64.StdEncoding.EncodeToString(hash[:]), nil
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return fileContentMD5(file)
+}
+
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+// blockIDsForFile returns the Block IDs used to upload the file in blocks of the specified size.
+//
+// Block IDs are derived from the MD5 of the file and the block size, such that a subsequent attempt at uploading the same
+// file generates the same Block IDs - allowing any blocks which were uploaded (but not committed) to be reused.
+func blockIDsForFile(contentMD5 string, fileSize, blockSize int64) []string {
+	prefix := fmt.Sprintf("%x", md5.Sum([]byte(fmt.Sprintf("%s/%d", contentMD5, blockSize))))
+
+	blockIDs := make([]string, 0)
+	for i := int64(0); i*blockSize < fileSize; i++ {
+		// all of the Block IDs within a blob must be the same length, hence the zero-padding
+		blockIDs = append(blockIDs, base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s-%05d", prefix, i))))
+	}
+
+	return blockIDs
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize, blockSize int64, contentMD5 string) ([]blobs.BlockID, error) {
+	// any blocks which were uploaded as a part of a previous attempt (and haven't been committed) can be reused
+	existingBlocks := make(map[string]int64)
+	existing, err := sbu.Client.GetBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, blobs.GetBlockListInput{
+		BlockListType: blobs.Uncommitted,
+	})
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return nil, fmt.Errorf("retrieving the uncommitted blocks: %s", err)
+		}
+	}
+	for _, block := range existing.UncommittedBlocks.Blocks {
+		existingBlocks[block.Name] = block.Size
+	}
+
+	blockIDs := blockIDsForFile(contentMD5, fileSize, blockSize)
+	blockList := make([]blobs.BlockID, 0)
+	blocksToUpload := make([]storageBlobBlock, 0)
+	for i, blockID := range blockIDs {
+		blockList = append(blockList, blobs.BlockID{
+			Value: blockID,
+		})
+
+		offset := int64(i) * blockSize
+		length := blockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+		if size, ok := existingBlocks[blockID]; ok && size == length {
+			continue
+		}
+
+		blocksToUpload = append(blocksToUpload, storageBlobBlock{
+			id:      blockID,
+			section: io.NewSectionReader(file, offset, length),
+		})
+	}
+	if reused := len(blockIDs) - len(blocksToUpload); reused > 0 {
+		log.Printf("[DEBUG] Resuming the upload of %q - %d of %d blocks have already been uploaded", sbu.Source, reused, len(blockIDs))
+	}
+
+	blocks := make(chan storageBlobBlock, len(blocksToUpload))
+	errors := make(chan error, len(blocksToUpload))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(blocksToUpload))
+
+	for _, block := range blocksToUpload {
+		blocks <- block
+	}
+	close(blocks)
+
+	for i := 0; i < blockUploadWorkerCount(sbu.Parallelism, len(blocksToUpload), blockSize); i++ {
+		go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+			blocks: blocks,
+			errors: errors,
+			wg:     wg,
+		})
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return nil, fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	return blockList, nil
+}
+
+// blockUploadWorkerCount returns the number of workers used to upload the blocks. Since each worker holds a buffer
+// for the block being uploaded this is capped to the number of blocks, and to the number of blocks which fit within
+// `maxBufferedBytes` - although at least one worker is always used, even when a single block exceeds this
+func blockUploadWorkerCount(parallelism int, blockCount int, blockSize int64) int {
+	workerCount := parallelism * runtime.NumCPU()
+	if workerCount > blockCount {
+		workerCount = blockCount
+	}
+
+	if blockSize > 0 {
+		maxWorkerCount := int(maxBufferedBytes / blockSize)
+		if maxWorkerCount < 1 {
+			maxWorkerCount = 1
+		}
+		if workerCount > maxWorkerCount {
+			workerCount = maxWorkerCount
+		}
+	}
+
+	return workerCount
+}
+
+type blobBlockUploadContext struct {
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	// the buffer is allocated for the first block uploaded by this worker (sized to that block, so that a file smaller
+	// than the block size only allocates the size of the file) and then reused, bounding the memory used to `workers * block size` (see `blockUploadWorkerCount`)
+	var buffer []byte
+
+	for block := range uploadCtx.blocks {
+		if int64(len(buffer)) < block.section.Size() {
+			buffer = make([]byte, block.section.Size())
+		}
+		chunk := buffer[:block.section.Size()]
+		if _, err := block.section.ReadAt(chunk, 0); err != nil && err != io.EOF {
+			uploadCtx.errors <- fmt.Errorf("reading source file %q for block %q: %s", sbu.Source, block.id, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+		if _, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+			uploadCtx.errors <- fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestBlockSizeForFile(t *testing.T) {
+	testData := []struct {
+		blockSize int64
+		fileSize  int64
+		expected  int64
+		error     bool
+	}{
+		{
+			// the default block size is used for smaller files
+			blockSize: 0,
+			fileSize:  1024,
+			expected:  defaultBlockSize,
+		},
+		{
+			// exactly the maximum number of blocks at the default block size
+			blockSize: 0,
+			fileSize:  defaultBlockSize * maxBlockCount,
+			expected:  defaultBlockSize,
+		},
+		{
+			// the default block size is increased for larger files
+			blockSize: 0,
+			fileSize:  defaultBlockSize*maxBlockCount + 1,
+			expected:  defaultBlockSize * 2,
+		},
+		{
+			// but can't exceed the maximum block size
+			blockSize: 0,
+			fileSize:  maxBlockSize * maxBlockCount,
+			expected:  maxBlockSize,
+		},
+		{
+			blockSize: 0,
+			fileSize:  maxBlockSize*maxBlockCount + 1,
+			error:     true,
+		},
+		{
+			// a block size which is specified is used as-is
+			blockSize: 1024 * 1024,
+			fileSize:  25 * 1024 * 1024,
+			expected:  1024 * 1024,
+		},
+		{
+			blockSize: 1024 * 1024,
+			fileSize:  1024*1024*maxBlockCount + 1,
+			error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing Block Size %d for File Size %d", v.blockSize, v.fileSize)
+
+		actual, err := blockSizeForFile(v.blockSize, v.fileSize)
+		if err != nil {
+			if v.error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != v.expected {
+			t.Fatalf("Expected a Block Size of %d but got %d", v.expected, actual)
+		}
+	}
+}
+
+func TestBlockUploadWorkerCount(t *testing.T) {
+	if actual := blockUploadWorkerCount(8, 1, defaultBlockSize); actual != 1 {
+		t.Fatalf("Expected a single worker for a single block but got %d", actual)
+	}
+	if actual := blockUploadWorkerCount(8, 0, defaultBlockSize); actual != 0 {
+		t.Fatalf("Expected no workers when there are no blocks to upload but got %d", actual)
+	}
+	if actual := blockUploadWorkerCount(1, 1000000, 1024*1024); actual != runtime.NumCPU() {
+		t.Fatalf("Expected %d workers but got %d", runtime.NumCPU(), actual)
+	}
+	if actual := blockUploadWorkerCount(8, 1000000, 256*1024*1024); actual > 4 {
+		t.Fatalf("Expected at most 4 workers for 256MB blocks but got %d", actual)
+	}
+	if actual := blockUploadWorkerCount(8, 1000000, maxBlockSize); actual != 1 {
+		t.Fatalf("Expected a single worker for blocks larger than the memory budget but got %d", actual)
+	}
+}
+
+func TestBlockIDsForFile(t *testing.T) {
+	blockIDs := blockIDsForFile("1B2M2Y8AsgTpgAmY7PhCfg==", 10*1024+1, 1024)
+	if len(blockIDs) != 11 {
+		t.Fatalf("Expected 11 Block IDs but got %d", len(blockIDs))
+	}
+
+	seen := make(map[string]struct{})
+	for _, blockID := range blockIDs {
+		if _, err := base64.StdEncoding.DecodeString(blockID); err != nil {
+			t.Fatalf("Expected the Block ID %q to be Base64 encoded: %+v", blockID, err)
+		}
+		if len(blockID) != len(blockIDs[0]) {
+			t.Fatalf("Expected all of the Block IDs to be the same length but %q and %q differ", blockID, blockIDs[0])
+		}
+		if _, ok := seen[blockID]; ok {
+			t.Fatalf("Expected the Block IDs to be unique but %q is duplicated", blockID)
+		}
+		seen[blockID] = struct{}{}
+	}
+
+	// the Block IDs must be stable across attempts so that the upload can be resumed
+	if again := blockIDsForFile("1B2M2Y8AsgTpgAmY7PhCfg==", 10*1024+1, 1024); again[10] != blockIDs[10] {
+		t.Fatalf("Expected the Block IDs to be stable but got %q and %q", blockIDs[10], again[10])
+	}
+
+	// but differ when either the file contents or the block size change
+	if other := blockIDsForFile("CY9rzUYh03PK3k6DJie09g==", 10*1024+1, 1024); other[0] == blockIDs[0] {
+		t.Fatalf("Expected the Block IDs to differ for a different file")
+	}
+	if other := blockIDsForFile("1B2M2Y8AsgTpgAmY7PhCfg==", 10*1024+1, 2048); other[0] == blockIDs[0] {
+		t.Fatalf("Expected the Block IDs to differ for a different block size")
+	}
+}
+
+func TestContentMD5ForSource(t *testing.T) {
+	// the MD5 of `hello world`
+	expected := "XrY7u+Ae7tCTyyK7j1rNww=="
+
+	actual, err := contentMD5ForSource("", "hello world")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actual != expected {
+		t.Fatalf("Expected the MD5 of the Source Content to be %q but got %q", expected, actual)
+	}
+
+	path := filepath.Join(t.TempDir(), "source")
+	if err := os.WriteFile(path, []byte("hello world"), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", path, err)
+	}
+	actual, err = contentMD5ForSource(path, "")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actual != expected {
+		t.Fatalf("Expected the MD5 of the Source to be %q but got %q", expected, actual)
+	}
+
+	if _, err := contentMD5ForSource(filepath.Join(t.TempDir(), "missing"), ""); !os.IsNotExist(err) {
+		t.Fatalf("Expected a not exists error for a missing Source but got: %+v", err)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+)
+
+// Giovanni doesn't expose the Set Access Control Recursive operation - however it's supported by the API Version used
+// by Giovanni, as such these requests are sent using the Giovanni Paths Client (and API Version) rather than a separate client.
+
+type accessControlRecursiveMode string
+
+const (
+	// accessControlRecursiveModeModify adds or updates the specified ACL entries, leaving any others as-is
+	accessControlRecursiveModeModify accessControlRecursiveMode = "modify"
+
+	// accessControlRecursiveModeRemove removes the specified ACL entries
+	accessControlRecursiveModeRemove accessControlRecursiveMode = "remove"
+
+	// accessControlRecursiveModeSet replaces the ACL with the specified ACL entries
+	accessControlRecursiveModeSet accessControlRecursiveMode = "set"
+)
+
+type accessControlRecursiveResult struct {
+	autorest.Response
+
+	// continuation is the token used to process the next batch of Paths - empty once all Paths have been processed
+	continuation string
+
+	DirectoriesSuccessful int64                               `json:"directoriesSuccessful"`
+	FailedEntries         []accessControlRecursiveFailedEntry `json:"failedEntries"`
+	FailureCount          int64                               `json:"failureCount"`
+	FilesSuccessful       int64                               `json:"filesSuccessful"`
+}
+
+type accessControlRecursiveFailedEntry struct {
+	ErrorMessage string `json:"errorMessage"`
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+}
+
+// setAccessControlRecursive sets, modifies or removes the ACL entries of the Path and of all Paths below it, following
+// each of the continuation tokens returned and combining the results. Unless `force` is set processing stops at the
+// first batch which contains a failure.
+func setAccessControlRecursive(ctx context.Context, client *paths.Client, id parse.StorageDataLakeGen2PathDataPlaneId, mode accessControlRecursiveMode, acl string, force bool) (result accessControlRecursiveResult, err error) {
+	continuation := ""
+	for {
+		req, err := accessControlRecursivePreparer(ctx, client, id, mode, acl, force, continuation)
+		if err != nil {
+			return result, autorest.NewErrorWithError(err, "paths.Client", "SetAccessControlRecursive", nil, "Failure preparing request")
+		}
+
+		resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+		if err != nil {
+			result.Response = autorest.Response{Response: resp}
+			return result, autorest.NewErrorWithError(err, "paths.Client", "SetAccessControlRecursive", resp, "Failure sending request")
+		}
+
+		batch, err := accessControlRecursiveResponder(client, resp)
+		result.Response = batch.Response
+		if err != nil {
+			return result, autorest.NewErrorWithError(err, "paths.Client", "SetAccessControlRecursive", resp, "Failure responding to request")
+		}
+
+		result.DirectoriesSuccessful += batch.DirectoriesSuccessful
+		result.FilesSuccessful += batch.FilesSuccessful
+		result.FailureCount += batch.FailureCount
+		result.FailedEntries = append(result.FailedEntries, batch.FailedEntries...)
+
+		if batch.continuation == "" || (batch.FailureCount > 0 && !force) {
+			return result, nil
+		}
+		continuation = batch.continuation
+	}
+}
+
+func accessControlRecursivePreparer(ctx context.Context, client *paths.Client, id parse.StorageDataLakeGen2PathDataPlaneId, mode accessControlRecursiveMode, acl string, force bool, continuation string) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"fileSystemName": autorest.Encode("path", id.FileSystemName),
+		"path":           autorest.Encode("path", id.Path),
+	}
+
+	queryParameters := map[string]interface{}{
+		"action":    autorest.Encode("query", "setAccessControlRecursive"),
+		"mode":      autorest.Encode("query", string(mode)),
+		"forceFlag": autorest.Encode("query", force),
+	}
+	if continuation != "" {
+		queryParameters["continuation"] = autorest.Encode("query", continuation)
+	}
+
+	headers := map[string]interface{}{
+		"x-ms-version": paths.APIVersion,
+		"x-ms-acl":     acl,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPatch(),
+		autorest.WithBaseURL(fmt.Sprintf("https://%s.dfs.%s", id.AccountName, client.BaseURI)),
+		autorest.WithPathParameters("/{fileSystemName}/{path}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeaders(headers))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func accessControlRecursiveResponder(client *paths.Client, resp *http.Response) (result accessControlRecursiveResult, err error) {
+	if resp != nil && resp.Header != nil {
+		result.continuation = resp.Header.Get("x-ms-continuation")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
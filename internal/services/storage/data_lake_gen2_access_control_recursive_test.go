@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+)
+
+func TestAccessControlRecursivePreparer(t *testing.T) {
+	client := paths.NewWithEnvironment(azure.PublicCloud)
+	id := parse.NewStorageDataLakeGen2PathDataPlaneId("account1", "core.windows.net", "filesystem1", "dir1/dir2")
+	continuation := "VBaS6LvqhpSKhSUYIA=="
+	acl := "user:00000000-0000-0000-0000-000000000000:r-x"
+
+	req, err := accessControlRecursivePreparer(context.TODO(), &client, id, accessControlRecursiveModeModify, acl, true, continuation)
+	if err != nil {
+		t.Fatalf("preparing the request: %+v", err)
+	}
+
+	if req.Method != http.MethodPatch {
+		t.Fatalf("expected the Method to be %q but got %q", http.MethodPatch, req.Method)
+	}
+	if req.URL.Host != "account1.dfs.core.windows.net" || req.URL.Path != "/filesystem1/dir1/dir2" {
+		t.Fatalf("expected the request to be for the Path but got %q", req.URL.String())
+	}
+	query := req.URL.Query()
+	expected := map[string]string{
+		"action":       "setAccessControlRecursive",
+		"mode":         "modify",
+		"continuation": continuation,
+		"forceFlag":    "true",
+	}
+	for k, v := range expected {
+		if actual := query.Get(k); actual != v {
+			t.Fatalf("expected `%s` to be %q but got %q", k, v, actual)
+		}
+	}
+	if v := req.Header.Get("x-ms-acl"); v != acl {
+		t.Fatalf("expected `x-ms-acl` to be the ACL but got %q", v)
+	}
+	if v := req.Header.Get("x-ms-version"); v != paths.APIVersion {
+		t.Fatalf("expected `x-ms-version` to be %q but got %q", paths.APIVersion, v)
+	}
+}
+
+func TestAccessControlRecursiveResponder(t *testing.T) {
+	client := paths.NewWithEnvironment(azure.PublicCloud)
+	body := `{"directoriesSuccessful":2,"failedEntries":[{"errorMessage":"This request is not authorized to perform this operation using this permission.","name":"dir1/file1","type":"FILE"}],"failureCount":1,"filesSuccessful":5}`
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type":      []string{"application/json"},
+			"X-Ms-Continuation": []string{"token"},
+		},
+		Body: io.NopCloser(bytes.NewBufferString(body)),
+	}
+
+	result, err := accessControlRecursiveResponder(&client, resp)
+	if err != nil {
+		t.Fatalf("handling the response: %+v", err)
+	}
+
+	if result.continuation != "token" {
+		t.Fatalf("expected the continuation to be %q but got %q", "token", result.continuation)
+	}
+	if result.DirectoriesSuccessful != 2 || result.FilesSuccessful != 5 || result.FailureCount != 1 {
+		t.Fatalf("expected 2 Directories, 5 Files and 1 Failure but got %+v", result)
+	}
+	if len(result.FailedEntries) != 1 || result.FailedEntries[0].Name != "dir1/file1" || result.FailedEntries[0].Type != "FILE" {
+		t.Fatalf("expected the Failed Entry for `dir1/file1` but got %+v", result.FailedEntries)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &StorageAccountBlobPropertiesId{}
+
+type StorageAccountBlobPropertiesId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	StorageAccountName string
+}
+
+func NewStorageAccountBlobPropertiesID(subscriptionId, resourceGroupName, storageAccountName string) StorageAccountBlobPropertiesId {
+	return StorageAccountBlobPropertiesId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		StorageAccountName: storageAccountName,
+	}
+}
+
+func NewStorageAccountBlobPropertiesIDFromAccountID(id commonids.StorageAccountId) StorageAccountBlobPropertiesId {
+	return NewStorageAccountBlobPropertiesID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+// StorageAccountId returns the ID of the Storage Account which the Storage Account Blob Properties belongs to
+func (id StorageAccountBlobPropertiesId) StorageAccountId() commonids.StorageAccountId {
+	return commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountBlobPropertiesId) String() string {
+	segments := []string{
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group Name %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Blob Properties", segmentsStr)
+}
+
+func (id StorageAccountBlobPropertiesId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/default"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountBlobPropertiesId) Segments() []resourceids.Segment {
+	return append(commonids.StorageAccountId{}.Segments(),
+		resourceids.StaticSegment("staticBlobServices", "blobServices", "blobServices"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	)
+}
+
+// StorageAccountBlobPropertiesID parses a StorageAccountBlobProperties ID into an StorageAccountBlobPropertiesId struct
+func StorageAccountBlobPropertiesID(input string) (*StorageAccountBlobPropertiesId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageAccountBlobPropertiesId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StorageAccountBlobPropertiesId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *StorageAccountBlobPropertiesId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &StorageAccountQueuePropertiesId{}
+
+type StorageAccountQueuePropertiesId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	StorageAccountName string
+}
+
+func NewStorageAccountQueuePropertiesID(subscriptionId, resourceGroupName, storageAccountName string) StorageAccountQueuePropertiesId {
+	return StorageAccountQueuePropertiesId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		StorageAccountName: storageAccountName,
+	}
+}
+
+func NewStorageAccountQueuePropertiesIDFromAccountID(id commonids.StorageAccountId) StorageAccountQueuePropertiesId {
+	return NewStorageAccountQueuePropertiesID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+// StorageAccountId returns the ID of the Storage Account which the Storage Account Queue Properties belongs to
+func (id StorageAccountQueuePropertiesId) StorageAccountId() commonids.StorageAccountId {
+	return commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountQueuePropertiesId) String() string {
+	segments := []string{
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group Name %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Queue Properties", segmentsStr)
+}
+
+func (id StorageAccountQueuePropertiesId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/queueServices/default"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountQueuePropertiesId) Segments() []resourceids.Segment {
+	return append(commonids.StorageAccountId{}.Segments(),
+		resourceids.StaticSegment("staticQueueServices", "queueServices", "queueServices"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	)
+}
+
+// StorageAccountQueuePropertiesID parses a StorageAccountQueueProperties ID into an StorageAccountQueuePropertiesId struct
+func StorageAccountQueuePropertiesID(input string) (*StorageAccountQueuePropertiesId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageAccountQueuePropertiesId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StorageAccountQueuePropertiesId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *StorageAccountQueuePropertiesId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &StorageAccountSharePropertiesId{}
+
+type StorageAccountSharePropertiesId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	StorageAccountName string
+}
+
+func NewStorageAccountSharePropertiesID(subscriptionId, resourceGroupName, storageAccountName string) StorageAccountSharePropertiesId {
+	return StorageAccountSharePropertiesId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		StorageAccountName: storageAccountName,
+	}
+}
+
+func NewStorageAccountSharePropertiesIDFromAccountID(id commonids.StorageAccountId) StorageAccountSharePropertiesId {
+	return NewStorageAccountSharePropertiesID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+// StorageAccountId returns the ID of the Storage Account which the Storage Account Share Properties belongs to
+func (id StorageAccountSharePropertiesId) StorageAccountId() commonids.StorageAccountId {
+	return commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountSharePropertiesId) String() string {
+	segments := []string{
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group Name %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Share Properties", segmentsStr)
+}
+
+func (id StorageAccountSharePropertiesId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/fileServices/default"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountSharePropertiesId) Segments() []resourceids.Segment {
+	return append(commonids.StorageAccountId{}.Segments(),
+		resourceids.StaticSegment("staticFileServices", "fileServices", "fileServices"),
+		resourceids.StaticSegment("staticDefault", "default", "default"),
+	)
+}
+
+// StorageAccountSharePropertiesID parses a StorageAccountShareProperties ID into an StorageAccountSharePropertiesId struct
+func StorageAccountSharePropertiesID(input string) (*StorageAccountSharePropertiesId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageAccountSharePropertiesId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StorageAccountSharePropertiesId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *StorageAccountSharePropertiesId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	return nil
+}
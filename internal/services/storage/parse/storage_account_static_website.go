@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &StorageAccountStaticWebsiteId{}
+
+type StorageAccountStaticWebsiteId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	StorageAccountName string
+}
+
+func NewStorageAccountStaticWebsiteID(subscriptionId, resourceGroupName, storageAccountName string) StorageAccountStaticWebsiteId {
+	return StorageAccountStaticWebsiteId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		StorageAccountName: storageAccountName,
+	}
+}
+
+func NewStorageAccountStaticWebsiteIDFromAccountID(id commonids.StorageAccountId) StorageAccountStaticWebsiteId {
+	return NewStorageAccountStaticWebsiteID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+// StorageAccountId returns the ID of the Storage Account which the Storage Account Static Website belongs to
+func (id StorageAccountStaticWebsiteId) StorageAccountId() commonids.StorageAccountId {
+	return commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountStaticWebsiteId) String() string {
+	segments := []string{
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group Name %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Static Website", segmentsStr)
+}
+
+func (id StorageAccountStaticWebsiteId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/staticWebsite"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+}
+
+func (id StorageAccountStaticWebsiteId) Segments() []resourceids.Segment {
+	return append(commonids.StorageAccountId{}.Segments(),
+		resourceids.StaticSegment("staticStaticWebsite", "staticWebsite", "staticWebsite"),
+	)
+}
+
+// StorageAccountStaticWebsiteID parses a StorageAccountStaticWebsite ID into an StorageAccountStaticWebsiteId struct
+func StorageAccountStaticWebsiteID(input string) (*StorageAccountStaticWebsiteId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageAccountStaticWebsiteId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StorageAccountStaticWebsiteId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *StorageAccountStaticWebsiteId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	return nil
+}
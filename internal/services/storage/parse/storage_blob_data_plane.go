@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+)
+
+var _ resourceids.Id = StorageBlobDataPlaneId{}
+
+// StorageBlobDataPlaneId is the ID of a Blob, or of a specific Snapshot or Version of a Blob
+type StorageBlobDataPlaneId struct {
+	AccountName   string
+	DomainSuffix  string
+	ContainerName string
+	Name          string
+
+	// Snapshot is the (opaque DateTime) value of the Snapshot of the Blob, if any
+	Snapshot string
+
+	// VersionID is the (opaque DateTime) value of the Version of the Blob, if any
+	VersionID string
+}
+
+func (id StorageBlobDataPlaneId) String() string {
+	components := []string{
+		fmt.Sprintf("Account Name %q", id.AccountName),
+		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	if id.Snapshot != "" {
+		components = append(components, fmt.Sprintf("Snapshot %q", id.Snapshot))
+	}
+	if id.VersionID != "" {
+		components = append(components, fmt.Sprintf("Version %q", id.VersionID))
+	}
+	return fmt.Sprintf("Storage Blob %s", strings.Join(components, " / "))
+}
+
+func (id StorageBlobDataPlaneId) ID() string {
+	output := fmt.Sprintf("https://%s.blob.%s/%s/%s", id.AccountName, id.DomainSuffix, id.ContainerName, id.Name)
+
+	query := url.Values{}
+	if id.Snapshot != "" {
+		query.Set("snapshot", id.Snapshot)
+	}
+	if id.VersionID != "" {
+		query.Set("versionid", id.VersionID)
+	}
+	if len(query) > 0 {
+		output = fmt.Sprintf("%s?%s", output, query.Encode())
+	}
+
+	return output
+}
+
+func NewStorageBlobDataPlaneId(accountName, domainSuffix, containerName, name string) StorageBlobDataPlaneId {
+	return StorageBlobDataPlaneId{
+		AccountName:   accountName,
+		DomainSuffix:  domainSuffix,
+		ContainerName: containerName,
+		Name:          name,
+	}
+}
+
+func StorageBlobDataPlaneID(id string) (*StorageBlobDataPlaneId, error) {
+	parsed, err := blobs.ParseResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.BlobName == "" {
+		return nil, fmt.Errorf("expected the ID to contain a Blob Name but got %q", id)
+	}
+
+	uri, err := url.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	host := uri.Host
+	hostSegments := strings.Split(host, ".")
+	if len(hostSegments) == 0 {
+		return nil, fmt.Errorf("expected multiple host segments but got 0")
+	}
+	domainNameSuffix := strings.TrimPrefix(host, fmt.Sprintf("%s.blob.", hostSegments[0]))
+
+	query := uri.Query()
+	output := StorageBlobDataPlaneId{
+		AccountName:   parsed.AccountName,
+		DomainSuffix:  domainNameSuffix,
+		ContainerName: parsed.ContainerName,
+		Name:          parsed.BlobName,
+		Snapshot:      query.Get("snapshot"),
+		VersionID:     query.Get("versionid"),
+	}
+	if output.Snapshot != "" && output.VersionID != "" {
+		return nil, fmt.Errorf("expected either a `snapshot` or a `versionid` but got both")
+	}
+
+	return &output, nil
+}
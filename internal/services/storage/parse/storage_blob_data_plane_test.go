@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageBlobDataPlaneID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *StorageBlobDataPlaneId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "https://account1.blob.core.windows.net/container1",
+			Expected: nil,
+		},
+		{
+			Input: "https://account1.blob.core.windows.net/container1/blob1",
+			Expected: &StorageBlobDataPlaneId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.windows.net",
+				ContainerName: "container1",
+				Name:          "blob1",
+			},
+		},
+		{
+			Input: "https://account1.blob.core.windows.net/container1/blob1?versionid=2023-11-03T00%3A00%3A00.0000000Z",
+			Expected: &StorageBlobDataPlaneId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.windows.net",
+				ContainerName: "container1",
+				Name:          "blob1",
+				VersionID:     "2023-11-03T00:00:00.0000000Z",
+			},
+		},
+		{
+			Input: "https://account1.blob.core.chinacloudapi.cn/container1/blob1?snapshot=2023-11-03T00%3A00%3A00.0000000Z",
+			Expected: &StorageBlobDataPlaneId{
+				AccountName:   "account1",
+				DomainSuffix:  "core.chinacloudapi.cn",
+				ContainerName: "container1",
+				Name:          "blob1",
+				Snapshot:      "2023-11-03T00:00:00.0000000Z",
+			},
+		},
+		{
+			Input:    "https://account1.blob.core.windows.net/container1/blob1?snapshot=2023-11-03T00:00:00.0000000Z&versionid=2023-11-03T00:00:00.0000000Z",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageBlobDataPlaneID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value: %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+
+		// the ID should round-trip
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
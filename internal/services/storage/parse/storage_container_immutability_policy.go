@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &StorageContainerImmutabilityPolicyId{}
+
+type StorageContainerImmutabilityPolicyId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	StorageAccountName string
+	ContainerName      string
+}
+
+func NewStorageContainerImmutabilityPolicyID(subscriptionId, resourceGroupName, storageAccountName, containerName string) StorageContainerImmutabilityPolicyId {
+	return StorageContainerImmutabilityPolicyId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		StorageAccountName: storageAccountName,
+		ContainerName:      containerName,
+	}
+}
+
+func NewStorageContainerImmutabilityPolicyIDFromContainerID(id commonids.StorageContainerId) StorageContainerImmutabilityPolicyId {
+	return NewStorageContainerImmutabilityPolicyID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, id.ContainerName)
+}
+
+// StorageContainerId returns the ID of the Storage Container which the Storage Container Immutability Policy belongs to
+func (id StorageContainerImmutabilityPolicyId) StorageContainerId() commonids.StorageContainerId {
+	return commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, id.ContainerName)
+}
+
+func (id StorageContainerImmutabilityPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group Name %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Container Immutability Policy", segmentsStr)
+}
+
+func (id StorageContainerImmutabilityPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/default/containers/%s/immutabilityPolicies/default"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, id.ContainerName)
+}
+
+func (id StorageContainerImmutabilityPolicyId) Segments() []resourceids.Segment {
+	return append(commonids.StorageContainerId{}.Segments(),
+		resourceids.StaticSegment("staticImmutabilityPolicies", "immutabilityPolicies", "immutabilityPolicies"),
+		resourceids.StaticSegment("staticImmutabilityPolicyName", "default", "default"),
+	)
+}
+
+// StorageContainerImmutabilityPolicyID parses a StorageContainerImmutabilityPolicy ID into an StorageContainerImmutabilityPolicyId struct
+func StorageContainerImmutabilityPolicyID(input string) (*StorageContainerImmutabilityPolicyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageContainerImmutabilityPolicyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StorageContainerImmutabilityPolicyId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *StorageContainerImmutabilityPolicyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.ContainerName, ok = input.Parsed["containerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "containerName", input)
+	}
+
+	return nil
+}
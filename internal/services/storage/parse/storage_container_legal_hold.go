@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = &StorageContainerLegalHoldId{}
+
+type StorageContainerLegalHoldId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	StorageAccountName string
+	ContainerName      string
+}
+
+func NewStorageContainerLegalHoldID(subscriptionId, resourceGroupName, storageAccountName, containerName string) StorageContainerLegalHoldId {
+	return StorageContainerLegalHoldId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		StorageAccountName: storageAccountName,
+		ContainerName:      containerName,
+	}
+}
+
+func NewStorageContainerLegalHoldIDFromContainerID(id commonids.StorageContainerId) StorageContainerLegalHoldId {
+	return NewStorageContainerLegalHoldID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, id.ContainerName)
+}
+
+// StorageContainerId returns the ID of the Storage Container which the Storage Container Legal Hold belongs to
+func (id StorageContainerLegalHoldId) StorageContainerId() commonids.StorageContainerId {
+	return commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, id.ContainerName)
+}
+
+func (id StorageContainerLegalHoldId) String() string {
+	segments := []string{
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group Name %q", id.ResourceGroupName),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Container Legal Hold", segmentsStr)
+}
+
+func (id StorageContainerLegalHoldId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/default/containers/%s/legalHold"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName, id.ContainerName)
+}
+
+func (id StorageContainerLegalHoldId) Segments() []resourceids.Segment {
+	return append(commonids.StorageContainerId{}.Segments(),
+		resourceids.StaticSegment("staticLegalHold", "legalHold", "legalHold"),
+	)
+}
+
+// StorageContainerLegalHoldID parses a StorageContainerLegalHold ID into an StorageContainerLegalHoldId struct
+func StorageContainerLegalHoldID(input string) (*StorageContainerLegalHoldId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&StorageContainerLegalHoldId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := StorageContainerLegalHoldId{}
+	if err := id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *StorageContainerLegalHoldId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.StorageAccountName, ok = input.Parsed["storageAccountName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "storageAccountName", input)
+	}
+
+	if id.ContainerName, ok = input.Parsed["containerName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "containerName", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+)
+
+var _ resourceids.Id = StorageDataLakeGen2PathDataPlaneId{}
+
+type StorageDataLakeGen2PathDataPlaneId struct {
+	AccountName    string
+	DomainSuffix   string
+	FileSystemName string
+	Path           string
+}
+
+func (id StorageDataLakeGen2PathDataPlaneId) String() string {
+	components := []string{
+		fmt.Sprintf("Account Name %q", id.AccountName),
+		fmt.Sprintf("Domain Suffix %q", id.DomainSuffix),
+		fmt.Sprintf("File System Name %q", id.FileSystemName),
+		fmt.Sprintf("Path %q", id.Path),
+	}
+	return fmt.Sprintf("Data Lake Gen2 Path %s", strings.Join(components, " / "))
+}
+
+func (id StorageDataLakeGen2PathDataPlaneId) ID() string {
+	return fmt.Sprintf("https://%s.dfs.%s/%s/%s", id.AccountName, id.DomainSuffix, id.FileSystemName, id.Path)
+}
+
+func NewStorageDataLakeGen2PathDataPlaneId(accountName, domainSuffix, fileSystemName, path string) StorageDataLakeGen2PathDataPlaneId {
+	return StorageDataLakeGen2PathDataPlaneId{
+		AccountName:    accountName,
+		DomainSuffix:   domainSuffix,
+		FileSystemName: fileSystemName,
+		Path:           path,
+	}
+}
+
+func StorageDataLakeGen2PathDataPlaneID(id string) (*StorageDataLakeGen2PathDataPlaneId, error) {
+	parsed, err := paths.ParseResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+	if parsed.FileSystemName == "" || parsed.Path == "" {
+		return nil, fmt.Errorf("expected the ID to contain a File System Name and a Path but got %q", id)
+	}
+
+	uri, err := url.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	host := uri.Host
+	hostSegments := strings.Split(host, ".")
+	if len(hostSegments) == 0 {
+		return nil, fmt.Errorf("expected multiple host segments but got 0")
+	}
+	domainNameSuffix := strings.TrimPrefix(host, fmt.Sprintf("%s.dfs.", hostSegments[0]))
+
+	return &StorageDataLakeGen2PathDataPlaneId{
+		AccountName:    parsed.AccountName,
+		DomainSuffix:   domainNameSuffix,
+		FileSystemName: parsed.FileSystemName,
+		Path:           parsed.Path,
+	}, nil
+}
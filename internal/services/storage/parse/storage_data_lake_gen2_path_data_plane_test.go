@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+)
+
+func TestStorageDataLakeGen2PathDataPlaneID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *StorageDataLakeGen2PathDataPlaneId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "https://account1.dfs.core.windows.net/filesystem1",
+			Expected: nil,
+		},
+		{
+			Input: "https://account1.dfs.core.windows.net/filesystem1/dir1",
+			Expected: &StorageDataLakeGen2PathDataPlaneId{
+				AccountName:    "account1",
+				DomainSuffix:   "core.windows.net",
+				FileSystemName: "filesystem1",
+				Path:           "dir1",
+			},
+		},
+		{
+			Input: "https://account1.dfs.core.chinacloudapi.cn/filesystem1/dir1/dir2",
+			Expected: &StorageDataLakeGen2PathDataPlaneId{
+				AccountName:    "account1",
+				DomainSuffix:   "core.chinacloudapi.cn",
+				FileSystemName: "filesystem1",
+				Path:           "dir1/dir2",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := StorageDataLakeGen2PathDataPlaneID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value: %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected the ID to be %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -80,5 +80,12 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		LocalUserResource{},
+		StorageAccountBlobPropertiesResource{},
+		StorageAccountQueuePropertiesResource{},
+		StorageAccountSharePropertiesResource{},
+		StorageAccountStaticWebsiteResource{},
+		StorageContainerImmutabilityPolicyResource{},
+		StorageContainerLegalHoldResource{},
+		StorageDataLakeGen2PathAclRecursiveResource{},
 	}
 }
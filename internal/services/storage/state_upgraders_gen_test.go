@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestStorageAccount_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, storage.Registration{}, "azurerm_storage_account")
+}
+
+func TestStorageBlob_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, storage.Registration{}, "azurerm_storage_blob")
+}
+
+func TestStorageContainer_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, storage.Registration{}, "azurerm_storage_container")
+}
+
+func TestStorageQueue_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, storage.Registration{}, "azurerm_storage_queue")
+}
+
+func TestStorageShare_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, storage.Registration{}, "azurerm_storage_share")
+}
+
+func TestStorageTable_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, storage.Registration{}, "azurerm_storage_table")
+}
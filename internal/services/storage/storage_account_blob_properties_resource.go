@@ -0,0 +1,447 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageAccountBlobPropertiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageAccountBlobPropertiesResource{}
+
+type StorageAccountBlobPropertiesModel struct {
+	StorageAccountId               string                               `tfschema:"storage_account_id"`
+	CorsRule                       []StorageAccountCorsRuleModel        `tfschema:"cors_rule"`
+	DeleteRetentionPolicy          []StorageAccountRetentionPolicyModel `tfschema:"delete_retention_policy"`
+	RestorePolicy                  []StorageAccountRetentionPolicyModel `tfschema:"restore_policy"`
+	VersioningEnabled              bool                                 `tfschema:"versioning_enabled"`
+	ChangeFeedEnabled              bool                                 `tfschema:"change_feed_enabled"`
+	ChangeFeedRetentionInDays      int64                                `tfschema:"change_feed_retention_in_days"`
+	DefaultServiceVersion          string                               `tfschema:"default_service_version"`
+	LastAccessTimeEnabled          bool                                 `tfschema:"last_access_time_enabled"`
+	ContainerDeleteRetentionPolicy []StorageAccountRetentionPolicyModel `tfschema:"container_delete_retention_policy"`
+}
+
+func (r StorageAccountBlobPropertiesResource) Arguments() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		// lintignore: S013
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+	}
+
+	for k, v := range storageAccountBlobPropertiesSchema("") {
+		s[k] = v
+	}
+
+	return s
+}
+
+func (r StorageAccountBlobPropertiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageAccountBlobPropertiesResource) ResourceType() string {
+	return "azurerm_storage_account_blob_properties"
+}
+
+func (r StorageAccountBlobPropertiesResource) ModelObject() interface{} {
+	return &StorageAccountBlobPropertiesModel{}
+}
+
+func (r StorageAccountBlobPropertiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageAccountBlobPropertiesID
+}
+
+func (r StorageAccountBlobPropertiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobService
+
+			var plan StorageAccountBlobPropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageAccountBlobPropertiesIDFromAccountID(*accountId)
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := retrieveStorageAccountForServiceProperties(ctx, metadata.Client.Storage, *accountId, "Blob Properties", func(level storageAccountServiceSupportLevel) bool {
+				return level.supportBlob
+			})
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetServiceProperties(ctx, *accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving Blob Properties for %s: %+v", accountId, err)
+			}
+
+			// the Blob Properties may already be configured via the `blob_properties` block within the `azurerm_storage_account` resource
+			if existing.Model != nil && checkForNonDefaultStorageAccountBlobProperties(existing.Model.Properties) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandStorageAccountBlobProperties(account, plan)
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.SetServiceProperties(ctx, *accountId, *props); err != nil {
+				return fmt.Errorf("updating Blob Properties for %s: %+v", accountId, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountBlobPropertiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobService
+
+			id, err := parse.StorageAccountBlobPropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			resp, err := client.GetServiceProperties(ctx, accountId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving Blob Properties for %s: %+v", accountId, err)
+			}
+
+			state := StorageAccountBlobPropertiesModel{
+				StorageAccountId: accountId.ID(),
+			}
+			if model := resp.Model; model != nil {
+				flattenStorageAccountBlobProperties(model.Properties, &state)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountBlobPropertiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobService
+
+			id, err := parse.StorageAccountBlobPropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			var plan StorageAccountBlobPropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := retrieveStorageAccountForServiceProperties(ctx, metadata.Client.Storage, accountId, "Blob Properties", func(level storageAccountServiceSupportLevel) bool {
+				return level.supportBlob
+			})
+			if err != nil {
+				return err
+			}
+
+			props, err := expandStorageAccountBlobProperties(account, plan)
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.SetServiceProperties(ctx, accountId, *props); err != nil {
+				return fmt.Errorf("updating Blob Properties for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountBlobPropertiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobService
+
+			id, err := parse.StorageAccountBlobPropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.ResourceManager.StorageAccounts.GetProperties(ctx, accountId, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(account.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", accountId)
+			}
+
+			// the Blob Properties can't be deleted, so we'll reset them back to the defaults instead
+			defaults, err := expandStorageAccountBlobProperties(account.Model, StorageAccountBlobPropertiesModel{})
+			if err != nil {
+				return fmt.Errorf("expanding the default Blob Properties: %+v", err)
+			}
+
+			if _, err = client.SetServiceProperties(ctx, accountId, *defaults); err != nil {
+				return fmt.Errorf("resetting Blob Properties for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageAccountBlobProperties(account *storageaccounts.StorageAccount, input StorageAccountBlobPropertiesModel) (*blobservice.BlobServiceProperties, error) {
+	props := blobservice.BlobServicePropertiesProperties{
+		Cors:                           expandStorageAccountBlobPropertiesCorsRules(input.CorsRule),
+		DeleteRetentionPolicy:          expandStorageAccountBlobPropertiesDeleteRetentionPolicy(input.DeleteRetentionPolicy),
+		ContainerDeleteRetentionPolicy: expandStorageAccountBlobPropertiesDeleteRetentionPolicy(input.ContainerDeleteRetentionPolicy),
+	}
+
+	if input.DefaultServiceVersion != "" {
+		props.DefaultServiceVersion = pointer.To(input.DefaultServiceVersion)
+	}
+
+	// `Storage` (v1) kind doesn't support:
+	// - LastAccessTimeTrackingPolicy
+	// - ChangeFeed
+	// - Versioning
+	// - RestorePolicy
+	if pointer.From(account.Kind) == storageaccounts.KindStorage {
+		if input.LastAccessTimeEnabled {
+			return nil, fmt.Errorf("`last_access_time_enabled` can not be configured when `kind` is set to `Storage` (v1)")
+		}
+		if input.ChangeFeedEnabled {
+			return nil, fmt.Errorf("`change_feed_enabled` can not be configured when `kind` is set to `Storage` (v1)")
+		}
+		if input.ChangeFeedRetentionInDays != 0 {
+			return nil, fmt.Errorf("`change_feed_retention_in_days` can not be configured when `kind` is set to `Storage` (v1)")
+		}
+		if len(input.RestorePolicy) != 0 {
+			return nil, fmt.Errorf("`restore_policy` can not be configured when `kind` is set to `Storage` (v1)")
+		}
+		if input.VersioningEnabled {
+			return nil, fmt.Errorf("`versioning_enabled` can not be configured when `kind` is set to `Storage` (v1)")
+		}
+
+		return &blobservice.BlobServiceProperties{
+			Properties: &props,
+		}, nil
+	}
+
+	props.LastAccessTimeTrackingPolicy = &blobservice.LastAccessTimeTrackingPolicy{
+		Enable: input.LastAccessTimeEnabled,
+	}
+	props.ChangeFeed = &blobservice.ChangeFeed{
+		Enabled: pointer.To(input.ChangeFeedEnabled),
+	}
+	if input.ChangeFeedRetentionInDays != 0 {
+		props.ChangeFeed.RetentionInDays = pointer.To(input.ChangeFeedRetentionInDays)
+	}
+	props.IsVersioningEnabled = pointer.To(input.VersioningEnabled)
+	props.RestorePolicy = &blobservice.RestorePolicyProperties{
+		Enabled: false,
+	}
+	if len(input.RestorePolicy) > 0 {
+		props.RestorePolicy = &blobservice.RestorePolicyProperties{
+			Enabled: true,
+			Days:    pointer.To(input.RestorePolicy[0].Days),
+		}
+
+		// Ref: https://learn.microsoft.com/en-us/azure/storage/blobs/point-in-time-restore-overview#prerequisites-for-point-in-time-restore
+		if !input.ChangeFeedEnabled {
+			return nil, fmt.Errorf("`change_feed_enabled` must be `true` when `restore_policy` is set")
+		}
+		if !input.VersioningEnabled {
+			return nil, fmt.Errorf("`versioning_enabled` must be `true` when `restore_policy` is set")
+		}
+	}
+
+	// See: https://learn.microsoft.com/en-us/azure/storage/blobs/versioning-overview#how-blob-versioning-works
+	if accountProps := account.Properties; input.VersioningEnabled && accountProps != nil && pointer.From(accountProps.IsHnsEnabled) {
+		return nil, fmt.Errorf("`versioning_enabled` can't be true when `is_hns_enabled` is true on the Storage Account")
+	}
+
+	return &blobservice.BlobServiceProperties{
+		Properties: &props,
+	}, nil
+}
+
+func expandStorageAccountBlobPropertiesDeleteRetentionPolicy(input []StorageAccountRetentionPolicyModel) *blobservice.DeleteRetentionPolicy {
+	if len(input) == 0 {
+		return &blobservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(false),
+		}
+	}
+
+	return &blobservice.DeleteRetentionPolicy{
+		Enabled: pointer.To(true),
+		Days:    pointer.To(input[0].Days),
+	}
+}
+
+func expandStorageAccountBlobPropertiesCorsRules(input []StorageAccountCorsRuleModel) *blobservice.CorsRules {
+	rules := make([]blobservice.CorsRule, 0)
+	for _, v := range input {
+		methods := make([]blobservice.AllowedMethods, 0)
+		for _, method := range v.AllowedMethods {
+			methods = append(methods, blobservice.AllowedMethods(method))
+		}
+
+		rules = append(rules, blobservice.CorsRule{
+			AllowedHeaders:  v.AllowedHeaders,
+			AllowedMethods:  methods,
+			AllowedOrigins:  v.AllowedOrigins,
+			ExposedHeaders:  v.ExposedHeaders,
+			MaxAgeInSeconds: v.MaxAgeInSeconds,
+		})
+	}
+
+	return &blobservice.CorsRules{
+		CorsRules: &rules,
+	}
+}
+
+func flattenStorageAccountBlobProperties(input *blobservice.BlobServicePropertiesProperties, state *StorageAccountBlobPropertiesModel) {
+	if input == nil {
+		return
+	}
+
+	if input.Cors != nil {
+		state.CorsRule = flattenStorageAccountBlobPropertiesCorsRules(input.Cors.CorsRules)
+	}
+	state.DeleteRetentionPolicy = flattenStorageAccountBlobPropertiesDeleteRetentionPolicy(input.DeleteRetentionPolicy)
+	state.ContainerDeleteRetentionPolicy = flattenStorageAccountBlobPropertiesDeleteRetentionPolicy(input.ContainerDeleteRetentionPolicy)
+
+	if v := input.RestorePolicy; v != nil && v.Enabled {
+		state.RestorePolicy = []StorageAccountRetentionPolicyModel{
+			{
+				Days: pointer.From(v.Days),
+			},
+		}
+	}
+
+	state.VersioningEnabled = pointer.From(input.IsVersioningEnabled)
+	if v := input.ChangeFeed; v != nil {
+		state.ChangeFeedEnabled = pointer.From(v.Enabled)
+		state.ChangeFeedRetentionInDays = pointer.From(v.RetentionInDays)
+	}
+	state.DefaultServiceVersion = pointer.From(input.DefaultServiceVersion)
+	if v := input.LastAccessTimeTrackingPolicy; v != nil {
+		state.LastAccessTimeEnabled = v.Enable
+	}
+}
+
+func flattenStorageAccountBlobPropertiesDeleteRetentionPolicy(input *blobservice.DeleteRetentionPolicy) []StorageAccountRetentionPolicyModel {
+	if input == nil || !pointer.From(input.Enabled) {
+		return []StorageAccountRetentionPolicyModel{}
+	}
+
+	return []StorageAccountRetentionPolicyModel{
+		{
+			Days: pointer.From(input.Days),
+		},
+	}
+}
+
+func flattenStorageAccountBlobPropertiesCorsRules(input *[]blobservice.CorsRule) []StorageAccountCorsRuleModel {
+	output := make([]StorageAccountCorsRuleModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		methods := make([]string, 0)
+		for _, method := range v.AllowedMethods {
+			methods = append(methods, string(method))
+		}
+
+		output = append(output, StorageAccountCorsRuleModel{
+			AllowedHeaders:  v.AllowedHeaders,
+			AllowedMethods:  methods,
+			AllowedOrigins:  v.AllowedOrigins,
+			ExposedHeaders:  v.ExposedHeaders,
+			MaxAgeInSeconds: v.MaxAgeInSeconds,
+		})
+	}
+
+	return output
+}
+
+// To make sure that someone isn't overriding their existing Blob Properties, we'll check for non default values
+func checkForNonDefaultStorageAccountBlobProperties(input *blobservice.BlobServicePropertiesProperties) bool {
+	if input == nil {
+		return false
+	}
+
+	if input.Cors != nil && input.Cors.CorsRules != nil && len(*input.Cors.CorsRules) > 0 {
+		return true
+	}
+
+	for _, policy := range []*blobservice.DeleteRetentionPolicy{input.DeleteRetentionPolicy, input.ContainerDeleteRetentionPolicy} {
+		if policy != nil && pointer.From(policy.Enabled) {
+			return true
+		}
+	}
+
+	if input.RestorePolicy != nil && input.RestorePolicy.Enabled {
+		return true
+	}
+
+	if input.ChangeFeed != nil && pointer.From(input.ChangeFeed.Enabled) {
+		return true
+	}
+
+	if input.LastAccessTimeTrackingPolicy != nil && input.LastAccessTimeTrackingPolicy.Enable {
+		return true
+	}
+
+	return pointer.From(input.IsVersioningEnabled)
+}
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/queue/queues"
+)
+
+type StorageAccountQueuePropertiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageAccountQueuePropertiesResource{}
+
+type StorageAccountQueuePropertiesModel struct {
+	StorageAccountId string                            `tfschema:"storage_account_id"`
+	CorsRule         []StorageAccountCorsRuleModel     `tfschema:"cors_rule"`
+	Logging          []StorageAccountQueueLoggingModel `tfschema:"logging"`
+	HourMetrics      []StorageAccountQueueMetricsModel `tfschema:"hour_metrics"`
+	MinuteMetrics    []StorageAccountQueueMetricsModel `tfschema:"minute_metrics"`
+}
+
+type StorageAccountQueueLoggingModel struct {
+	Version             string `tfschema:"version"`
+	Delete              bool   `tfschema:"delete"`
+	Read                bool   `tfschema:"read"`
+	Write               bool   `tfschema:"write"`
+	RetentionPolicyDays int64  `tfschema:"retention_policy_days"`
+}
+
+type StorageAccountQueueMetricsModel struct {
+	Version             string `tfschema:"version"`
+	Enabled             bool   `tfschema:"enabled"`
+	IncludeAPIs         bool   `tfschema:"include_apis"`
+	RetentionPolicyDays int64  `tfschema:"retention_policy_days"`
+}
+
+func (r StorageAccountQueuePropertiesResource) Arguments() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		// lintignore: S013
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+	}
+
+	for k, v := range storageAccountQueuePropertiesSchema() {
+		s[k] = v
+	}
+
+	return s
+}
+
+func (r StorageAccountQueuePropertiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageAccountQueuePropertiesResource) ResourceType() string {
+	return "azurerm_storage_account_queue_properties"
+}
+
+func (r StorageAccountQueuePropertiesResource) ModelObject() interface{} {
+	return &StorageAccountQueuePropertiesModel{}
+}
+
+func (r StorageAccountQueuePropertiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageAccountQueuePropertiesID
+}
+
+func (r StorageAccountQueuePropertiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan StorageAccountQueuePropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageAccountQueuePropertiesIDFromAccountID(*accountId)
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			if _, err := retrieveStorageAccountForServiceProperties(ctx, storageClient, *accountId, "Queue Properties", func(level storageAccountServiceSupportLevel) bool {
+				return level.supportQueue
+			}); err != nil {
+				return err
+			}
+
+			// Logging and Metrics for the Queue Service aren't exposed by the Resource Manager API, so the Data Plane API is used
+			account, err := storageClient.FindAccount(ctx, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", accountId)
+			}
+
+			queueClient, err := storageClient.QueuesClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			existing, err := queueClient.GetServiceProperties(ctx, accountId.ResourceGroupName, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Queue Properties for %s: %+v", accountId, err)
+			}
+
+			// the Queue Properties may already be configured via the `queue_properties` block within the `azurerm_storage_account` resource
+			if checkForNonDefaultStorageAccountQueueProperties(existing) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandStorageAccountQueueProperties(plan)
+			if err != nil {
+				return err
+			}
+
+			if err = queueClient.UpdateServiceProperties(ctx, accountId.ResourceGroupName, accountId.StorageAccountName, *props); err != nil {
+				return fmt.Errorf("updating Queue Properties for %s: %+v", accountId, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountQueuePropertiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageAccountQueuePropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			account, err := storageClient.FindAccount(ctx, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			queueClient, err := storageClient.QueuesClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			props, err := queueClient.GetServiceProperties(ctx, accountId.ResourceGroupName, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Queue Properties for %s: %+v", accountId, err)
+			}
+
+			state := StorageAccountQueuePropertiesModel{
+				StorageAccountId: accountId.ID(),
+			}
+			flattenStorageAccountQueueProperties(props, &state)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountQueuePropertiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageAccountQueuePropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			var plan StorageAccountQueuePropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return fmt.Errorf("unable to locate %s", accountId)
+			}
+
+			queueClient, err := storageClient.QueuesClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			props, err := expandStorageAccountQueueProperties(plan)
+			if err != nil {
+				return err
+			}
+
+			if err = queueClient.UpdateServiceProperties(ctx, accountId.ResourceGroupName, accountId.StorageAccountName, *props); err != nil {
+				return fmt.Errorf("updating Queue Properties for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountQueuePropertiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageAccountQueuePropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := storageClient.FindAccount(ctx, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			queueClient, err := storageClient.QueuesClient(ctx, *account)
+			if err != nil {
+				return fmt.Errorf("building Queues Client: %s", err)
+			}
+
+			// the Queue Properties can't be deleted, so we'll reset them back to the defaults instead
+			defaults, err := expandStorageAccountQueueProperties(StorageAccountQueuePropertiesModel{})
+			if err != nil {
+				return fmt.Errorf("expanding the default Queue Properties: %+v", err)
+			}
+
+			if err = queueClient.UpdateServiceProperties(ctx, accountId.ResourceGroupName, accountId.StorageAccountName, *defaults); err != nil {
+				return fmt.Errorf("resetting Queue Properties for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageAccountQueueProperties(input StorageAccountQueuePropertiesModel) (*queues.StorageServiceProperties, error) {
+	props := queues.StorageServiceProperties{
+		Cors: &queues.Cors{
+			CorsRule: []queues.CorsRule{},
+		},
+		Logging: &queues.LoggingConfig{
+			Version: "1.0",
+		},
+		HourMetrics: &queues.MetricsConfig{
+			Version: "1.0",
+		},
+		MinuteMetrics: &queues.MetricsConfig{
+			Version: "1.0",
+		},
+	}
+
+	for _, v := range input.CorsRule {
+		props.Cors.CorsRule = append(props.Cors.CorsRule, queues.CorsRule{
+			AllowedOrigins:  strings.Join(v.AllowedOrigins, ","),
+			AllowedMethods:  strings.Join(v.AllowedMethods, ","),
+			AllowedHeaders:  strings.Join(v.AllowedHeaders, ","),
+			ExposedHeaders:  strings.Join(v.ExposedHeaders, ","),
+			MaxAgeInSeconds: int(v.MaxAgeInSeconds),
+		})
+	}
+
+	if len(input.Logging) > 0 {
+		v := input.Logging[0]
+		props.Logging = &queues.LoggingConfig{
+			Version:         v.Version,
+			Delete:          v.Delete,
+			Read:            v.Read,
+			Write:           v.Write,
+			RetentionPolicy: expandStorageAccountQueuePropertiesRetentionPolicy(v.RetentionPolicyDays),
+		}
+	}
+
+	var err error
+	if props.HourMetrics, err = expandStorageAccountQueuePropertiesMetrics(input.HourMetrics, props.HourMetrics); err != nil {
+		return nil, fmt.Errorf("expanding `hour_metrics`: %+v", err)
+	}
+	if props.MinuteMetrics, err = expandStorageAccountQueuePropertiesMetrics(input.MinuteMetrics, props.MinuteMetrics); err != nil {
+		return nil, fmt.Errorf("expanding `minute_metrics`: %+v", err)
+	}
+
+	return &props, nil
+}
+
+func expandStorageAccountQueuePropertiesMetrics(input []StorageAccountQueueMetricsModel, defaults *queues.MetricsConfig) (*queues.MetricsConfig, error) {
+	if len(input) == 0 {
+		return defaults, nil
+	}
+
+	v := input[0]
+	metrics := queues.MetricsConfig{
+		Version:         v.Version,
+		Enabled:         v.Enabled,
+		RetentionPolicy: expandStorageAccountQueuePropertiesRetentionPolicy(v.RetentionPolicyDays),
+	}
+
+	if v.Enabled {
+		metrics.IncludeAPIs = &v.IncludeAPIs
+	} else if v.IncludeAPIs {
+		return nil, fmt.Errorf("`include_apis` may only be set when `enabled` is true")
+	}
+
+	return &metrics, nil
+}
+
+func expandStorageAccountQueuePropertiesRetentionPolicy(days int64) queues.RetentionPolicy {
+	if days <= 0 {
+		return queues.RetentionPolicy{}
+	}
+
+	return queues.RetentionPolicy{
+		Enabled: true,
+		Days:    int(days),
+	}
+}
+
+func flattenStorageAccountQueueProperties(input *queues.StorageServiceProperties, state *StorageAccountQueuePropertiesModel) {
+	if input == nil {
+		return
+	}
+
+	if cors := input.Cors; cors != nil && len(cors.CorsRule) > 0 && cors.CorsRule[0].AllowedOrigins != "" {
+		for _, v := range cors.CorsRule {
+			state.CorsRule = append(state.CorsRule, StorageAccountCorsRuleModel{
+				AllowedOrigins:  strings.Split(v.AllowedOrigins, ","),
+				AllowedMethods:  strings.Split(v.AllowedMethods, ","),
+				AllowedHeaders:  strings.Split(v.AllowedHeaders, ","),
+				ExposedHeaders:  strings.Split(v.ExposedHeaders, ","),
+				MaxAgeInSeconds: int64(v.MaxAgeInSeconds),
+			})
+		}
+	}
+
+	if v := input.Logging; v != nil && v.Version != "" {
+		logging := StorageAccountQueueLoggingModel{
+			Version: v.Version,
+			Delete:  v.Delete,
+			Read:    v.Read,
+			Write:   v.Write,
+		}
+		if v.RetentionPolicy.Enabled {
+			logging.RetentionPolicyDays = int64(v.RetentionPolicy.Days)
+		}
+		state.Logging = []StorageAccountQueueLoggingModel{logging}
+	}
+
+	state.HourMetrics = flattenStorageAccountQueuePropertiesMetrics(input.HourMetrics)
+	state.MinuteMetrics = flattenStorageAccountQueuePropertiesMetrics(input.MinuteMetrics)
+}
+
+func flattenStorageAccountQueuePropertiesMetrics(input *queues.MetricsConfig) []StorageAccountQueueMetricsModel {
+	if input == nil || input.Version == "" {
+		return []StorageAccountQueueMetricsModel{}
+	}
+
+	metrics := StorageAccountQueueMetricsModel{
+		Version: input.Version,
+		Enabled: input.Enabled,
+	}
+	if input.IncludeAPIs != nil {
+		metrics.IncludeAPIs = *input.IncludeAPIs
+	}
+	if input.RetentionPolicy.Enabled {
+		metrics.RetentionPolicyDays = int64(input.RetentionPolicy.Days)
+	}
+
+	return []StorageAccountQueueMetricsModel{metrics}
+}
+
+// To make sure that someone isn't overriding their existing Queue Properties, we'll check for non default values
+func checkForNonDefaultStorageAccountQueueProperties(input *queues.StorageServiceProperties) bool {
+	if input == nil {
+		return false
+	}
+
+	if input.Cors != nil && len(input.Cors.CorsRule) > 0 && input.Cors.CorsRule[0].AllowedOrigins != "" {
+		return true
+	}
+
+	if v := input.Logging; v != nil && (v.Delete || v.Read || v.Write) {
+		return true
+	}
+
+	// Hour and Minute Metrics aren't checked since, depending on when the Storage Account was created, these can be
+	// enabled by default
+	return false
+}
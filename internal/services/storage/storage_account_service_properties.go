@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+)
+
+// the models below are shared between the `azurerm_storage_account_{blob,queue,share}_properties` resources, which
+// manage the same Service Properties as the blocks of the same name within the `azurerm_storage_account` resource
+
+type StorageAccountCorsRuleModel struct {
+	AllowedHeaders  []string `tfschema:"allowed_headers"`
+	AllowedMethods  []string `tfschema:"allowed_methods"`
+	AllowedOrigins  []string `tfschema:"allowed_origins"`
+	ExposedHeaders  []string `tfschema:"exposed_headers"`
+	MaxAgeInSeconds int64    `tfschema:"max_age_in_seconds"`
+}
+
+type StorageAccountRetentionPolicyModel struct {
+	Days int64 `tfschema:"days"`
+}
+
+// retrieveStorageAccountForServiceProperties retrieves the Storage Account which the Service Properties belong to,
+// returning an error if the Storage Account doesn't support the Service in question
+func retrieveStorageAccountForServiceProperties(ctx context.Context, client *client.Client, id commonids.StorageAccountId, service string, supported func(storageAccountServiceSupportLevel) bool) (*storageaccounts.StorageAccount, error) {
+	resp, err := client.ResourceManager.StorageAccounts.GetProperties(ctx, id, storageaccounts.DefaultGetPropertiesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s was not found", id)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	kind := storage.Kind(pointer.From(resp.Model.Kind))
+	var tier storage.SkuTier
+	if sku := resp.Model.Sku; sku != nil {
+		tier = storage.SkuTier(pointer.From(sku.Tier))
+	}
+	if !supported(resolveStorageAccountServiceSupportLevel(kind, tier)) {
+		return nil, fmt.Errorf("%s aren't supported for account kind %q in sku tier %q", service, kind, tier)
+	}
+
+	return resp.Model, nil
+}
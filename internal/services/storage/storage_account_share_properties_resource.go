@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/fileservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageAccountSharePropertiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageAccountSharePropertiesResource{}
+
+type StorageAccountSharePropertiesModel struct {
+	StorageAccountId string                               `tfschema:"storage_account_id"`
+	CorsRule         []StorageAccountCorsRuleModel        `tfschema:"cors_rule"`
+	RetentionPolicy  []StorageAccountRetentionPolicyModel `tfschema:"retention_policy"`
+	Smb              []StorageAccountShareSmbModel        `tfschema:"smb"`
+}
+
+type StorageAccountShareSmbModel struct {
+	Versions                     []string `tfschema:"versions"`
+	AuthenticationTypes          []string `tfschema:"authentication_types"`
+	KerberosTicketEncryptionType []string `tfschema:"kerberos_ticket_encryption_type"`
+	ChannelEncryptionType        []string `tfschema:"channel_encryption_type"`
+	MultichannelEnabled          bool     `tfschema:"multichannel_enabled"`
+}
+
+func (r StorageAccountSharePropertiesResource) Arguments() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		// lintignore: S013
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+	}
+
+	for k, v := range storageAccountSharePropertiesSchema() {
+		s[k] = v
+	}
+
+	return s
+}
+
+func (r StorageAccountSharePropertiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageAccountSharePropertiesResource) ResourceType() string {
+	return "azurerm_storage_account_share_properties"
+}
+
+func (r StorageAccountSharePropertiesResource) ModelObject() interface{} {
+	return &StorageAccountSharePropertiesModel{}
+}
+
+func (r StorageAccountSharePropertiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageAccountSharePropertiesID
+}
+
+func (r StorageAccountSharePropertiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.FileService
+
+			var plan StorageAccountSharePropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageAccountSharePropertiesIDFromAccountID(*accountId)
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := retrieveStorageAccountForServiceProperties(ctx, metadata.Client.Storage, *accountId, "Share Properties", func(level storageAccountServiceSupportLevel) bool {
+				return level.supportShare
+			})
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetServiceProperties(ctx, *accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving Share Properties for %s: %+v", accountId, err)
+			}
+
+			// the Share Properties may already be configured via the `share_properties` block within the `azurerm_storage_account` resource
+			if existing.Model != nil && checkForNonDefaultStorageAccountShareProperties(existing.Model.Properties) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandStorageAccountShareProperties(account, plan)
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.SetServiceProperties(ctx, *accountId, *props); err != nil {
+				return fmt.Errorf("updating Share Properties for %s: %+v", accountId, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountSharePropertiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.FileService
+
+			id, err := parse.StorageAccountSharePropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			resp, err := client.GetServiceProperties(ctx, accountId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving Share Properties for %s: %+v", accountId, err)
+			}
+
+			state := StorageAccountSharePropertiesModel{
+				StorageAccountId: accountId.ID(),
+			}
+			if model := resp.Model; model != nil {
+				flattenStorageAccountShareProperties(model.Properties, &state)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountSharePropertiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.FileService
+
+			id, err := parse.StorageAccountSharePropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			var plan StorageAccountSharePropertiesModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := retrieveStorageAccountForServiceProperties(ctx, metadata.Client.Storage, accountId, "Share Properties", func(level storageAccountServiceSupportLevel) bool {
+				return level.supportShare
+			})
+			if err != nil {
+				return err
+			}
+
+			props, err := expandStorageAccountShareProperties(account, plan)
+			if err != nil {
+				return err
+			}
+
+			if _, err = client.SetServiceProperties(ctx, accountId, *props); err != nil {
+				return fmt.Errorf("updating Share Properties for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountSharePropertiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.FileService
+
+			id, err := parse.StorageAccountSharePropertiesID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.ResourceManager.StorageAccounts.GetProperties(ctx, accountId, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(account.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", accountId)
+			}
+
+			// the Share Properties can't be deleted, so we'll reset them back to the defaults instead
+			defaults, err := expandStorageAccountShareProperties(account.Model, StorageAccountSharePropertiesModel{})
+			if err != nil {
+				return fmt.Errorf("expanding the default Share Properties: %+v", err)
+			}
+
+			if _, err = client.SetServiceProperties(ctx, accountId, *defaults); err != nil {
+				return fmt.Errorf("resetting Share Properties for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageAccountShareProperties(account *storageaccounts.StorageAccount, input StorageAccountSharePropertiesModel) (*fileservice.FileServiceProperties, error) {
+	props := fileservice.FileServicePropertiesProperties{
+		Cors: expandStorageAccountSharePropertiesCorsRules(input.CorsRule),
+		ShareDeleteRetentionPolicy: &fileservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(false),
+		},
+		ProtocolSettings: &fileservice.ProtocolSettings{
+			Smb: &fileservice.SmbSetting{
+				Versions:                 pointer.To(""),
+				AuthenticationMethods:    pointer.To(""),
+				KerberosTicketEncryption: pointer.To(""),
+				ChannelEncryption:        pointer.To(""),
+			},
+		},
+	}
+
+	if len(input.RetentionPolicy) > 0 {
+		props.ShareDeleteRetentionPolicy = &fileservice.DeleteRetentionPolicy{
+			Enabled: pointer.To(true),
+			Days:    pointer.To(input.RetentionPolicy[0].Days),
+		}
+	}
+
+	if len(input.Smb) > 0 {
+		v := input.Smb[0]
+		props.ProtocolSettings.Smb = &fileservice.SmbSetting{
+			Versions:                 pointer.To(strings.Join(v.Versions, ";")),
+			AuthenticationMethods:    pointer.To(strings.Join(v.AuthenticationTypes, ";")),
+			KerberosTicketEncryption: pointer.To(strings.Join(v.KerberosTicketEncryptionType, ";")),
+			ChannelEncryption:        pointer.To(strings.Join(v.ChannelEncryptionType, ";")),
+		}
+
+		// the API complains if any Multichannel information is sent for Storage Accounts which aren't in the Premium tier,
+		// even when Multichannel is disabled
+		isPremium := account.Sku != nil && pointer.From(account.Sku.Tier) == storageaccounts.SkuTierPremium
+		if isPremium {
+			props.ProtocolSettings.Smb.Multichannel = &fileservice.Multichannel{
+				Enabled: pointer.To(v.MultichannelEnabled),
+			}
+		} else if v.MultichannelEnabled {
+			return nil, fmt.Errorf("`multichannel_enabled` isn't supported for Standard tier Storage accounts")
+		}
+	}
+
+	return &fileservice.FileServiceProperties{
+		Properties: &props,
+	}, nil
+}
+
+func expandStorageAccountSharePropertiesCorsRules(input []StorageAccountCorsRuleModel) *fileservice.CorsRules {
+	rules := make([]fileservice.CorsRule, 0)
+	for _, v := range input {
+		methods := make([]fileservice.AllowedMethods, 0)
+		for _, method := range v.AllowedMethods {
+			methods = append(methods, fileservice.AllowedMethods(method))
+		}
+
+		rules = append(rules, fileservice.CorsRule{
+			AllowedHeaders:  v.AllowedHeaders,
+			AllowedMethods:  methods,
+			AllowedOrigins:  v.AllowedOrigins,
+			ExposedHeaders:  v.ExposedHeaders,
+			MaxAgeInSeconds: v.MaxAgeInSeconds,
+		})
+	}
+
+	return &fileservice.CorsRules{
+		CorsRules: &rules,
+	}
+}
+
+func flattenStorageAccountShareProperties(input *fileservice.FileServicePropertiesProperties, state *StorageAccountSharePropertiesModel) {
+	if input == nil {
+		return
+	}
+
+	state.CorsRule = make([]StorageAccountCorsRuleModel, 0)
+	if input.Cors != nil && input.Cors.CorsRules != nil {
+		for _, v := range *input.Cors.CorsRules {
+			methods := make([]string, 0)
+			for _, method := range v.AllowedMethods {
+				methods = append(methods, string(method))
+			}
+
+			state.CorsRule = append(state.CorsRule, StorageAccountCorsRuleModel{
+				AllowedHeaders:  v.AllowedHeaders,
+				AllowedMethods:  methods,
+				AllowedOrigins:  v.AllowedOrigins,
+				ExposedHeaders:  v.ExposedHeaders,
+				MaxAgeInSeconds: v.MaxAgeInSeconds,
+			})
+		}
+	}
+
+	if v := input.ShareDeleteRetentionPolicy; v != nil && pointer.From(v.Enabled) {
+		state.RetentionPolicy = []StorageAccountRetentionPolicyModel{
+			{
+				Days: pointer.From(v.Days),
+			},
+		}
+	}
+
+	if input.ProtocolSettings != nil && input.ProtocolSettings.Smb != nil {
+		smb := input.ProtocolSettings.Smb
+		output := StorageAccountShareSmbModel{
+			Versions:                     splitStorageAccountShareSmbSetting(smb.Versions),
+			AuthenticationTypes:          splitStorageAccountShareSmbSetting(smb.AuthenticationMethods),
+			KerberosTicketEncryptionType: splitStorageAccountShareSmbSetting(smb.KerberosTicketEncryption),
+			ChannelEncryptionType:        splitStorageAccountShareSmbSetting(smb.ChannelEncryption),
+		}
+		if smb.Multichannel != nil {
+			output.MultichannelEnabled = pointer.From(smb.Multichannel.Enabled)
+		}
+
+		if len(output.Versions) > 0 || len(output.AuthenticationTypes) > 0 || len(output.KerberosTicketEncryptionType) > 0 || len(output.ChannelEncryptionType) > 0 || smb.Multichannel != nil {
+			state.Smb = []StorageAccountShareSmbModel{output}
+		}
+	}
+}
+
+func splitStorageAccountShareSmbSetting(input *string) []string {
+	output := make([]string, 0)
+	if input == nil || *input == "" {
+		return output
+	}
+
+	return append(output, strings.Split(*input, ";")...)
+}
+
+// To make sure that someone isn't overriding their existing Share Properties, we'll check for non default values
+func checkForNonDefaultStorageAccountShareProperties(input *fileservice.FileServicePropertiesProperties) bool {
+	if input == nil {
+		return false
+	}
+
+	if input.Cors != nil && input.Cors.CorsRules != nil && len(*input.Cors.CorsRules) > 0 {
+		return true
+	}
+
+	if input.ProtocolSettings != nil && input.ProtocolSettings.Smb != nil {
+		smb := input.ProtocolSettings.Smb
+		for _, v := range []*string{smb.Versions, smb.AuthenticationMethods, smb.KerberosTicketEncryption, smb.ChannelEncryption} {
+			if pointer.From(v) != "" {
+				return true
+			}
+		}
+		if smb.Multichannel != nil && pointer.From(smb.Multichannel.Enabled) {
+			return true
+		}
+	}
+
+	// the Share Delete Retention Policy isn't checked since, depending on how the Storage Account was created, this can
+	// be enabled by default
+	return false
+}
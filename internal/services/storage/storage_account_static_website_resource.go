@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/accounts"
+)
+
+type StorageAccountStaticWebsiteResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageAccountStaticWebsiteResource{}
+
+type StorageAccountStaticWebsiteModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	IndexDocument    string `tfschema:"index_document"`
+	Error404Document string `tfschema:"error_404_document"`
+}
+
+func (r StorageAccountStaticWebsiteResource) Arguments() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		// lintignore: S013
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+	}
+
+	for k, v := range storageAccountStaticWebsiteSchema() {
+		s[k] = v
+	}
+
+	return s
+}
+
+func (r StorageAccountStaticWebsiteResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageAccountStaticWebsiteResource) ResourceType() string {
+	return "azurerm_storage_account_static_website"
+}
+
+func (r StorageAccountStaticWebsiteResource) ModelObject() interface{} {
+	return &StorageAccountStaticWebsiteModel{}
+}
+
+func (r StorageAccountStaticWebsiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageAccountStaticWebsiteID
+}
+
+func (r StorageAccountStaticWebsiteResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan StorageAccountStaticWebsiteModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageAccountStaticWebsiteIDFromAccountID(*accountId)
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			if _, err := retrieveStorageAccountForServiceProperties(ctx, storageClient, *accountId, "Static Websites", func(level storageAccountServiceSupportLevel) bool {
+				return level.supportStaticWebsite
+			}); err != nil {
+				return err
+			}
+
+			// the Static Website isn't exposed by the Resource Manager API, so the Data Plane API is used
+			accountsClient, err := buildStorageAccountStaticWebsiteClient(ctx, metadata, *accountId)
+			if err != nil {
+				return err
+			}
+			if accountsClient == nil {
+				return fmt.Errorf("unable to locate %s", accountId)
+			}
+
+			existing, err := accountsClient.GetServiceProperties(ctx, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Static Website for %s: %+v", accountId, err)
+			}
+
+			// the Static Website may already be enabled via the `static_website` block within the `azurerm_storage_account` resource
+			if len(flattenStaticWebsiteProperties(existing)) != 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err = accountsClient.SetServiceProperties(ctx, accountId.StorageAccountName, expandStorageAccountStaticWebsite(plan)); err != nil {
+				return fmt.Errorf("enabling the Static Website for %s: %+v", accountId, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageAccountStaticWebsiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			accountsClient, err := buildStorageAccountStaticWebsiteClient(ctx, metadata, accountId)
+			if err != nil {
+				return err
+			}
+			if accountsClient == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			props, err := accountsClient.GetServiceProperties(ctx, accountId.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Static Website for %s: %+v", accountId, err)
+			}
+
+			// a disabled Static Website is treated as not existing
+			staticWebsite := props.StorageServiceProperties
+			if staticWebsite == nil || staticWebsite.StaticWebsite == nil || !staticWebsite.StaticWebsite.Enabled {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageAccountStaticWebsiteModel{
+				StorageAccountId: accountId.ID(),
+				IndexDocument:    staticWebsite.StaticWebsite.IndexDocument,
+				Error404Document: staticWebsite.StaticWebsite.ErrorDocument404Path,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageAccountStaticWebsiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			var plan StorageAccountStaticWebsiteModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			accountsClient, err := buildStorageAccountStaticWebsiteClient(ctx, metadata, accountId)
+			if err != nil {
+				return err
+			}
+			if accountsClient == nil {
+				return fmt.Errorf("unable to locate %s", accountId)
+			}
+
+			if _, err = accountsClient.SetServiceProperties(ctx, accountId.StorageAccountName, expandStorageAccountStaticWebsite(plan)); err != nil {
+				return fmt.Errorf("updating the Static Website for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageAccountStaticWebsiteID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			accountId := id.StorageAccountId()
+
+			locks.ByName(accountId.StorageAccountName, storageAccountResourceName)
+			defer locks.UnlockByName(accountId.StorageAccountName, storageAccountResourceName)
+
+			accountsClient, err := buildStorageAccountStaticWebsiteClient(ctx, metadata, accountId)
+			if err != nil {
+				return err
+			}
+			if accountsClient == nil {
+				return nil
+			}
+
+			// the Static Website can't be deleted, instead it's disabled
+			if _, err = accountsClient.SetServiceProperties(ctx, accountId.StorageAccountName, expandStaticWebsiteProperties([]interface{}{})); err != nil {
+				return fmt.Errorf("disabling the Static Website for %s: %+v", accountId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// buildStorageAccountStaticWebsiteClient returns the Data Plane client used to manage the Static Website, or nil
+// when the Storage Account can't be found
+func buildStorageAccountStaticWebsiteClient(ctx context.Context, metadata sdk.ResourceMetaData, id commonids.StorageAccountId) (*accounts.Client, error) {
+	storageClient := metadata.Client.Storage
+
+	account, err := storageClient.FindAccount(ctx, id.StorageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, nil
+	}
+
+	accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client: %s", err)
+	}
+
+	return accountsClient, nil
+}
+
+func expandStorageAccountStaticWebsite(input StorageAccountStaticWebsiteModel) accounts.StorageServiceProperties {
+	// the presence of this resource signifies that the Static Website is enabled, with all of the fields being optional
+	return accounts.StorageServiceProperties{
+		StaticWebsite: &accounts.StaticWebsite{
+			Enabled:              true,
+			IndexDocument:        input.IndexDocument,
+			ErrorDocument404Path: input.Error404Document,
+		},
+	}
+}
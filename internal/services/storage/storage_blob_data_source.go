@@ -10,10 +10,11 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
-	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
 )
 
 func dataSourceStorageBlob() *pluginsdk.Resource {
@@ -41,6 +42,26 @@ func dataSourceStorageBlob() *pluginsdk.Resource {
 				Required: true,
 			},
 
+			"version_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"snapshot"},
+			},
+
+			"snapshot": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"version_id"},
+			},
+
+			"is_current_version": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"type": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -67,6 +88,14 @@ func dataSourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"metadata": MetaDataComputedSchema(),
+
+			"index_tags": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -93,17 +122,26 @@ func dataSourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
-	id := blobsClient.GetResourceID(accountName, containerName, name)
+	id := parse.NewStorageBlobDataPlaneId(accountName, storageClient.Environment.StorageEndpointSuffix, containerName, name)
+	url := id.ID()
 
-	log.Printf("[INFO] Retrieving Storage Blob %q (Container %q / Account %q).", name, containerName, accountName)
-	input := blobs.GetPropertiesInput{}
-	props, err := blobsClient.GetProperties(ctx, accountName, containerName, name, input)
+	// the ID is the URL of the Blob, including the Version or Snapshot when specified
+	id.VersionID = d.Get("version_id").(string)
+	id.Snapshot = d.Get("snapshot").(string)
+
+	log.Printf("[INFO] Retrieving %s.", id)
+	props, err := getBlobProperties(ctx, blobsClient, id)
 	if err != nil {
 		if utils.ResponseWasNotFound(props.Response) {
-			return fmt.Errorf("the Blob %q was not found in Container %q / Account %q", name, containerName, accountName)
+			return fmt.Errorf("%s was not found", id)
 		}
 
-		return fmt.Errorf("retrieving properties for Blob %q (Container %q / Account %q): %s", name, containerName, accountName, err)
+		return fmt.Errorf("retrieving properties for %s: %s", id, err)
+	}
+
+	indexTags, err := getBlobIndexTags(ctx, blobsClient, id, props)
+	if err != nil {
+		return fmt.Errorf("retrieving Index Tags for %s: %s", id, err)
 	}
 
 	d.Set("name", name)
@@ -124,14 +162,20 @@ func dataSourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	d.Set("content_md5", contentMD5)
 
 	d.Set("type", strings.TrimSuffix(string(props.BlobType), "Blob"))
+	d.Set("version_id", props.Header.Get("x-ms-version-id"))
+	d.Set("is_current_version", strings.EqualFold(props.Header.Get("x-ms-is-current-version"), "true"))
 
-	d.SetId(id)
+	d.SetId(id.ID())
 
-	d.Set("url", id)
+	d.Set("url", url)
 
 	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %+v", err)
 	}
 
+	if err := d.Set("index_tags", FlattenMetaData(indexTags)); err != nil {
+		return fmt.Errorf("setting `index_tags`: %+v", err)
+	}
+
 	return nil
 }
@@ -39,6 +39,78 @@ func TestAccDataSourceStorageBlob_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceStorageBlob_indexTagsAndVersion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: StorageBlobDataSource{}.indexTagsAndVersion(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("version_id").Exists(),
+				check.That(data.ResourceName).Key("is_current_version").HasValue("true"),
+				check.That(data.ResourceName).Key("index_tags.%").HasValue("1"),
+				check.That(data.ResourceName).Key("index_tags.project").HasValue("example"),
+			),
+		},
+	})
+}
+
+func (d StorageBlobDataSource) indexTagsAndVersion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "blobdstest-%[1]s"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsadsc%[1]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = true
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "containerdstest-%[1]s"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "example"
+
+  index_tags = {
+    project = "example"
+  }
+}
+
+data "azurerm_storage_blob" "current" {
+  name                   = azurerm_storage_blob.test.name
+  storage_account_name   = azurerm_storage_blob.test.storage_account_name
+  storage_container_name = azurerm_storage_blob.test.storage_container_name
+}
+
+data "azurerm_storage_blob" "test" {
+  name                   = azurerm_storage_blob.test.name
+  storage_account_name   = azurerm_storage_blob.test.storage_account_name
+  storage_container_name = azurerm_storage_blob.test.storage_container_name
+  version_id             = data.azurerm_storage_blob.current.version_id
+}
+`, data.RandomString, data.Locations.Primary)
+}
+
 func (d StorageBlobDataSource) basic(data acceptance.TestData, fileName string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -7,12 +7,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -132,7 +134,7 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"content_md5": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
+				Computed:      true,
 				ConflictsWith: []string{"source_uri"},
 			},
 
@@ -142,7 +144,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -150,18 +151,110 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			"block_size_in_mb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4000),
+			},
+
 			"metadata": MetaDataComputedSchema(),
+
+			"index_tags": {
+				Type:         pluginsdk.TypeMap,
+				Optional:     true,
+				ValidateFunc: validate.StorageBlobIndexTags,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
 		},
 
-		CustomizeDiff: func(ctx context.Context, diff *pluginsdk.ResourceDiff, i interface{}) error {
-			if content := diff.Get("source_content"); content != "" && diff.Get("type") == "Page" {
-				if len(content.(string))%512 != 0 {
-					return fmt.Errorf(`"source" must be aligned to 512-byte boundary for "type" set to "Page"`)
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, i interface{}) error {
+				if content := diff.Get("source_content"); content != "" && diff.Get("type") == "Page" {
+					if len(content.(string))%512 != 0 {
+						return fmt.Errorf(`"source" must be aligned to 512-byte boundary for "type" set to "Page"`)
+					}
 				}
-			}
+				return nil
+			},
+			storageBlobContentMD5Diff,
+		),
+	}
+}
+
+// storageBlobContentMD5Diff computes the `content_md5` of the `source` or `source_content` for Block blobs when it's not
+// specified, so that when either the source or the blob itself changes the blob is re-uploaded (in-place)
+func storageBlobContentMD5Diff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Get("type").(string) != "Block" {
+		return nil
+	}
+	if v := diff.GetRawConfig().GetAttr("content_md5"); !v.IsNull() {
+		return nil
+	}
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("source_content") {
+		return nil
+	}
+
+	source := diff.Get("source").(string)
+	sourceContent := diff.Get("source_content").(string)
+	if source == "" && sourceContent == "" {
+		return nil
+	}
+
+	contentMD5, err := contentMD5ForSource(source, sourceContent)
+	if err != nil {
+		// the file may be created by another resource during the apply, in which case it's computed during the upload
+		if os.IsNotExist(err) {
 			return nil
-		},
+		}
+		return fmt.Errorf("computing the MD5 of `source`: %+v", err)
+	}
+
+	contentMD5, err = convertBase64ToHexEncoding(contentMD5)
+	if err != nil {
+		return err
+	}
+
+	if diff.Get("content_md5").(string) != contentMD5 {
+		return diff.SetNew("content_md5", contentMD5)
+	}
+
+	return nil
+}
+
+// expandStorageBlobUpload builds the BlobUpload used to upload the contents of the blob
+func expandStorageBlobUpload(d *pluginsdk.ResourceData, client *blobs.Client, accountName, containerName, name string) (*BlobUpload, error) {
+	contentMD5 := ""
+	if contentMD5Raw := d.Get("content_md5").(string); contentMD5Raw != "" {
+		// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
+		var err error
+		contentMD5, err = convertHexToBase64Encoding(contentMD5Raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to base64 encode `content_md5` value: %s", err)
+		}
 	}
+
+	metaDataRaw := d.Get("metadata").(map[string]interface{})
+	return &BlobUpload{
+		AccountName:   accountName,
+		ContainerName: containerName,
+		BlobName:      name,
+		Client:        client,
+
+		BlobType:      d.Get("type").(string),
+		BlockSize:     int64(d.Get("block_size_in_mb").(int)) * 1024 * 1024,
+		CacheControl:  d.Get("cache_control").(string),
+		ContentType:   d.Get("content_type").(string),
+		ContentMD5:    contentMD5,
+		MetaData:      ExpandMetaData(metaDataRaw),
+		Parallelism:   d.Get("parallelism").(int),
+		Size:          d.Get("size").(int),
+		Source:        d.Get("source").(string),
+		SourceContent: d.Get("source_content").(string),
+		SourceUri:     d.Get("source_uri").(string),
+	}, nil
 }
 
 func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -200,34 +293,10 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	contentMD5Raw := d.Get("content_md5").(string)
-	contentMD5 := ""
-	if contentMD5Raw != "" {
-		// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
-		contentMD5, err = convertHexToBase64Encoding(d.Get("content_md5").(string))
-		if err != nil {
-			return fmt.Errorf("failed to base64 encode `content_md5` value: %s", err)
-		}
-	}
-
 	log.Printf("[DEBUG] Creating Blob %q in Container %q within Storage Account %q..", name, containerName, accountName)
-	metaDataRaw := d.Get("metadata").(map[string]interface{})
-	blobInput := BlobUpload{
-		AccountName:   accountName,
-		ContainerName: containerName,
-		BlobName:      name,
-		Client:        blobsClient,
-
-		BlobType:      d.Get("type").(string),
-		CacheControl:  d.Get("cache_control").(string),
-		ContentType:   d.Get("content_type").(string),
-		ContentMD5:    contentMD5,
-		MetaData:      ExpandMetaData(metaDataRaw),
-		Parallelism:   d.Get("parallelism").(int),
-		Size:          d.Get("size").(int),
-		Source:        d.Get("source").(string),
-		SourceContent: d.Get("source_content").(string),
-		SourceUri:     d.Get("source_uri").(string),
+	blobInput, err := expandStorageBlobUpload(d, blobsClient, accountName, containerName, name)
+	if err != nil {
+		return err
 	}
 	if err := blobInput.Create(ctx); err != nil {
 		return fmt.Errorf("creating Blob %q (Container %q / Account %q): %s", name, containerName, accountName, err)
@@ -262,14 +331,30 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
-	if d.HasChange("content_type") || d.HasChange("cache_control") {
+	// when the contents of the `source` / `source_content` (or the blob itself) have changed the blob is re-uploaded,
+	// which resets the properties of the blob - so these need to be set again
+	reuploaded := false
+	if d.HasChange("content_md5") && !d.IsNewResource() {
+		log.Printf("[DEBUG] Re-uploading Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		blobInput, err := expandStorageBlobUpload(d, blobsClient, id.AccountName, id.ContainerName, id.BlobName)
+		if err != nil {
+			return err
+		}
+		if err := blobInput.Create(ctx); err != nil {
+			return fmt.Errorf("re-uploading Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] Re-uploaded Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+		reuploaded = true
+	}
+
+	if d.HasChange("content_type") || d.HasChange("cache_control") || reuploaded {
 		log.Printf("[DEBUG] Updating Properties for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		input := blobs.SetPropertiesInput{
 			ContentType:  utils.String(d.Get("content_type").(string)),
 			CacheControl: utils.String(d.Get("cache_control").(string)),
 		}
 
-		// `content_md5` is `ForceNew` but must be included in the `SetPropertiesInput` update payload or it will be zeroed on the blob.
+		// `content_md5` must be included in the `SetPropertiesInput` update payload or it will be zeroed on the blob.
 		if contentMD5 := d.Get("content_md5").(string); contentMD5 != "" {
 			data, err := convertHexToBase64Encoding(contentMD5)
 			if err != nil {
@@ -277,6 +362,15 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			}
 
 			input.ContentMD5 = utils.String(data)
+		} else {
+			// when `content_md5` isn't specified it's computed during the upload, so isn't available until the blob's been read
+			props, err := blobsClient.GetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+			if err != nil {
+				return fmt.Errorf("retrieving Properties for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+			if props.ContentMD5 != "" {
+				input.ContentMD5 = utils.String(props.ContentMD5)
+			}
 		}
 
 		if _, err := blobsClient.SetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName, input); err != nil {
@@ -297,7 +391,16 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		log.Printf("[DEBUG] Updated MetaData for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
 	}
 
-	if d.HasChange("access_tier") {
+	if d.HasChange("index_tags") || (reuploaded && len(d.Get("index_tags").(map[string]interface{})) > 0) {
+		log.Printf("[DEBUG] Updating Index Tags for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		tagsId := parse.NewStorageBlobDataPlaneId(id.AccountName, storageClient.Environment.StorageEndpointSuffix, id.ContainerName, id.BlobName)
+		if err := setBlobIndexTags(ctx, blobsClient, tagsId, ExpandMetaData(d.Get("index_tags").(map[string]interface{}))); err != nil {
+			return fmt.Errorf("updating Index Tags for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] Updated Index Tags for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
+	if d.HasChange("access_tier") || (reuploaded && d.Get("access_tier").(string) != "") {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		accessTier := blobs.AccessTier(d.Get("access_tier").(string))
@@ -374,6 +477,15 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %+v", err)
 	}
+
+	indexTags, err := getBlobIndexTags(ctx, blobsClient, parse.NewStorageBlobDataPlaneId(id.AccountName, storageClient.Environment.StorageEndpointSuffix, id.ContainerName, id.BlobName), props)
+	if err != nil {
+		return fmt.Errorf("retrieving Index Tags for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+	}
+	if err := d.Set("index_tags", FlattenMetaData(indexTags)); err != nil {
+		return fmt.Errorf("setting `index_tags`: %+v", err)
+	}
+
 	// The CopySource is only returned if the blob hasn't been modified (e.g. metadata configured etc)
 	// as such, we need to conditionally set this to ensure it's trackable if possible
 	if props.CopySource != "" {
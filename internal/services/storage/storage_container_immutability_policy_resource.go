@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageContainerImmutabilityPolicyResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageContainerImmutabilityPolicyResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageContainerImmutabilityPolicyResource{}
+)
+
+type StorageContainerImmutabilityPolicyModel struct {
+	StorageContainerResourceManagerId string `tfschema:"storage_container_resource_manager_id"`
+	ImmutabilityPeriodInDays          int64  `tfschema:"immutability_period_in_days"`
+	Locked                            bool   `tfschema:"locked"`
+	ProtectedAppendWritesEnabled      bool   `tfschema:"protected_append_writes_enabled"`
+	ProtectedAppendWritesAllEnabled   bool   `tfschema:"protected_append_writes_all_enabled"`
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_resource_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"immutability_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 146000),
+		},
+
+		"locked": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"protected_append_writes_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"protected_append_writes_all_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) ResourceType() string {
+	return "azurerm_storage_container_immutability_policy"
+}
+
+func (r StorageContainerImmutabilityPolicyResource) ModelObject() interface{} {
+	return &StorageContainerImmutabilityPolicyModel{}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageContainerImmutabilityPolicyID
+}
+
+func (r StorageContainerImmutabilityPolicyResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			if diff.Get("protected_append_writes_enabled").(bool) && diff.Get("protected_append_writes_all_enabled").(bool) {
+				return fmt.Errorf("`protected_append_writes_enabled` and `protected_append_writes_all_enabled` cannot both be enabled")
+			}
+
+			// once locked the policy can only be extended, so catch anything else at plan time rather than part-way through an apply
+			if diff.Id() == "" {
+				return nil
+			}
+			if oldLocked, _ := diff.GetChange("locked"); !oldLocked.(bool) {
+				return nil
+			}
+			if !diff.Get("locked").(bool) {
+				return fmt.Errorf("a locked Immutability Policy cannot be unlocked")
+			}
+			if oldPeriod, newPeriod := diff.GetChange("immutability_period_in_days"); newPeriod.(int) < oldPeriod.(int) {
+				return fmt.Errorf("the `immutability_period_in_days` of a locked Immutability Policy can only be increased, but it would be decreased from %d to %d", oldPeriod.(int), newPeriod.(int))
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			var plan StorageContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := commonids.ParseStorageContainerID(plan.StorageContainerResourceManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageContainerImmutabilityPolicyIDFromContainerID(*containerId)
+
+			locks.ByID(containerId.ID())
+			defer locks.UnlockByID(containerId.ID())
+
+			existing, err := client.Get(ctx, *containerId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", containerId)
+				}
+				return fmt.Errorf("retrieving %s: %+v", containerId, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && pointer.From(existing.Model.Properties.HasImmutabilityPolicy) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, *containerId, expandStorageContainerImmutabilityPolicy(plan), blobcontainers.DefaultCreateOrUpdateImmutabilityPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("creating Immutability Policy for %s: %+v", containerId, err)
+			}
+
+			metadata.SetID(id)
+
+			if plan.Locked {
+				if resp.Model == nil || resp.Model.Etag == nil {
+					return fmt.Errorf("creating Immutability Policy for %s: `etag` was nil", containerId)
+				}
+				if err := lockStorageContainerImmutabilityPolicy(ctx, client, *containerId, *resp.Model.Etag); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			containerId := id.StorageContainerId()
+
+			// the API returns an empty "default" policy when none exists, so check the Container first
+			container, err := client.Get(ctx, containerId)
+			if err != nil {
+				if response.WasNotFound(container.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", containerId, err)
+			}
+			if container.Model == nil || container.Model.Properties == nil || !pointer.From(container.Model.Properties.HasImmutabilityPolicy) {
+				return metadata.MarkAsGone(id)
+			}
+
+			resp, err := client.GetImmutabilityPolicy(ctx, containerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving Immutability Policy for %s: %+v", containerId, err)
+			}
+
+			state := StorageContainerImmutabilityPolicyModel{
+				StorageContainerResourceManagerId: containerId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.ImmutabilityPeriodInDays = pointer.From(props.ImmutabilityPeriodSinceCreationInDays)
+				state.Locked = pointer.From(props.State) == blobcontainers.ImmutabilityPolicyStateLocked
+				state.ProtectedAppendWritesEnabled = pointer.From(props.AllowProtectedAppendWrites)
+				state.ProtectedAppendWritesAllEnabled = pointer.From(props.AllowProtectedAppendWritesAll)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			containerId := id.StorageContainerId()
+
+			var plan StorageContainerImmutabilityPolicyModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(containerId.ID())
+			defer locks.UnlockByID(containerId.ID())
+
+			existing, err := client.GetImmutabilityPolicy(ctx, containerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving Immutability Policy for %s: %+v", containerId, err)
+			}
+			if existing.Model == nil || existing.Model.Etag == nil {
+				return fmt.Errorf("retrieving Immutability Policy for %s: `model` or `etag` was nil", containerId)
+			}
+			etag := *existing.Model.Etag
+
+			// the Etag is passed through to each operation so that we never lock or extend a policy which has changed underneath us
+			if pointer.From(existing.Model.Properties.State) == blobcontainers.ImmutabilityPolicyStateLocked {
+				if !plan.Locked {
+					return fmt.Errorf("updating Immutability Policy for %s: a locked Immutability Policy cannot be unlocked", containerId)
+				}
+
+				if metadata.ResourceData.HasChanges("immutability_period_in_days", "protected_append_writes_enabled", "protected_append_writes_all_enabled") {
+					options := blobcontainers.ExtendImmutabilityPolicyOperationOptions{
+						IfMatch: pointer.To(etag),
+					}
+					if _, err := client.ExtendImmutabilityPolicy(ctx, containerId, expandStorageContainerImmutabilityPolicy(plan), options); err != nil {
+						return fmt.Errorf("extending Immutability Policy for %s: %+v", containerId, err)
+					}
+				}
+
+				return nil
+			}
+
+			if metadata.ResourceData.HasChanges("immutability_period_in_days", "protected_append_writes_enabled", "protected_append_writes_all_enabled") {
+				options := blobcontainers.CreateOrUpdateImmutabilityPolicyOperationOptions{
+					IfMatch: pointer.To(etag),
+				}
+				resp, err := client.CreateOrUpdateImmutabilityPolicy(ctx, containerId, expandStorageContainerImmutabilityPolicy(plan), options)
+				if err != nil {
+					return fmt.Errorf("updating Immutability Policy for %s: %+v", containerId, err)
+				}
+				if resp.Model == nil || resp.Model.Etag == nil {
+					return fmt.Errorf("updating Immutability Policy for %s: `etag` was nil", containerId)
+				}
+				etag = *resp.Model.Etag
+			}
+
+			if plan.Locked {
+				if err := lockStorageContainerImmutabilityPolicy(ctx, client, containerId, etag); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerImmutabilityPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerImmutabilityPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			containerId := id.StorageContainerId()
+
+			locks.ByID(containerId.ID())
+			defer locks.UnlockByID(containerId.ID())
+
+			existing, err := client.GetImmutabilityPolicy(ctx, containerId, blobcontainers.DefaultGetImmutabilityPolicyOperationOptions())
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving Immutability Policy for %s: %+v", containerId, err)
+			}
+			if existing.Model == nil || existing.Model.Etag == nil {
+				return fmt.Errorf("retrieving Immutability Policy for %s: `model` or `etag` was nil", containerId)
+			}
+
+			if pointer.From(existing.Model.Properties.State) == blobcontainers.ImmutabilityPolicyStateLocked {
+				// this is enforced by the service to guarantee WORM compliance - the policy lives until the Container is deleted,
+				// which is itself only possible once every blob within it has passed its retention period.
+				return fmt.Errorf("deleting Immutability Policy for %s: a locked Immutability Policy cannot be deleted. The policy is removed when the Storage Container is deleted, which is only possible once all blobs within it have passed their retention period. To stop managing this policy with Terraform, remove it from the state using `terraform state rm`", containerId)
+			}
+
+			options := blobcontainers.DeleteImmutabilityPolicyOperationOptions{
+				IfMatch: existing.Model.Etag,
+			}
+			if _, err := client.DeleteImmutabilityPolicy(ctx, containerId, options); err != nil {
+				return fmt.Errorf("deleting Immutability Policy for %s: %+v", containerId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func lockStorageContainerImmutabilityPolicy(ctx context.Context, client *blobcontainers.BlobContainersClient, id commonids.StorageContainerId, etag string) error {
+	options := blobcontainers.LockImmutabilityPolicyOperationOptions{
+		IfMatch: pointer.To(etag),
+	}
+	if _, err := client.LockImmutabilityPolicy(ctx, id, options); err != nil {
+		return fmt.Errorf("locking Immutability Policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandStorageContainerImmutabilityPolicy(input StorageContainerImmutabilityPolicyModel) blobcontainers.ImmutabilityPolicy {
+	return blobcontainers.ImmutabilityPolicy{
+		Properties: blobcontainers.ImmutabilityPolicyProperty{
+			AllowProtectedAppendWrites:            pointer.To(input.ProtectedAppendWritesEnabled),
+			AllowProtectedAppendWritesAll:         pointer.To(input.ProtectedAppendWritesAllEnabled),
+			ImmutabilityPeriodSinceCreationInDays: pointer.To(input.ImmutabilityPeriodInDays),
+		},
+	}
+}
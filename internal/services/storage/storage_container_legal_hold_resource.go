@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/blobcontainers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageContainerLegalHoldResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageContainerLegalHoldResource{}
+
+type StorageContainerLegalHoldModel struct {
+	StorageContainerResourceManagerId string   `tfschema:"storage_container_resource_manager_id"`
+	Tags                              []string `tfschema:"tags"`
+	ProtectedAppendWritesAllEnabled   bool     `tfschema:"protected_append_writes_all_enabled"`
+}
+
+func (r StorageContainerLegalHoldResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_resource_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			MaxItems: 10,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.StorageContainerLegalHoldTag,
+			},
+		},
+
+		"protected_append_writes_all_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageContainerLegalHoldResource) ResourceType() string {
+	return "azurerm_storage_container_legal_hold"
+}
+
+func (r StorageContainerLegalHoldResource) ModelObject() interface{} {
+	return &StorageContainerLegalHoldModel{}
+}
+
+func (r StorageContainerLegalHoldResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageContainerLegalHoldID
+}
+
+func (r StorageContainerLegalHoldResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			var plan StorageContainerLegalHoldModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := commonids.ParseStorageContainerID(plan.StorageContainerResourceManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageContainerLegalHoldIDFromContainerID(*containerId)
+
+			locks.ByID(containerId.ID())
+			defer locks.UnlockByID(containerId.ID())
+
+			existing, err := client.Get(ctx, *containerId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", containerId)
+				}
+				return fmt.Errorf("retrieving %s: %+v", containerId, err)
+			}
+			if existing.Model != nil && existing.Model.Properties != nil && pointer.From(existing.Model.Properties.HasLegalHold) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := blobcontainers.LegalHold{
+				AllowProtectedAppendWritesAll: pointer.To(plan.ProtectedAppendWritesAllEnabled),
+				Tags:                          plan.Tags,
+			}
+			if _, err := client.SetLegalHold(ctx, *containerId, input); err != nil {
+				return fmt.Errorf("setting Legal Hold for %s: %+v", containerId, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerLegalHoldID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			containerId := id.StorageContainerId()
+
+			var existingState StorageContainerLegalHoldModel
+			if err := metadata.Decode(&existingState); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, containerId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", containerId, err)
+			}
+			if resp.Model == nil || resp.Model.Properties == nil || !pointer.From(resp.Model.Properties.HasLegalHold) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageContainerLegalHoldModel{
+				StorageContainerResourceManagerId: containerId.ID(),
+				Tags:                              make([]string, 0),
+			}
+
+			if legalHold := resp.Model.Properties.LegalHold; legalHold != nil {
+				if legalHold.Tags != nil {
+					for _, tag := range *legalHold.Tags {
+						if tag.Tag == nil {
+							continue
+						}
+						state.Tags = append(state.Tags, storageContainerLegalHoldTagFromConfig(*tag.Tag, existingState.Tags))
+					}
+				}
+				if history := legalHold.ProtectedAppendWritesHistory; history != nil {
+					state.ProtectedAppendWritesAllEnabled = pointer.From(history.AllowProtectedAppendWritesAll)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerLegalHoldID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			containerId := id.StorageContainerId()
+
+			var plan StorageContainerLegalHoldModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(containerId.ID())
+			defer locks.UnlockByID(containerId.ID())
+
+			// the new tags are applied before any are cleared, so that the Container is never without a Legal Hold part-way through
+			input := blobcontainers.LegalHold{
+				AllowProtectedAppendWritesAll: pointer.To(plan.ProtectedAppendWritesAllEnabled),
+				Tags:                          plan.Tags,
+			}
+			if _, err := client.SetLegalHold(ctx, containerId, input); err != nil {
+				return fmt.Errorf("setting Legal Hold for %s: %+v", containerId, err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				oldRaw, newRaw := metadata.ResourceData.GetChange("tags")
+				removed := oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set))
+				if removed.Len() > 0 {
+					clearInput := blobcontainers.LegalHold{
+						Tags: expandStorageContainerLegalHoldTags(removed.List()),
+					}
+					if _, err := client.ClearLegalHold(ctx, containerId, clearInput); err != nil {
+						return fmt.Errorf("clearing removed tags from the Legal Hold for %s: %+v", containerId, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageContainerLegalHoldResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ResourceManager.BlobContainers
+
+			id, err := parse.StorageContainerLegalHoldID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			containerId := id.StorageContainerId()
+
+			var state StorageContainerLegalHoldModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(containerId.ID())
+			defer locks.UnlockByID(containerId.ID())
+
+			// the Legal Hold is removed from the Container once all of its tags have been cleared
+			input := blobcontainers.LegalHold{
+				Tags: state.Tags,
+			}
+			if resp, err := client.ClearLegalHold(ctx, containerId, input); err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("clearing Legal Hold for %s: %+v", containerId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageContainerLegalHoldTags(input []interface{}) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return output
+}
+
+// storageContainerLegalHoldTagFromConfig returns the tag as it was specified in the configuration, since the API doesn't
+// necessarily return tags in the same casing that they were set with.
+func storageContainerLegalHoldTagFromConfig(tag string, configured []string) string {
+	for _, v := range configured {
+		if strings.EqualFold(v, tag) {
+			return v
+		}
+	}
+	return tag
+}
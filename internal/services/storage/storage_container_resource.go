@@ -72,7 +72,7 @@ func resourceStorageContainer() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 
-			// TODO: support for ACL's, Legal Holds and Immutability Policies
+			// TODO: support for ACL's - Legal Holds and Immutability Policies are managed via their own resources
 			"has_immutability_policy": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
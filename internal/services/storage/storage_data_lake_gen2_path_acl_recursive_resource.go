@@ -0,0 +1,571 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/storageaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+	"github.com/tombuildsstuff/giovanni/storage/accesscontrol"
+)
+
+// storageDataLakeGen2MaxFailedEntries is the number of failed entries which are output within the state and any errors,
+// since a recursive operation on a large File System can return a failure for every Path within it
+const storageDataLakeGen2MaxFailedEntries = 50
+
+type StorageDataLakeGen2PathAclRecursiveResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = StorageDataLakeGen2PathAclRecursiveResource{}
+	_ sdk.ResourceWithCustomImporter = StorageDataLakeGen2PathAclRecursiveResource{}
+	_ sdk.ResourceWithCustomizeDiff  = StorageDataLakeGen2PathAclRecursiveResource{}
+)
+
+type StorageDataLakeGen2PathAclRecursiveModel struct {
+	StorageAccountId          string                                           `tfschema:"storage_account_id"`
+	FileSystemName            string                                           `tfschema:"filesystem_name"`
+	Path                      string                                           `tfschema:"path"`
+	Ace                       []StorageDataLakeGen2PathAclRecursiveAce         `tfschema:"ace"`
+	DefaultAclOnly            bool                                             `tfschema:"default_acl_only"`
+	ReplaceExistingAclEnabled bool                                             `tfschema:"replace_existing_acl_enabled"`
+	ContinueOnFailure         bool                                             `tfschema:"continue_on_failure"`
+	DirectoriesSuccessful     int64                                            `tfschema:"directories_successful"`
+	FilesSuccessful           int64                                            `tfschema:"files_successful"`
+	FailureCount              int64                                            `tfschema:"failure_count"`
+	FailedEntries             []StorageDataLakeGen2PathAclRecursiveFailedEntry `tfschema:"failed_entries"`
+}
+
+type StorageDataLakeGen2PathAclRecursiveAce struct {
+	Scope       string `tfschema:"scope"`
+	Type        string `tfschema:"type"`
+	Id          string `tfschema:"id"`
+	Permissions string `tfschema:"permissions"`
+}
+
+type StorageDataLakeGen2PathAclRecursiveFailedEntry struct {
+	Name         string `tfschema:"name"`
+	Type         string `tfschema:"type"`
+	ErrorMessage string `tfschema:"error_message"`
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"filesystem_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"ace": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scope": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default", "access"}, false),
+						Default:      "access",
+					},
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"user", "group", "mask", "other"}, false),
+					},
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+					"permissions": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ADLSAccessControlPermissions,
+					},
+				},
+			},
+		},
+
+		"default_acl_only": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			ForceNew:      true,
+			Default:       false,
+			ConflictsWith: []string{"replace_existing_acl_enabled"},
+		},
+
+		"replace_existing_acl_enabled": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"default_acl_only"},
+		},
+
+		"continue_on_failure": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"directories_successful": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"files_successful": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"failure_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"failed_entries": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"error_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) ResourceType() string {
+	return "azurerm_storage_data_lake_gen2_path_acl_recursive"
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) ModelObject() interface{} {
+	return &StorageDataLakeGen2PathAclRecursiveModel{}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageDataLakeGen2PathDataPlaneID
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var plan StorageDataLakeGen2PathAclRecursiveModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storageAccountId, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			// confirm the storage account exists, otherwise Data Plane API requests will fail
+			account, err := storageClient.ResourceManager.StorageAccounts.GetProperties(ctx, *storageAccountId, storageaccounts.DefaultGetPropertiesOperationOptions())
+			if err != nil {
+				if response.WasNotFound(account.HttpResponse) {
+					return fmt.Errorf("%s was not found", storageAccountId)
+				}
+				return fmt.Errorf("checking for existence of %s: %+v", storageAccountId, err)
+			}
+
+			id := parse.NewStorageDataLakeGen2PathDataPlaneId(storageAccountId.StorageAccountName, storageClient.Environment.StorageEndpointSuffix, plan.FileSystemName, plan.Path)
+
+			existing, err := storageClient.ADLSGen2PathsClient.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetStatus)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("checking for existence of %s: %+v", id, err)
+			}
+
+			mode := accessControlRecursiveModeModify
+			if plan.ReplaceExistingAclEnabled {
+				mode = accessControlRecursiveModeSet
+			}
+			log.Printf("[INFO] Applying ACL entries recursively (mode %q) to %s..", mode, id)
+			result, err := setAccessControlRecursive(ctx, storageClient.ADLSGen2PathsClient, id, mode, expandStorageDataLakeGen2PathAclRecursiveAces(plan.Ace).String(), plan.ContinueOnFailure)
+			if err != nil {
+				return fmt.Errorf("applying ACL entries recursively to %s: %+v", id, err)
+			}
+			if err := checkStorageDataLakeGen2PathAclRecursiveResult(&plan, result); err != nil {
+				return fmt.Errorf("applying ACL entries recursively to %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&plan)
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ADLSGen2PathsClient
+
+			id, err := parse.StorageDataLakeGen2PathDataPlaneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageDataLakeGen2PathAclRecursiveModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// checking every Path below this one would be prohibitively expensive for a large File System, so drift is
+			// detected by comparing the configured entries against the ACL of the top-level Path only
+			resp, err := client.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetAccessControl)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving ACLs for %s: %+v", id, err)
+			}
+
+			acl, err := accesscontrol.ParseACL(resp.ACL)
+			if err != nil {
+				return fmt.Errorf("parsing response ACL %q: %s", resp.ACL, err)
+			}
+
+			state.FileSystemName = id.FileSystemName
+			state.Path = id.Path
+			state.Ace = flattenStorageDataLakeGen2PathAclRecursiveAces(state.Ace, acl, state.DefaultAclOnly)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ADLSGen2PathsClient
+
+			id, err := parse.StorageDataLakeGen2PathDataPlaneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var plan StorageDataLakeGen2PathAclRecursiveModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChanges("ace", "replace_existing_acl_enabled") {
+				return nil
+			}
+
+			// when the ACL is being replaced any entries which have been removed are dropped as part of applying it, otherwise
+			// they need to be removed explicitly, since modifying the ACL leaves any entries which aren't specified as-is
+			if !plan.ReplaceExistingAclEnabled {
+				oldRaw, _ := metadata.ResourceData.GetChange("ace")
+				previous := make([]StorageDataLakeGen2PathAclRecursiveAce, 0)
+				for _, raw := range oldRaw.(*pluginsdk.Set).List() {
+					v := raw.(map[string]interface{})
+					previous = append(previous, StorageDataLakeGen2PathAclRecursiveAce{
+						Scope:       v["scope"].(string),
+						Type:        v["type"].(string),
+						Id:          v["id"].(string),
+						Permissions: v["permissions"].(string),
+					})
+				}
+
+				if removed := storageDataLakeGen2AceRemovalList(previous, plan.Ace); len(removed) > 0 {
+					result, err := setAccessControlRecursive(ctx, client, *id, accessControlRecursiveModeRemove, strings.Join(removed, ","), plan.ContinueOnFailure)
+					if err != nil {
+						return fmt.Errorf("removing ACL entries recursively from %s: %+v", id, err)
+					}
+					if err := checkStorageDataLakeGen2PathAclRecursiveResult(&plan, result); err != nil {
+						return fmt.Errorf("removing ACL entries recursively from %s: %+v", id, err)
+					}
+				}
+			}
+
+			mode := accessControlRecursiveModeModify
+			if plan.ReplaceExistingAclEnabled {
+				mode = accessControlRecursiveModeSet
+			}
+			log.Printf("[INFO] Applying ACL entries recursively (mode %q) to %s..", mode, id)
+			result, err := setAccessControlRecursive(ctx, client, *id, mode, expandStorageDataLakeGen2PathAclRecursiveAces(plan.Ace).String(), plan.ContinueOnFailure)
+			if err != nil {
+				return fmt.Errorf("applying ACL entries recursively to %s: %+v", id, err)
+			}
+			if err := checkStorageDataLakeGen2PathAclRecursiveResult(&plan, result); err != nil {
+				return fmt.Errorf("applying ACL entries recursively to %s: %+v", id, err)
+			}
+
+			return metadata.Encode(&plan)
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.ADLSGen2PathsClient
+
+			id, err := parse.StorageDataLakeGen2PathDataPlaneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageDataLakeGen2PathAclRecursiveModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the entries for the owning user, owning group and others can't be removed, so only the named entries are
+			removed := storageDataLakeGen2AceRemovalList(state.Ace, nil)
+			if len(removed) == 0 {
+				log.Printf("[DEBUG] No named ACL entries to remove from %s", id)
+				return nil
+			}
+
+			result, err := setAccessControlRecursive(ctx, client, *id, accessControlRecursiveModeRemove, strings.Join(removed, ","), state.ContinueOnFailure)
+			if err != nil {
+				if utils.ResponseWasNotFound(result.Response) {
+					return nil
+				}
+				return fmt.Errorf("removing ACL entries recursively from %s: %+v", id, err)
+			}
+			if err := checkStorageDataLakeGen2PathAclRecursiveResult(&state, result); err != nil {
+				return fmt.Errorf("removing ACL entries recursively from %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		id, err := parse.StorageDataLakeGen2PathDataPlaneID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		account, err := metadata.Client.Storage.FindAccount(ctx, id.AccountName)
+		if err != nil {
+			return fmt.Errorf("retrieving Account %q for %s: %+v", id.AccountName, id, err)
+		}
+		if account == nil {
+			return fmt.Errorf("Unable to locate Storage Account %q!", id.AccountName)
+		}
+
+		return metadata.ResourceData.Set("storage_account_id", account.ID)
+	}
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config StorageDataLakeGen2PathAclRecursiveModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !config.DefaultAclOnly {
+				return nil
+			}
+
+			for _, ace := range config.Ace {
+				if ace.Scope != "default" {
+					return fmt.Errorf("every `ace` must have a `scope` of `default` when `default_acl_only` is enabled")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageDataLakeGen2PathAclRecursiveAces(input []StorageDataLakeGen2PathAclRecursiveAce) *accesscontrol.ACL {
+	output := &accesscontrol.ACL{
+		Entries: make([]accesscontrol.ACE, 0),
+	}
+
+	for _, v := range input {
+		var qualifier *uuid.UUID
+		if v.Id != "" {
+			// the `id` is validated as a UUID within the Schema
+			if id, err := uuid.Parse(v.Id); err == nil {
+				qualifier = &id
+			}
+		}
+
+		output.Entries = append(output.Entries, accesscontrol.ACE{
+			IsDefault:    v.Scope == "default",
+			TagType:      accesscontrol.TagType(v.Type),
+			TagQualifier: qualifier,
+			Permissions:  v.Permissions,
+		})
+	}
+
+	return output
+}
+
+// checkStorageDataLakeGen2PathAclRecursiveResult records the outcome of a recursive operation and returns an error
+// containing the failed entries, unless `continue_on_failure` is enabled
+func checkStorageDataLakeGen2PathAclRecursiveResult(model *StorageDataLakeGen2PathAclRecursiveModel, result accessControlRecursiveResult) error {
+	failedEntries := result.FailedEntries
+	if len(failedEntries) > storageDataLakeGen2MaxFailedEntries {
+		failedEntries = failedEntries[0:storageDataLakeGen2MaxFailedEntries]
+	}
+
+	model.DirectoriesSuccessful = result.DirectoriesSuccessful
+	model.FilesSuccessful = result.FilesSuccessful
+	model.FailureCount = result.FailureCount
+	model.FailedEntries = make([]StorageDataLakeGen2PathAclRecursiveFailedEntry, 0)
+	for _, v := range failedEntries {
+		model.FailedEntries = append(model.FailedEntries, StorageDataLakeGen2PathAclRecursiveFailedEntry{
+			Name:         v.Name,
+			Type:         v.Type,
+			ErrorMessage: v.ErrorMessage,
+		})
+	}
+
+	if result.FailureCount == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0)
+	for _, v := range failedEntries {
+		messages = append(messages, fmt.Sprintf("%s %q: %s", v.Type, v.Name, v.ErrorMessage))
+	}
+
+	if model.ContinueOnFailure {
+		log.Printf("[WARN] the ACL couldn't be updated for %d Paths:\n%s", result.FailureCount, strings.Join(messages, "\n"))
+		return nil
+	}
+
+	return fmt.Errorf("the ACL couldn't be updated for %d Paths, processing was stopped at the first failure:\n%s", result.FailureCount, strings.Join(messages, "\n"))
+}
+
+// storageDataLakeGen2AceRemovalList returns the ACL entries (in the format used for removal, which omits the
+// permissions) which are present within `previous` but not within `current`. Entries without an `id` are skipped, since the
+// entries for the owning user, owning group, mask and others can't be removed.
+func storageDataLakeGen2AceRemovalList(previous []StorageDataLakeGen2PathAclRecursiveAce, current []StorageDataLakeGen2PathAclRecursiveAce) []string {
+	keep := make(map[string]struct{})
+	for _, ace := range current {
+		keep[storageDataLakeGen2AceRemovalString(ace)] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for _, ace := range previous {
+		if ace.Id == "" {
+			continue
+		}
+
+		key := storageDataLakeGen2AceRemovalString(ace)
+		if _, ok := keep[key]; ok {
+			continue
+		}
+		output = append(output, key)
+	}
+
+	return output
+}
+
+func storageDataLakeGen2AceRemovalString(ace StorageDataLakeGen2PathAclRecursiveAce) string {
+	output := fmt.Sprintf("%s:%s", ace.Type, strings.ToLower(ace.Id))
+	if ace.Scope == "default" {
+		output = "default:" + output
+	}
+	return output
+}
+
+// flattenStorageDataLakeGen2PathAclRecursiveAces returns the configured entries which are present within the ACL,
+// such that any entry which has been removed or changed is re-applied. When no entries are configured (for example
+// when importing) all of the named entries are returned.
+func flattenStorageDataLakeGen2PathAclRecursiveAces(configured []StorageDataLakeGen2PathAclRecursiveAce, acl accesscontrol.ACL, defaultOnly bool) []StorageDataLakeGen2PathAclRecursiveAce {
+	output := make([]StorageDataLakeGen2PathAclRecursiveAce, 0)
+
+	for _, v := range acl.Entries {
+		if defaultOnly && !v.IsDefault {
+			continue
+		}
+
+		ace := StorageDataLakeGen2PathAclRecursiveAce{
+			Scope:       "access",
+			Type:        string(v.TagType),
+			Permissions: v.Permissions,
+		}
+		if v.IsDefault {
+			ace.Scope = "default"
+		}
+		if v.TagQualifier != nil {
+			ace.Id = v.TagQualifier.String()
+		}
+
+		if len(configured) == 0 {
+			if ace.Id != "" {
+				output = append(output, ace)
+			}
+			continue
+		}
+
+		for _, existing := range configured {
+			if existing.Scope == ace.Scope && existing.Type == ace.Type && strings.EqualFold(existing.Id, ace.Id) {
+				// retain the casing of the configured `id`
+				ace.Id = existing.Id
+				output = append(output, ace)
+				break
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+)
+
+type StorageDataLakeGen2PathAclRecursiveResource struct{}
+
+func TestAccStorageDataLakeGen2PathAclRecursive_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl_recursive", "test")
+	r := StorageDataLakeGen2PathAclRecursiveResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("directories_successful").HasValue("2"),
+				check.That(data.ResourceName).Key("failure_count").HasValue("0"),
+			),
+		},
+		data.ImportStep("directories_successful", "files_successful", "failure_count", "failed_entries"),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathAclRecursive_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl_recursive", "test")
+	r := StorageDataLakeGen2PathAclRecursiveResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("directories_successful", "files_successful", "failure_count", "failed_entries"),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("directories_successful", "files_successful", "failure_count", "failed_entries"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("directories_successful", "files_successful", "failure_count", "failed_entries"),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathAclRecursive_defaultAclOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl_recursive", "test")
+	r := StorageDataLakeGen2PathAclRecursiveResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultAclOnly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ace.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccStorageDataLakeGen2PathAclRecursive_replaceExistingAcl(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_acl_recursive", "test")
+	r := StorageDataLakeGen2PathAclRecursiveResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.replaceExistingAcl(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageDataLakeGen2PathDataPlaneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Storage.ADLSGen2PathsClient.GetProperties(ctx, id.AccountName, id.FileSystemName, id.Path, paths.GetPropertiesActionGetAccessControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving ACLs for %s: %+v", id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl_recursive" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl_recursive" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "rwx"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) defaultAclOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl_recursive" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+  default_acl_only   = true
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) replaceExistingAcl(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_acl_recursive" "test" {
+  storage_account_id           = azurerm_storage_account.test.id
+  filesystem_name              = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path                         = azurerm_storage_data_lake_gen2_path.parent.path
+  replace_existing_acl_enabled = true
+  continue_on_failure          = true
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "user"
+    id          = azuread_service_principal.test.object_id
+    permissions = "r-x"
+  }
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "mask"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+
+  depends_on = [azurerm_storage_data_lake_gen2_path.child]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathAclRecursiveResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestspa%[2]d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "parent" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "parent"
+  resource           = "directory"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.parent.path}/child"
+  resource           = "directory"
+}
+`, StorageDataLakeGen2PathResource{}.template(data), data.RandomInteger)
+}
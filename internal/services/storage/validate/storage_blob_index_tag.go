@@ -24,3 +24,25 @@ func StorageBlobIndexTagValue(v interface{}, k string) (warnings []string, error
 	}
 	return warnings, errors
 }
+
+func StorageBlobIndexTags(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a map", k))
+		return warnings, errors
+	}
+
+	if len(value) > 10 {
+		errors = append(errors, fmt.Errorf("%q can contain at most 10 tags, got %d", k, len(value)))
+	}
+
+	for name, tagValue := range value {
+		_, nameErrors := StorageBlobIndexTagName(name, fmt.Sprintf("%s.%s", k, name))
+		errors = append(errors, nameErrors...)
+
+		_, valueErrors := StorageBlobIndexTagValue(tagValue, fmt.Sprintf("%s.%s", k, name))
+		errors = append(errors, valueErrors...)
+	}
+
+	return warnings, errors
+}
@@ -4,6 +4,7 @@
 package validate
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 )
@@ -54,3 +55,49 @@ func TestStorageBlobIndexTagValue(t *testing.T) {
 		}
 	}
 }
+
+func TestStorageBlobIndexTags(t *testing.T) {
+	tooMany := make(map[string]interface{})
+	for i := 0; i < 11; i++ {
+		tooMany[fmt.Sprintf("tag%d", i)] = "value"
+	}
+
+	testData := []struct {
+		input map[string]interface{}
+		valid bool
+	}{
+		{
+			input: map[string]interface{}{},
+			valid: true,
+		},
+		{
+			input: map[string]interface{}{
+				"project":     "example",
+				"environment": "",
+			},
+			valid: true,
+		},
+		{
+			input: map[string]interface{}{
+				"project": strings.Repeat("w", 257),
+			},
+			valid: false,
+		},
+		{
+			input: map[string]interface{}{
+				strings.Repeat("w", 129): "example",
+			},
+			valid: false,
+		},
+		{
+			input: tooMany,
+			valid: false,
+		},
+	}
+	for _, v := range testData {
+		_, errors := StorageBlobIndexTags(v.input, "index_tags")
+		if valid := len(errors) == 0; valid != v.valid {
+			t.Fatalf("expected %+v to be valid %t but got %t: %+v", v.input, v.valid, valid, errors)
+		}
+	}
+}
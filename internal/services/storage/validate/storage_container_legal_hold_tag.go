@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func StorageContainerLegalHoldTag(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("only alphanumeric characters are allowed in %q", k))
+	}
+
+	if len(value) < 3 || len(value) > 23 {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 23 characters", k))
+	}
+
+	return warnings, errors
+}
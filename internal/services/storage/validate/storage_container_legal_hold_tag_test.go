@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageContainerLegalHoldTag(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "tag1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "CaseNumber123",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ab",
+			ErrCount: 1,
+		},
+		{
+			Value:    strings.Repeat("a", 23),
+			ErrCount: 0,
+		},
+		{
+			Value:    strings.Repeat("a", 24),
+			ErrCount: 1,
+		},
+		{
+			Value:    "case-123",
+			ErrCount: 1,
+		},
+		{
+			Value:    "a_",
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := StorageContainerLegalHoldTag(tc.Value, "tags")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d errors for the Legal Hold Tag %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
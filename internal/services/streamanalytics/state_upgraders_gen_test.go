@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package streamanalytics_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestStreamAnalyticsCluster_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_cluster")
+}
+
+func TestStreamAnalyticsFunctionJavascriptUda_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_function_javascript_uda")
+}
+
+func TestStreamAnalyticsFunctionJavascriptUdf_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_function_javascript_udf")
+}
+
+func TestStreamAnalyticsJob_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_job")
+}
+
+func TestStreamAnalyticsJobSchedule_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_job_schedule")
+}
+
+func TestStreamAnalyticsManagedPrivateEndpoint_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_managed_private_endpoint")
+}
+
+func TestStreamAnalyticsOutputBlob_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_blob")
+}
+
+func TestStreamAnalyticsOutputCosmosdb_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_cosmosdb")
+}
+
+func TestStreamAnalyticsOutputEventhub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_eventhub")
+}
+
+func TestStreamAnalyticsOutputFunction_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_function")
+}
+
+func TestStreamAnalyticsOutputMssql_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_mssql")
+}
+
+func TestStreamAnalyticsOutputPowerbi_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_powerbi")
+}
+
+func TestStreamAnalyticsOutputServicebusQueue_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_servicebus_queue")
+}
+
+func TestStreamAnalyticsOutputServicebusTopic_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_servicebus_topic")
+}
+
+func TestStreamAnalyticsOutputSynapse_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_synapse")
+}
+
+func TestStreamAnalyticsOutputTable_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_output_table")
+}
+
+func TestStreamAnalyticsReferenceInputBlob_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_reference_input_blob")
+}
+
+func TestStreamAnalyticsReferenceInputMssql_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_reference_input_mssql")
+}
+
+func TestStreamAnalyticsStreamInputBlob_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_stream_input_blob")
+}
+
+func TestStreamAnalyticsStreamInputEventhub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_stream_input_eventhub")
+}
+
+func TestStreamAnalyticsStreamInputEventhubV2_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_stream_input_eventhub_v2")
+}
+
+func TestStreamAnalyticsStreamInputIothub_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, streamanalytics.Registration{}, "azurerm_stream_analytics_stream_input_iothub")
+}
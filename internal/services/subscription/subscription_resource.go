@@ -503,14 +503,14 @@ func checkExistingAliases(ctx context.Context, client subscriptionAlias.Subscrip
 		return nil, 0, fmt.Errorf("could not List existing Subscription Aliases")
 	}
 
-	if aliasList.Model == nil || aliasList.Model.Value == nil {
+	if aliasList.Model == nil {
 		return nil, 0, fmt.Errorf("failed reading Subscription Alias list")
 	}
 
-	for _, v := range *aliasList.Model.Value {
+	for _, v := range *aliasList.Model {
 		if v.Properties != nil && v.Properties.SubscriptionId != nil && subscriptionId == *v.Properties.SubscriptionId {
-			return v.Name, len(*aliasList.Model.Value), nil
+			return v.Name, len(*aliasList.Model), nil
 		}
 	}
-	return nil, len(*aliasList.Model.Value), nil
+	return nil, len(*aliasList.Model), nil
 }
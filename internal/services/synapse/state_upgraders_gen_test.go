@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package synapse_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+)
+
+func TestSynapseIntegrationRuntimeAzure_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, synapse.Registration{}, "azurerm_synapse_integration_runtime_azure")
+}
+
+func TestSynapseIntegrationRuntimeSelfHosted_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, synapse.Registration{}, "azurerm_synapse_integration_runtime_self_hosted")
+}
+
+func TestSynapseLinkedService_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, synapse.Registration{}, "azurerm_synapse_linked_service")
+}
+
+func TestSynapseRoleAssignment_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, synapse.Registration{}, "azurerm_synapse_role_assignment")
+}
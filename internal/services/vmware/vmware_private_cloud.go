@@ -8,7 +8,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-func flattenPrivateCloudManagementCluster(input privateclouds.CommonClusterProperties) []interface{} {
+func flattenPrivateCloudManagementCluster(input *privateclouds.CommonClusterProperties) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"size":  input.ClusterSize,
@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateupgrades
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// defaultResourceId is used as the `id` within the synthetic state when an example Resource ID isn't
+// specified - and should be updated within the Snapshot as required.
+const defaultResourceId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group"
+
+// exampleSubscriptionId is the Subscription ID used within the Provider Meta passed to the State Upgraders, matching
+// the Subscription ID used within the example Resource IDs
+const exampleSubscriptionId = "12345678-1234-9876-4563-123456789012"
+
+// Snapshot is a point-in-time record of the Schema for each Schema Version of a Resource, alongside
+// the state used as the input to the State Upgraders for each historical Schema Version.
+type Snapshot struct {
+	ResourceType string `json:"resourceType"`
+
+	// SchemaVersion is the Schema Version of the Resource at the time the Snapshot was generated
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Schemas is a map of the Schema Version to the Type implied by the Schema at that Schema Version
+	Schemas map[int]json.RawMessage `json:"schemas"`
+
+	// States is a map of the Schema Version to the (synthetic) state which is fed through the State Upgraders
+	// from that Schema Version - these can be updated by hand where a State Upgrader requires specific values.
+	States map[int]map[string]interface{} `json:"states"`
+
+	// SkipStateUpgradeReason is set by hand when the State Upgraders can't be run offline (for example because
+	// they call the Azure API), in which case only the Schemas are verified - this is retained when regenerating.
+	SkipStateUpgradeReason string `json:"skipStateUpgradeReason,omitempty"`
+
+	// DroppedAttributes is a map of the Schema Version to the path of each attribute which is expected to be dropped
+	// from the upgraded state, since it's no longer present in the current Schema - these are set by hand, since by
+	// default every attribute within the upgraded state must be present in the current Schema.
+	DroppedAttributes map[int][]string `json:"droppedAttributes,omitempty"`
+}
+
+// SnapshotPath returns the path to the Snapshot for the specified Resource Type, relative to the Service Package
+func SnapshotPath(resourceType string) string {
+	return filepath.Join("testdata", "state_upgraders", fmt.Sprintf("%s.json", resourceType))
+}
+
+// LoadSnapshot loads the Snapshot at the specified path
+func LoadSnapshot(path string) (*Snapshot, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(contents, &snapshot); err != nil {
+		return nil, fmt.Errorf("unmarshaling %q: %+v", path, err)
+	}
+
+	return &snapshot, nil
+}
+
+// Save writes the Snapshot to the specified path
+func (s Snapshot) Save(path string) error {
+	contents, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the Snapshot for %q: %+v", s.ResourceType, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating the directory for %q: %+v", path, err)
+	}
+
+	return os.WriteFile(path, append(contents, '\n'), 0644)
+}
+
+// BuildSnapshot builds a Snapshot for the Resource's State Upgraders.
+//
+// Since the Schemas within the Snapshot are a historical record, when an existing Snapshot is specified the
+// Schemas and States for previous Schema Versions are retained - and only the Schema for the current Schema
+// Version (and any missing Schema Versions) are populated from the Resource.
+//
+// The exampleResourceId is used as the `id` within the synthetic state, and should be an example of the
+// Resource ID which can be imported by the Resource (when known).
+func BuildSnapshot(resourceType string, resource *pluginsdk.Resource, exampleResourceId string, existing *Snapshot) (*Snapshot, error) {
+	if len(resource.StateUpgraders) == 0 {
+		return nil, fmt.Errorf("%q has no State Upgraders", resourceType)
+	}
+
+	output := Snapshot{
+		ResourceType:  resourceType,
+		SchemaVersion: resource.SchemaVersion,
+		Schemas:       map[int]json.RawMessage{},
+		States:        map[int]map[string]interface{}{},
+	}
+	if existing != nil {
+		output.SkipStateUpgradeReason = existing.SkipStateUpgradeReason
+		output.DroppedAttributes = existing.DroppedAttributes
+		for version, v := range existing.Schemas {
+			if version < resource.SchemaVersion {
+				output.Schemas[version] = v
+			}
+		}
+		for version, v := range existing.States {
+			if version < resource.SchemaVersion {
+				output.States[version] = v
+			}
+		}
+	}
+
+	resourceId := exampleResourceId
+	if resourceId == "" {
+		resourceId = defaultResourceId
+	}
+
+	for _, upgrader := range resource.StateUpgraders {
+		if _, ok := output.Schemas[upgrader.Version]; !ok {
+			impliedType, err := ctyjson.MarshalType(upgrader.Type)
+			if err != nil {
+				return nil, fmt.Errorf("marshaling the Schema for Version %d: %+v", upgrader.Version, err)
+			}
+			output.Schemas[upgrader.Version] = impliedType
+		}
+
+		if _, ok := output.States[upgrader.Version]; !ok {
+			state, ok := syntheticValue("", upgrader.Type, resourceId).(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("the Schema for Version %d isn't an object", upgrader.Version)
+			}
+			output.States[upgrader.Version] = state
+		}
+	}
+
+	// the current Schema Version is recorded so that the Schema used by the next State Upgrader can be checked against it
+	impliedType, err := ctyjson.MarshalType(impliedTypeForSchema(resource.Schema))
+	if err != nil {
+		return nil, fmt.Errorf("marshaling the Schema for Version %d: %+v", resource.SchemaVersion, err)
+	}
+	output.Schemas[resource.SchemaVersion] = impliedType
+
+	return &output, nil
+}
+
+// Verify checks that the Schema used by each State Upgrader matches the Snapshot, and that the state for each
+// historical Schema Version can be upgraded through the State Upgraders into a state matching the current Schema
+// (unless the Snapshot specifies the reason this can't be done offline).
+func (s Snapshot) Verify(resource *pluginsdk.Resource) []error {
+	errs := make([]error, 0)
+
+	if resource.SchemaVersion < s.SchemaVersion {
+		errs = append(errs, fmt.Errorf("the Schema Version %d is older than the Schema Version %d within the Snapshot", resource.SchemaVersion, s.SchemaVersion))
+	}
+
+	for _, upgrader := range resource.StateUpgraders {
+		raw, ok := s.Schemas[upgrader.Version]
+		if !ok {
+			errs = append(errs, fmt.Errorf("the Snapshot doesn't contain the Schema for Version %d - regenerate the Snapshot", upgrader.Version))
+			continue
+		}
+
+		expected, err := ctyjson.UnmarshalType(raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unmarshaling the Schema for Version %d: %+v", upgrader.Version, err))
+			continue
+		}
+
+		if !upgrader.Type.Equals(expected) {
+			errs = append(errs, fmt.Errorf("the Schema used by the State Upgrader for Version %d doesn't match the Schema for Version %d within the Snapshot:\n\n%s", upgrader.Version, upgrader.Version, describeTypeDifferences(expected, upgrader.Type)))
+		}
+	}
+
+	if s.SkipStateUpgradeReason != "" {
+		return errs
+	}
+
+	versions := make([]int, 0)
+	for version := range s.States {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+	for _, version := range versions {
+		if version >= resource.SchemaVersion {
+			continue
+		}
+		dropped, err := UpgradeState(s.ResourceType, resource, version, s.States[version])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("upgrading the state from Version %d: %+v", version, err))
+			continue
+		}
+
+		// Terraform removes any attributes which aren't present in the current Schema from the upgraded state, so
+		// any attributes which the State Upgraders fail to rename or remove would otherwise be silently dropped
+		expected := append([]string{}, s.DroppedAttributes[version]...)
+		sort.Strings(expected)
+		if strings.Join(dropped, ",") != strings.Join(expected, ",") {
+			errs = append(errs, fmt.Errorf("upgrading the state from Version %d: expected the attributes [%s] to be dropped from the upgraded state since they aren't present in the current Schema, but got [%s]", version, strings.Join(expected, ", "), strings.Join(dropped, ", ")))
+		}
+	}
+
+	return errs
+}
+
+// UpgradeState runs the state through the State Upgraders from the specified Schema Version in the same manner
+// as Terraform - returning an error if any of the State Upgraders fail, or the upgraded state doesn't match the
+// current Schema. The path to each attribute which Terraform drops from the upgraded state (since it isn't present
+// within the current Schema) is returned.
+func UpgradeState(resourceType string, resource *pluginsdk.Resource, version int, state map[string]interface{}) (dropped []string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %+v", r)
+		}
+	}()
+
+	rawState, err := json.Marshal(state)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling the state: %+v", err)
+	}
+
+	// a Provider is required to be able to use the same logic as Terraform, rather than reimplementing it
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			resourceType: resource,
+		},
+	}
+	meta := syntheticMeta()
+	provider.SetMeta(meta)
+	resp, err := schema.NewGRPCProviderServer(provider).UpgradeResourceState(context.Background(), &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: resourceType,
+		Version:  int64(version),
+		RawState: &tfprotov5.RawState{
+			JSON: rawState,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diags := make([]string, 0)
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov5.DiagnosticSeverityError {
+			diags = append(diags, fmt.Sprintf("%s: %s", diag.Summary, diag.Detail))
+		}
+	}
+	if len(diags) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(diags, "\n"))
+	}
+	if resp.UpgradedState == nil {
+		return nil, fmt.Errorf("no state was returned")
+	}
+
+	// prior to decoding the upgraded state Terraform removes any attributes which aren't present in the current Schema,
+	// as such the State Upgraders are run again to determine which attributes are dropped from the upgraded state
+	upgraded := make(map[string]interface{})
+	if err := json.Unmarshal(rawState, &upgraded); err != nil {
+		return nil, fmt.Errorf("unmarshaling the state: %+v", err)
+	}
+	for _, upgrader := range resource.StateUpgraders {
+		if upgrader.Version != version {
+			continue
+		}
+		if upgraded, err = upgrader.Upgrade(context.Background(), upgraded, meta); err != nil {
+			return nil, fmt.Errorf("upgrading the state from Version %d: %+v", upgrader.Version, err)
+		}
+		version++
+	}
+
+	dropped = droppedAttributes("", upgraded, resource.CoreConfigSchema().ImpliedType())
+	sort.Strings(dropped)
+	return dropped, nil
+}
+
+// droppedAttributes returns the path to each attribute within the state which isn't present within the Type, in the
+// same manner as Terraform removes these attributes from the upgraded state
+func droppedAttributes(path string, input interface{}, ty cty.Type) []string {
+	output := make([]string, 0)
+
+	switch v := input.(type) {
+	case []interface{}:
+		if ty.IsListType() || ty.IsSetType() {
+			for i, item := range v {
+				output = append(output, droppedAttributes(fmt.Sprintf("%s.%d", path, i), item, ty.ElementType())...)
+			}
+		}
+
+	case map[string]interface{}:
+		if ty.IsMapType() {
+			for key, item := range v {
+				output = append(output, droppedAttributes(fmt.Sprintf("%s.%s", path, key), item, ty.ElementType())...)
+			}
+			return output
+		}
+		if !ty.IsObjectType() {
+			return output
+		}
+
+		for key, item := range v {
+			attributePath := strings.TrimPrefix(fmt.Sprintf("%s.%s", path, key), ".")
+			if !ty.HasAttribute(key) {
+				output = append(output, attributePath)
+				continue
+			}
+			output = append(output, droppedAttributes(attributePath, item, ty.AttributeType(key))...)
+		}
+	}
+
+	return output
+}
+
+// syntheticMeta returns the Provider Meta passed to the State Upgraders, which contains the details of the Account
+// used by some State Upgraders to build Resource IDs - but no API Clients, since the State Upgraders are run offline
+func syntheticMeta() *clients.Client {
+	return &clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			Environment:      *environments.AzurePublic(),
+			AzureEnvironment: azure.PublicCloud,
+			SubscriptionId:   exampleSubscriptionId,
+		},
+		StopContext: context.Background(),
+	}
+}
+
+// impliedTypeForSchema returns the Type implied by the Schema, in the same manner as `pluginsdk.StateUpgrades`
+func impliedTypeForSchema(input map[string]*pluginsdk.Schema) cty.Type {
+	resource := pluginsdk.Resource{
+		Schema: input,
+	}
+	return resource.CoreConfigSchema().ImpliedType()
+}
+
+// syntheticValue returns a value shaped like the Type, as it'd be decoded from the JSON state
+func syntheticValue(name string, input cty.Type, resourceId string) interface{} {
+	switch {
+	case input == cty.String:
+		if name == "id" {
+			return resourceId
+		}
+		return "example"
+
+	case input == cty.Number:
+		return 1
+
+	case input == cty.Bool:
+		return true
+
+	case input.IsListType(), input.IsSetType():
+		return []interface{}{
+			syntheticValue(name, input.ElementType(), resourceId),
+		}
+
+	case input.IsMapType():
+		return map[string]interface{}{
+			"example": syntheticValue(name, input.ElementType(), resourceId),
+		}
+
+	case input.IsObjectType():
+		output := make(map[string]interface{})
+		for attributeName, attributeType := range input.AttributeTypes() {
+			output[attributeName] = syntheticValue(attributeName, attributeType, resourceId)
+		}
+		return output
+	}
+
+	return nil
+}
+
+// describeTypeDifferences returns a summary of the top-level attributes which differ between the two Types
+func describeTypeDifferences(expected, actual cty.Type) string {
+	if !expected.IsObjectType() || !actual.IsObjectType() {
+		return fmt.Sprintf("expected %s but got %s", expected.FriendlyName(), actual.FriendlyName())
+	}
+
+	lines := make([]string, 0)
+	for name, expectedType := range expected.AttributeTypes() {
+		if !actual.HasAttribute(name) {
+			lines = append(lines, fmt.Sprintf("* `%s` was removed", name))
+			continue
+		}
+		if actualType := actual.AttributeType(name); !actualType.Equals(expectedType) {
+			lines = append(lines, fmt.Sprintf("* `%s` changed from %s to %s", name, expectedType.FriendlyName(), actualType.FriendlyName()))
+		}
+	}
+	for name := range actual.AttributeTypes() {
+		if !expected.HasAttribute(name) {
+			lines = append(lines, fmt.Sprintf("* `%s` was added", name))
+		}
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
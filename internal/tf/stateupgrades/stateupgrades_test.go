@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateupgrades
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type exampleV0ToV1 struct {
+	sizeType pluginsdk.ValueType
+}
+
+func (u exampleV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"size": {
+			Type:     u.sizeType,
+			Optional: true,
+		},
+	}
+}
+
+func (exampleV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if _, ok := rawState["id"].(string); !ok {
+			return rawState, fmt.Errorf("expected `id` to be a string")
+		}
+
+		rawState["size_in_gb"] = rawState["size"]
+		delete(rawState, "size")
+		return rawState, nil
+	}
+}
+
+func exampleResource(sizeType pluginsdk.ValueType, sizeInGbType pluginsdk.ValueType) *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		SchemaVersion: 1,
+		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: exampleV0ToV1{sizeType: sizeType},
+		}),
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"size_in_gb": {
+				Type:     sizeInGbType,
+				Optional: true,
+			},
+
+			"tags": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func TestSnapshotVerify(t *testing.T) {
+	resource := exampleResource(pluginsdk.TypeInt, pluginsdk.TypeInt)
+	snapshot, err := BuildSnapshot("azurerm_example", resource, "", nil)
+	if err != nil {
+		t.Fatalf("building the Snapshot: %+v", err)
+	}
+
+	if _, ok := snapshot.Schemas[0]; !ok {
+		t.Fatalf("expected the Schema for Version 0 to be recorded")
+	}
+	if _, ok := snapshot.Schemas[1]; !ok {
+		t.Fatalf("expected the Schema for Version 1 to be recorded")
+	}
+	if id := snapshot.States[0]["id"]; id != defaultResourceId {
+		t.Fatalf("expected the `id` to be %q but got %q", defaultResourceId, id)
+	}
+
+	path := filepath.Join(t.TempDir(), SnapshotPath("azurerm_example"))
+	if err := snapshot.Save(path); err != nil {
+		t.Fatalf("saving the Snapshot: %+v", err)
+	}
+	snapshot, err = LoadSnapshot(path)
+	if err != nil {
+		t.Fatalf("loading the Snapshot: %+v", err)
+	}
+
+	if errs := snapshot.Verify(resource); len(errs) > 0 {
+		t.Fatalf("expected no errors but got %+v", errs)
+	}
+
+	// the Schema used by the State Upgrader no longer matches the Schema recorded for Version 0
+	if errs := snapshot.Verify(exampleResource(pluginsdk.TypeString, pluginsdk.TypeInt)); len(errs) != 1 {
+		t.Fatalf("expected 1 error when the historical Schema changes but got %+v", errs)
+	}
+
+	// the upgraded state no longer matches the current Schema
+	if errs := snapshot.Verify(exampleResource(pluginsdk.TypeInt, pluginsdk.TypeBool)); len(errs) != 1 {
+		t.Fatalf("expected 1 error when the upgraded state doesn't match the current Schema but got %+v", errs)
+	}
+
+	// the upgraded state contains an attribute which isn't present in the current Schema
+	removed := exampleResource(pluginsdk.TypeInt, pluginsdk.TypeInt)
+	delete(removed.Schema, "size_in_gb")
+	if errs := snapshot.Verify(removed); len(errs) != 1 || !strings.Contains(errs[0].Error(), "[size_in_gb]") {
+		t.Fatalf("expected 1 error when an attribute would be dropped from the upgraded state but got %+v", errs)
+	}
+
+	// unless the attribute is expected to be dropped
+	snapshot.DroppedAttributes = map[int][]string{
+		0: {"size_in_gb"},
+	}
+	if errs := snapshot.Verify(removed); len(errs) > 0 {
+		t.Fatalf("expected no errors when the dropped attribute is expected but got %+v", errs)
+	}
+	if errs := snapshot.Verify(resource); len(errs) != 1 {
+		t.Fatalf("expected 1 error when an expected attribute isn't dropped but got %+v", errs)
+	}
+}
+
+func TestBuildSnapshotRetainsHistoricalSchemas(t *testing.T) {
+	existing, err := BuildSnapshot("azurerm_example", exampleResource(pluginsdk.TypeInt, pluginsdk.TypeInt), "", nil)
+	if err != nil {
+		t.Fatalf("building the Snapshot: %+v", err)
+	}
+	existing.States[0]["name"] = "updated-by-hand"
+
+	snapshot, err := BuildSnapshot("azurerm_example", exampleResource(pluginsdk.TypeString, pluginsdk.TypeInt), "", existing)
+	if err != nil {
+		t.Fatalf("building the Snapshot: %+v", err)
+	}
+
+	if string(snapshot.Schemas[0]) != string(existing.Schemas[0]) {
+		t.Fatalf("expected the Schema for Version 0 to be retained but got %s", string(snapshot.Schemas[0]))
+	}
+	if snapshot.States[0]["name"] != "updated-by-hand" {
+		t.Fatalf("expected the State for Version 0 to be retained but got %+v", snapshot.States[0])
+	}
+}
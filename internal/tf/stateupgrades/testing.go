@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package stateupgrades
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Test verifies the State Upgraders for the Resource Type registered within the Service Registration
+// against the Snapshot within the `testdata` directory of the Service Package.
+func Test(t *testing.T, registration interface{}, resourceType string) {
+	resource, err := ResourceFromRegistration(registration, resourceType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := LoadSnapshot(SnapshotPath(resourceType))
+	if err != nil {
+		t.Fatalf("loading the Snapshot for %q: %+v", resourceType, err)
+	}
+
+	for _, err := range snapshot.Verify(resource) {
+		t.Error(err)
+	}
+}
+
+// ResourceFromRegistration returns the Resource for the Resource Type from the Typed or Untyped Service Registration
+func ResourceFromRegistration(registration interface{}, resourceType string) (*pluginsdk.Resource, error) {
+	if v, ok := registration.(sdk.UntypedServiceRegistration); ok {
+		if resource, ok := v.SupportedResources()[resourceType]; ok {
+			return resource, nil
+		}
+	}
+
+	if v, ok := registration.(sdk.TypedServiceRegistration); ok {
+		for _, r := range v.Resources() {
+			if r.ResourceType() != resourceType {
+				continue
+			}
+
+			wrapper := sdk.NewResourceWrapper(r)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Resource %q: %+v", resourceType, err)
+			}
+			return resource, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the Resource %q was not registered", resourceType)
+}
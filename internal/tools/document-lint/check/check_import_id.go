@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/importids"
+)
+
+// importIdDiff the import example in the document can't be parsed by the resource's own ID parser
+type importIdDiff struct {
+	checkBase
+	Import  model.Import
+	Example string // generated from the type of Resource ID, empty if it can't be generated
+	Err     error
+}
+
+func newImportIdDiff(checkBase checkBase, imp model.Import, example string, err error) *importIdDiff {
+	return &importIdDiff{
+		checkBase: checkBase,
+		Import:    imp,
+		Example:   example,
+		Err:       err,
+	}
+}
+
+// ShouldSkip import examples are not a property of the document, so there is no mdField
+func (i importIdDiff) ShouldSkip() bool {
+	return i.line == 0
+}
+
+func (i importIdDiff) String() string {
+	msg := strings.SplitN(i.Err.Error(), "\n", 2)[0]
+	if i.Example == "" {
+		return fmt.Sprintf("%d the import example ID %s is invalid: %s", i.Line()+1, util.FormatCode(i.Import.ResourceID), msg)
+	}
+	return fmt.Sprintf("%d the import example ID %s is invalid: %s, it should be like %s", i.Line()+1,
+		util.FormatCode(i.Import.ResourceID), msg, util.FormatCode(i.Example))
+}
+
+func (i importIdDiff) Fix(line string) (result string, err error) {
+	if i.Example == "" || i.Import.ResourceID == "" {
+		return line, nil
+	}
+	return strings.Replace(line, i.Import.ResourceID, i.Example, 1), nil
+}
+
+var _ Checker = (*importIdDiff)(nil)
+
+// importIds is the Resource ID used to import each Resource, which is populated when first used
+var importIds map[string]importids.Resource
+
+// diffImportId checks the import example in the document can be parsed by the ID Validation Function of the resource
+func diffImportId(r *schema.Resource, md *model.ResourceDoc) (res []Checker) {
+	if r.Schema == nil || r.Schema.Importer == nil || md.Import.Line == 0 {
+		return
+	}
+
+	if importIds == nil {
+		importIds = importids.Resources()
+	}
+	importId, ok := importIds[r.ResourceType]
+	if !ok {
+		// custom importers can't be checked
+		return
+	}
+
+	// a placeholder for the scope (e.g. `{resource}/providers/Microsoft.Authorization/policyAssignments/assignment1`)
+	// is described within the document, and can't be represented by an example Resource ID
+	if strings.HasPrefix(md.Import.ResourceID, "{") {
+		return
+	}
+
+	err := importId.ValidateID(md.Import.ResourceID)
+	if err == nil {
+		return
+	}
+	example, _ := importId.ExampleID()
+	res = append(res, *newImportIdDiff(newCheckBase(md.Import.Line, "", nil), md.Import, example, err))
+	return
+}
@@ -91,4 +91,7 @@ func (r *ResourceDiff) DiffAll() {
 
 	timeouts := diffTimeout(r.tf, r.md)
 	r.Diff = append(r.Diff, timeouts...)
+
+	importIds := diffImportId(r.tf, r.md)
+	r.Diff = append(r.Diff, importIds...)
 }
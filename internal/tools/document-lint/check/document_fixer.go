@@ -123,6 +123,12 @@ func (f *Fixer) TryFix() (err error) {
 			return err
 		}
 
+		// the import example is a shell command, so should not end with a period
+		if _, ok := item.(importIdDiff); ok {
+			lines[lineIdx] = line
+			continue
+		}
+
 		if suf := strings.TrimSuffix(line, " "); suf != "" {
 			if ch := suf[len(suf)-1]; ch != '.' && ch != '?' {
 				line = suf + "."
@@ -6,6 +6,7 @@ package md
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"unicode"
 
@@ -372,6 +373,33 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 			doc.SetTimeout(item.FromLine, item.content())
 		}
 	}
+	doc.Import = m.importExample()
 
 	return doc
 }
+
+var importExampleReg = regexp.MustCompile(`^\s*terraform import (azurerm_\w+)\.\S+\s+(\S+)\s*$`)
+
+// importExample finds the first `terraform import` command within the Import part of the document
+func (m *Mark) importExample() (res model.Import) {
+	if m.content == nil {
+		return
+	}
+	inImport := false
+	for idx, line := range strings.Split(*m.content, "\n") {
+		if strings.HasPrefix(line, "##") {
+			inImport = strings.Contains(line, "Import")
+			continue
+		}
+		if !inImport {
+			continue
+		}
+		if matches := importExampleReg.FindStringSubmatch(line); len(matches) == 3 {
+			res.Line = idx
+			res.ResourceType = matches[1]
+			res.ResourceID = strings.Trim(matches[2], `"'`)
+			return res
+		}
+	}
+	return
+}
@@ -38,3 +38,27 @@ func Test_unmarshalFile(t *testing.T) {
 
 	}
 }
+
+func Test_importExample(t *testing.T) {
+	args := []struct {
+		file         string
+		line         int
+		resourceType string
+		resourceID   string
+	}{
+		{"key_vault.html.markdown", 177, "azurerm_key_vault", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.KeyVault/vaults/vault1"},
+		{"media_transform.html.markdown", 887, "azurerm_media_transform", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Media/mediaServices/media1/transforms/transform1"},
+	}
+	for _, arg := range args {
+		doc := MustNewMarkFromFile(filepath.Join(testDir, arg.file)).BuildResourceDoc()
+		if doc.Import.Line != arg.line {
+			t.Fatalf("`%s` expect import line: %d, got: %d", arg.file, arg.line, doc.Import.Line)
+		}
+		if doc.Import.ResourceType != arg.resourceType {
+			t.Fatalf("`%s` expect import resource type: %s, got: %s", arg.file, arg.resourceType, doc.Import.ResourceType)
+		}
+		if doc.Import.ResourceID != arg.resourceID {
+			t.Fatalf("`%s` expect import resource id: %s, got: %s", arg.file, arg.resourceID, doc.Import.ResourceID)
+		}
+	}
+}
@@ -193,6 +193,7 @@ type Timeouts struct {
 }
 
 type Import struct {
+	Line         int // line number, if line == 0 means no import example in document
 	ResourceType string
 	ResourceID   string
 }
@@ -0,0 +1,981 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/importids"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+const (
+	importsFileName   = "imports.tf"
+	generatedFileName = "generated.tf"
+	variablesFileName = "variables.tf"
+	unmappedFileName  = "unmapped.txt"
+)
+
+func main() {
+	f := flag.NewFlagSet("generator-import-blocks", flag.ExitOnError)
+
+	resourceGroupName := f.String("resource-group-name", "", "The name of the Resource Group containing the Resources which should be imported")
+	resourceType := f.String("type", "", "(Optional) Only include Resources of this Azure Resource Type (e.g. `Microsoft.Network/virtualNetworks`)")
+	inputPath := f.String("input", "", "(Optional) The path to a JSON file containing a previously recorded list of Resources, used instead of listing the Resource Group")
+	outputPath := f.String("output-path", ".", "The path to the directory where the generated files should be written")
+	generateConfig := f.Bool("generate-config", false, "Whether to Read each Resource and generate its Terraform Configuration from the resulting state")
+	recordPath := f.String("record-path", "", "(Optional) The path to a file where the responses from the Resource Manager API should be recorded, for use with `-replay-path`")
+	replayPath := f.String("replay-path", "", "(Optional) The path to a file containing previously recorded responses from the Resource Manager API, which are used instead of the live API")
+
+	_ = f.Parse(os.Args[1:])
+
+	if *resourceGroupName == "" && *inputPath == "" {
+		log.Print("Either the name of a Resource Group must be specified via `-resource-group-name` or a list of Resources via `-input`")
+		os.Exit(1)
+	}
+	if *recordPath != "" && *replayPath != "" {
+		log.Print("Only one of `-record-path` and `-replay-path` can be specified")
+		os.Exit(1)
+	}
+
+	input := GeneratorInput{
+		ResourceGroupName: *resourceGroupName,
+		ResourceType:      *resourceType,
+		InputPath:         *inputPath,
+		OutputPath:        *outputPath,
+		GenerateConfig:    *generateConfig,
+		RecordPath:        *recordPath,
+		ReplayPath:        *replayPath,
+	}
+	if err := run(context.Background(), input); err != nil {
+		log.Print(err)
+		os.Exit(1)
+	}
+}
+
+type GeneratorInput struct {
+	ResourceGroupName string
+	ResourceType      string
+	InputPath         string
+	OutputPath        string
+	GenerateConfig    bool
+	RecordPath        string
+	ReplayPath        string
+}
+
+// DiscoveredResource is a Resource found within the scope, matching an item in the `resources` block of the `azurerm_resources` Data Source
+type DiscoveredResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// MappedResource is a DiscoveredResource which can be managed using a Terraform Resource
+type MappedResource struct {
+	DiscoveredResource
+
+	// TerraformType is the Terraform Resource Type used to manage this Resource, e.g. `azurerm_resource_group`
+	TerraformType string
+
+	// Label is the Terraform Resource label used to manage this Resource, e.g. `example`
+	Label string
+}
+
+func run(ctx context.Context, input GeneratorInput) error {
+	p := provider.AzureProvider()
+
+	var meta interface{}
+	var recording *apiRecording
+	switch {
+	case input.ReplayPath != "":
+		replay, err := loadRecording(input.ReplayPath)
+		if err != nil {
+			return err
+		}
+		server := httptest.NewServer(replay.replayHandler())
+		defer server.Close()
+
+		log.Printf("[DEBUG] Configuring the Client to use the responses recorded in %q..", input.ReplayPath)
+		if meta, err = buildClient(ctx, server.URL, replayCredentials(server.URL, replay.TenantId), replay.SubscriptionId); err != nil {
+			return err
+		}
+
+	case input.RecordPath != "" && (input.InputPath == "" || input.GenerateConfig):
+		endpoint, ok := environments.AzurePublic().ResourceManager.Endpoint()
+		if !ok {
+			return fmt.Errorf("determining the Resource Manager endpoint")
+		}
+		recording = &apiRecording{
+			Endpoint: *endpoint,
+		}
+		server := httptest.NewServer(recording.recordHandler())
+		defer server.Close()
+
+		log.Printf("[DEBUG] Configuring the Client to record the responses from the Resource Manager API..")
+		client, err := buildClient(ctx, server.URL, liveCredentials(), os.Getenv("ARM_SUBSCRIPTION_ID"))
+		if err != nil {
+			return err
+		}
+		recording.SubscriptionId = client.Account.SubscriptionId
+		recording.TenantId = client.Account.TenantId
+		meta = client
+
+	case input.InputPath == "" || input.GenerateConfig:
+		log.Printf("[DEBUG] Configuring the Provider..")
+		diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"features": []interface{}{
+				map[string]interface{}{},
+			},
+		}))
+		if diags.HasError() {
+			return fmt.Errorf("configuring the Provider: %s", diagnosticsToString(diags))
+		}
+		meta = p.Meta()
+	}
+
+	var discovered []DiscoveredResource
+	var err error
+	if input.InputPath != "" {
+		log.Printf("[DEBUG] Loading the Resources from %q..", input.InputPath)
+		discovered, err = loadResourcesFromFile(input.InputPath, input.ResourceType)
+	} else {
+		log.Printf("[DEBUG] Listing the Resources within Resource Group %q..", input.ResourceGroupName)
+		discovered, err = listResources(ctx, p, meta, input.ResourceGroupName, input.ResourceType)
+	}
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Found %d Resources", len(discovered))
+
+	mapper := NewResourceMapper(p.ResourcesMap, importids.Resources())
+	mapped, unmapped := mapper.Map(discovered)
+	log.Printf("[DEBUG] Mapped %d Resources, %d Resources could not be mapped", len(mapped), len(unmapped))
+
+	if err := os.MkdirAll(input.OutputPath, 0o755); err != nil {
+		return fmt.Errorf("creating the output directory %q: %+v", input.OutputPath, err)
+	}
+
+	if err := writeFile(filepath.Join(input.OutputPath, importsFileName), renderImportBlocks(mapped)); err != nil {
+		return err
+	}
+
+	if input.GenerateConfig {
+		config := strings.Builder{}
+		variables := make([]sensitiveVariable, 0)
+		for _, item := range mapped {
+			resource := p.ResourcesMap[item.TerraformType]
+			log.Printf("[DEBUG] Reading %s.%s (%q)..", item.TerraformType, item.Label, item.ID)
+			d, err := readResource(ctx, resource, item.ID, meta)
+			if err != nil {
+				unmapped = append(unmapped, UnmappedResource{
+					DiscoveredResource: item.DiscoveredResource,
+					Reason:             fmt.Sprintf("reading as %q: %+v", item.TerraformType, err),
+				})
+				continue
+			}
+
+			block, blockVariables := renderResourceBlock(item, resource.SchemaMap(), d)
+			config.WriteString(block)
+			variables = append(variables, blockVariables...)
+		}
+
+		if err := writeFile(filepath.Join(input.OutputPath, generatedFileName), config.String()); err != nil {
+			return err
+		}
+		if err := writeFile(filepath.Join(input.OutputPath, variablesFileName), renderVariableBlocks(variables)); err != nil {
+			return err
+		}
+	}
+
+	if recording != nil {
+		if err := recording.write(input.RecordPath); err != nil {
+			return err
+		}
+	}
+
+	if err := writeFile(filepath.Join(input.OutputPath, unmappedFileName), renderUnmappedReport(unmapped)); err != nil {
+		return err
+	}
+
+	if len(unmapped) > 0 {
+		log.Printf("[WARN] %d Resources could not be mapped - see %q for details", len(unmapped), filepath.Join(input.OutputPath, unmappedFileName))
+	}
+
+	return nil
+}
+
+// listResources lists the Resources within the Resource Group using the `azurerm_resources` Data Source
+func listResources(ctx context.Context, p *schema.Provider, meta interface{}, resourceGroupName, resourceType string) ([]DiscoveredResource, error) {
+	dataSource, ok := p.DataSourcesMap["azurerm_resources"]
+	if !ok {
+		return nil, fmt.Errorf("the `azurerm_resources` Data Source was not found")
+	}
+
+	d := dataSource.TestResourceData()
+	if err := d.Set("resource_group_name", resourceGroupName); err != nil {
+		return nil, fmt.Errorf("setting `resource_group_name`: %+v", err)
+	}
+	if resourceType != "" {
+		if err := d.Set("type", resourceType); err != nil {
+			return nil, fmt.Errorf("setting `type`: %+v", err)
+		}
+	}
+
+	if dataSource.ReadContext != nil {
+		if diags := dataSource.ReadContext(ctx, d, meta); diags.HasError() {
+			return nil, fmt.Errorf("listing Resources within Resource Group %q: %s", resourceGroupName, diagnosticsToString(diags))
+		}
+	} else if err := dataSource.Read(d, meta); err != nil { //nolint:staticcheck
+		return nil, fmt.Errorf("listing Resources within Resource Group %q: %+v", resourceGroupName, err)
+	}
+
+	output := make([]DiscoveredResource, 0)
+	for _, raw := range d.Get("resources").([]interface{}) {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		output = append(output, DiscoveredResource{
+			ID:   v["id"].(string),
+			Name: v["name"].(string),
+			Type: v["type"].(string),
+		})
+	}
+
+	return output, nil
+}
+
+// loadResourcesFromFile loads a previously recorded list of Resources from the JSON file at `path`
+func loadResourcesFromFile(path string, resourceType string) ([]DiscoveredResource, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	var resources []DiscoveredResource
+	if err := json.Unmarshal(contents, &resources); err != nil {
+		return nil, fmt.Errorf("unmarshaling %q: %+v", path, err)
+	}
+
+	output := make([]DiscoveredResource, 0)
+	for _, item := range resources {
+		if resourceType != "" && !strings.EqualFold(item.Type, resourceType) {
+			continue
+		}
+		output = append(output, item)
+	}
+
+	return output, nil
+}
+
+// readResource imports and then reads the Resource with the specified ID, returning the resulting state
+func readResource(ctx context.Context, resource *schema.Resource, id string, meta interface{}) (*schema.ResourceData, error) {
+	d := resource.Data(nil)
+	d.SetId(id)
+
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		imported, err := resource.Importer.StateContext(ctx, d, meta)
+		if err != nil {
+			return nil, fmt.Errorf("importing: %+v", err)
+		}
+		if len(imported) == 0 {
+			return nil, fmt.Errorf("importing: no state was returned")
+		}
+		d = imported[0]
+	}
+
+	state, diags := resource.RefreshWithoutUpgrade(ctx, d.State(), meta)
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading: %s", diagnosticsToString(diags))
+	}
+	if state == nil || state.ID == "" {
+		return nil, fmt.Errorf("reading: the Resource was not found")
+	}
+
+	return resource.Data(state), nil
+}
+
+type UnmappedResource struct {
+	DiscoveredResource
+
+	// Reason is the reason why this Resource couldn't be mapped
+	Reason string
+}
+
+type resourceCandidate struct {
+	name       string
+	importId   importids.Resource
+	deprecated bool
+}
+
+// ResourceMapper maps Azure Resources to the Terraform Resources which can manage them, using the
+// ID Validation Function registered for each Terraform Resource
+type ResourceMapper struct {
+	candidates []resourceCandidate
+}
+
+func NewResourceMapper(resources map[string]*schema.Resource, importIds map[string]importids.Resource) ResourceMapper {
+	candidates := make([]resourceCandidate, 0)
+	for name, resource := range resources {
+		if resource.Importer == nil {
+			continue
+		}
+
+		importId, ok := importIds[name]
+		if !ok {
+			log.Printf("[DEBUG] Skipping %q since the Resource ID used for import can't be determined", name)
+			continue
+		}
+
+		candidates = append(candidates, resourceCandidate{
+			name:       name,
+			importId:   importId,
+			deprecated: resource.DeprecationMessage != "",
+		})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].name < candidates[j].name
+	})
+
+	return ResourceMapper{
+		candidates: candidates,
+	}
+}
+
+// Map returns the Resources which could be mapped to a Terraform Resource, and those which couldn't
+func (m ResourceMapper) Map(input []DiscoveredResource) ([]MappedResource, []UnmappedResource) {
+	mapped := make([]MappedResource, 0)
+	unmapped := make([]UnmappedResource, 0)
+	labels := make(map[string]struct{})
+
+	for _, item := range input {
+		matches := m.terraformTypesFor(item.ID)
+		if len(matches) == 0 {
+			unmapped = append(unmapped, UnmappedResource{
+				DiscoveredResource: item,
+				Reason:             "no Terraform Resource supports importing this Resource ID",
+			})
+			continue
+		}
+
+		// e.g. both `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` can import a Virtual Machine,
+		// which can only be determined from the Resource itself - so this needs to be chosen by the user
+		if len(matches) > 1 {
+			unmapped = append(unmapped, UnmappedResource{
+				DiscoveredResource: item,
+				Reason:             fmt.Sprintf("this Resource ID is ambiguous since it can be imported by each of: %s", strings.Join(matches, ", ")),
+			})
+			continue
+		}
+
+		terraformType := matches[0]
+		mapped = append(mapped, MappedResource{
+			DiscoveredResource: item,
+			TerraformType:      terraformType,
+			Label:              uniqueLabel(terraformType, item.Name, labels),
+		})
+	}
+
+	return mapped, unmapped
+}
+
+// terraformTypesFor returns the Terraform Resource Types which can import the specified Resource ID, where
+// deprecated Resources are only returned when no other Terraform Resource can import the Resource ID
+func (m ResourceMapper) terraformTypesFor(id string) []string {
+	current := make([]string, 0)
+	deprecated := make([]string, 0)
+	for _, candidate := range m.candidates {
+		// NOTE: ValidateID recovers from any panic raised by the validation function (for example when a
+		// composite Resource ID is assumed to contain a separator) - which means this Resource can't import it
+		if err := candidate.importId.ValidateID(id); err != nil {
+			continue
+		}
+
+		if candidate.deprecated {
+			deprecated = append(deprecated, candidate.name)
+			continue
+		}
+		current = append(current, candidate.name)
+	}
+
+	if len(current) > 0 {
+		return current
+	}
+	return deprecated
+}
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// uniqueLabel returns a valid Terraform label based on the Resource name, which is unique for the Terraform Resource Type
+func uniqueLabel(terraformType, name string, existing map[string]struct{}) string {
+	label := strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if label == "" || !(label[0] >= 'a' && label[0] <= 'z') {
+		label = "res_" + label
+	}
+
+	candidate := label
+	for i := 2; ; i++ {
+		key := fmt.Sprintf("%s.%s", terraformType, candidate)
+		if _, exists := existing[key]; !exists {
+			existing[key] = struct{}{}
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s_%d", label, i)
+	}
+}
+
+func renderImportBlocks(input []MappedResource) string {
+	output := strings.Builder{}
+	for _, item := range input {
+		output.WriteString(fmt.Sprintf(`import {
+  id = %s
+  to = %s.%s
+}
+
+`, hclString(item.ID), item.TerraformType, item.Label))
+	}
+	return output.String()
+}
+
+func renderUnmappedReport(input []UnmappedResource) string {
+	byType := make(map[string][]UnmappedResource)
+	for _, item := range input {
+		byType[item.Type] = append(byType[item.Type], item)
+	}
+
+	types := make([]string, 0)
+	for k := range byType {
+		types = append(types, k)
+	}
+	sort.Strings(types)
+
+	output := strings.Builder{}
+	for _, t := range types {
+		output.WriteString(fmt.Sprintf("%s:\n", t))
+		for _, item := range byType[t] {
+			output.WriteString(fmt.Sprintf("  - %s (%s)\n", item.ID, item.Reason))
+		}
+	}
+	return output.String()
+}
+
+// sensitiveVariable is a Terraform Variable used to configure a Sensitive argument, so that the value of the
+// argument isn't written into the generated configuration
+type sensitiveVariable struct {
+	// Name is the name of the Terraform Variable, e.g. `example_linux_virtual_machine_admin_password`
+	Name string
+
+	// Type is the Terraform type constraint for the Variable, e.g. `string`
+	Type string
+}
+
+// renderResourceBlock renders the HCL for a Resource from its state - including only the arguments
+// which can be configured and which differ from their zero or default values - alongside the
+// Variables used to configure any Sensitive arguments
+func renderResourceBlock(item MappedResource, schemaMap map[string]*schema.Schema, d *schema.ResourceData) (string, []sensitiveVariable) {
+	values := make(map[string]interface{})
+	for k := range schemaMap {
+		values[k] = d.Get(k)
+	}
+
+	output := strings.Builder{}
+	variables := make([]sensitiveVariable, 0)
+	output.WriteString(fmt.Sprintf("resource %q %q {\n", item.TerraformType, item.Label))
+	renderBody(&output, &variables, fmt.Sprintf("%s_%s", strings.TrimPrefix(item.TerraformType, "azurerm_"), item.Label), schemaMap, values, 1)
+	output.WriteString("}\n\n")
+	return output.String(), variables
+}
+
+func renderBody(output *strings.Builder, variables *[]sensitiveVariable, path string, schemaMap map[string]*schema.Schema, values map[string]interface{}, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	attributes := make([]string, 0)
+	blocks := make([]string, 0)
+	for k, v := range schemaMap {
+		if !v.Required && !v.Optional {
+			continue
+		}
+		if v.Deprecated != "" {
+			continue
+		}
+		if !v.Required && !hasConfiguredValue(v, values[k]) {
+			continue
+		}
+
+		// Sensitive values are configured using a Variable, rather than writing them into the configuration in plain text
+		if v.Sensitive {
+			attributes = append(attributes, k)
+			continue
+		}
+
+		if _, isBlock := v.Elem.(*schema.Resource); isBlock && v.ConfigMode != schema.SchemaConfigModeAttr {
+			blocks = append(blocks, k)
+			continue
+		}
+		attributes = append(attributes, k)
+	}
+	sort.Strings(attributes)
+	sort.Strings(blocks)
+
+	for _, k := range attributes {
+		if schemaMap[k].Sensitive {
+			variable := sensitiveVariable{
+				Name: fmt.Sprintf("%s_%s", path, k),
+				Type: variableType(schemaMap[k]),
+			}
+			*variables = append(*variables, variable)
+			output.WriteString(fmt.Sprintf("%s%s = var.%s\n", indent, k, variable.Name))
+			continue
+		}
+		output.WriteString(fmt.Sprintf("%s%s = %s\n", indent, k, renderValue(values[k], depth)))
+	}
+
+	for _, k := range blocks {
+		nested := schemaMap[k].Elem.(*schema.Resource)
+		for i, item := range listValue(values[k]) {
+			v, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			output.WriteString(fmt.Sprintf("\n%s%s {\n", indent, k))
+			renderBody(output, variables, fmt.Sprintf("%s_%s_%d", path, k, i), nested.SchemaMap(), v, depth+1)
+			output.WriteString(fmt.Sprintf("%s}\n", indent))
+		}
+	}
+}
+
+// renderVariableBlocks renders the HCL for the Variables used to configure Sensitive arguments
+func renderVariableBlocks(input []sensitiveVariable) string {
+	output := strings.Builder{}
+	for _, item := range input {
+		output.WriteString(fmt.Sprintf("variable %q {\n", item.Name))
+		output.WriteString(fmt.Sprintf("  type      = %s\n", item.Type))
+		output.WriteString("  sensitive = true\n")
+		output.WriteString("}\n\n")
+	}
+	return output.String()
+}
+
+// variableType returns the Terraform type constraint for the value of the specified argument
+func variableType(s *schema.Schema) string {
+	switch s.Type {
+	case schema.TypeString:
+		return "string"
+	case schema.TypeInt, schema.TypeFloat:
+		return "number"
+	case schema.TypeBool:
+		return "bool"
+	case schema.TypeList, schema.TypeSet, schema.TypeMap:
+		element := "string"
+		if s.Type != schema.TypeMap {
+			element = "any"
+		}
+		if v, ok := s.Elem.(*schema.Schema); ok {
+			element = variableType(v)
+		}
+
+		collection := map[schema.ValueType]string{
+			schema.TypeList: "list",
+			schema.TypeSet:  "set",
+			schema.TypeMap:  "map",
+		}[s.Type]
+		return fmt.Sprintf("%s(%s)", collection, element)
+	}
+
+	return "any"
+}
+
+func hasConfiguredValue(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return !reflect.DeepEqual(s.Default, value)
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	case bool:
+		return v
+	case map[string]interface{}:
+		return len(v) > 0
+	case []interface{}, *schema.Set:
+		return len(listValue(v)) > 0
+	}
+
+	return true
+}
+
+func listValue(input interface{}) []interface{} {
+	switch v := input.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+	return []interface{}{}
+}
+
+func renderValue(input interface{}, depth int) string {
+	switch v := input.(type) {
+	case string:
+		return hclString(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}, *schema.Set:
+		items := make([]string, 0)
+		for _, item := range listValue(v) {
+			items = append(items, renderValue(item, depth))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(items, ", "))
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+
+		keys := make([]string, 0)
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		indent := strings.Repeat("  ", depth+1)
+		output := strings.Builder{}
+		output.WriteString("{\n")
+		for _, k := range keys {
+			output.WriteString(fmt.Sprintf("%s%s = %s\n", indent, hclString(k), renderValue(v[k], depth+1)))
+		}
+		output.WriteString(fmt.Sprintf("%s}", strings.Repeat("  ", depth)))
+		return output.String()
+	}
+
+	return "null"
+}
+
+// hclString returns the quoted HCL representation of input, escaping any template sequences
+func hclString(input string) string {
+	output := strings.Builder{}
+	output.WriteString(`"`)
+	runes := []rune(input)
+	for i, r := range runes {
+		switch r {
+		case '"':
+			output.WriteString(`\"`)
+		case '\\':
+			output.WriteString(`\\`)
+		case '\n':
+			output.WriteString(`\n`)
+		case '\r':
+			output.WriteString(`\r`)
+		case '\t':
+			output.WriteString(`\t`)
+		case '$', '%':
+			output.WriteRune(r)
+			if i+1 < len(runes) && runes[i+1] == '{' {
+				output.WriteRune(r)
+			}
+		default:
+			output.WriteRune(r)
+		}
+	}
+	output.WriteString(`"`)
+	return output.String()
+}
+
+// buildClient builds the Client used to Read each Resource, sending each request to the Resource Manager API
+// via `endpoint` - which is a local server either recording or replaying the responses from the Resource Manager API
+func buildClient(ctx context.Context, endpoint string, credentials auth.Credentials, subscriptionId string) (*clients.Client, error) {
+	credentials.Environment.ResourceManager = redirectedApi{
+		Api:      credentials.Environment.ResourceManager,
+		endpoint: endpoint,
+	}
+
+	client, err := clients.Build(ctx, clients.ClientBuilder{
+		AuthConfig:               &credentials,
+		Features:                 features.Default(),
+		SkipProviderRegistration: true,
+		SubscriptionID:           subscriptionId,
+		TerraformVersion:         "0.11+compatible",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building the Client: %+v", err)
+	}
+	return client, nil
+}
+
+// redirectedApi sends requests for an API to a different endpoint, whilst continuing to obtain
+// tokens for the original API
+type redirectedApi struct {
+	environments.Api
+
+	endpoint string
+}
+
+func (r redirectedApi) Endpoint() (*string, bool) {
+	return &r.endpoint, true
+}
+
+// liveCredentials returns the credentials used to authenticate against the live API when recording, which
+// are configured in the same way as the Provider (either using a Service Principal or the Azure CLI)
+func liveCredentials() auth.Credentials {
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	return auth.Credentials{
+		Environment:                           *environments.AzurePublic(),
+		ClientID:                              os.Getenv("ARM_CLIENT_ID"),
+		TenantID:                              os.Getenv("ARM_TENANT_ID"),
+		ClientSecret:                          clientSecret,
+		EnableAuthenticatingUsingClientSecret: clientSecret != "",
+		EnableAuthenticatingUsingAzureCLI:     true,
+	}
+}
+
+// replayCredentials returns the credentials used when replaying a recording, which obtain an (unsigned)
+// token from the local server rather than authenticating
+func replayCredentials(endpoint, tenantId string) auth.Credentials {
+	environment := *environments.AzurePublic()
+	authorization := *environment.Authorization
+	authorization.LoginEndpoint = endpoint
+	environment.Authorization = &authorization
+
+	return auth.Credentials{
+		Environment:                           environment,
+		ClientID:                              replayClientId,
+		TenantID:                              tenantId,
+		ClientSecret:                          "replayed",
+		EnableAuthenticatingUsingClientSecret: true,
+	}
+}
+
+const replayClientId = "00000000-0000-0000-0000-000000000000"
+
+// apiRecording is a recording of the responses from the Resource Manager API - which contains only the
+// Method and URI of each request alongside the response, and doesn't include any request headers or tokens
+type apiRecording struct {
+	// Endpoint is the Resource Manager endpoint which was recorded, e.g. `https://management.azure.com`
+	Endpoint string `json:"endpoint"`
+
+	SubscriptionId string `json:"subscriptionId"`
+	TenantId       string `json:"tenantId"`
+
+	Interactions []apiInteraction `json:"interactions"`
+
+	lock sync.Mutex
+}
+
+type apiInteraction struct {
+	Method      string `json:"method"`
+	URI         string `json:"uri"`
+	StatusCode  int    `json:"statusCode"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        string `json:"body,omitempty"`
+}
+
+func loadRecording(path string) (*apiRecording, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	var recording apiRecording
+	if err := json.Unmarshal(contents, &recording); err != nil {
+		return nil, fmt.Errorf("unmarshaling %q: %+v", path, err)
+	}
+	if recording.Endpoint == "" || recording.SubscriptionId == "" || recording.TenantId == "" {
+		return nil, fmt.Errorf("the recording %q doesn't contain an `endpoint`, `subscriptionId` and `tenantId`", path)
+	}
+
+	return &recording, nil
+}
+
+// write writes the recording to `path` - since the responses can contain secrets (for example from a
+// `listKeys` operation) this is only readable by the current user
+func (r *apiRecording) write(path string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	contents, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the recording: %+v", err)
+	}
+	if err := os.WriteFile(path, contents, 0o600); err != nil {
+		return fmt.Errorf("writing %q: %+v", path, err)
+	}
+	log.Printf("[DEBUG] Written %q", path)
+	return nil
+}
+
+// recordHandler forwards each request to the Resource Manager API and records the response
+func (r *apiRecording) recordHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		upstream, err := http.NewRequestWithContext(req.Context(), req.Method, r.Endpoint+req.URL.RequestURI(), req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		upstream.Header = req.Header.Clone()
+		// the response is recorded as-is, so let the Transport handle any compression
+		upstream.Header.Del("Accept-Encoding")
+
+		resp, err := http.DefaultClient.Do(upstream)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		interaction := apiInteraction{
+			Method:      req.Method,
+			URI:         req.URL.RequestURI(),
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        string(body),
+		}
+		r.lock.Lock()
+		r.Interactions = append(r.Interactions, interaction)
+		r.lock.Unlock()
+
+		r.respond(w, req, interaction)
+	})
+}
+
+// replayHandler responds to each request using the recorded responses, in the order they were recorded
+func (r *apiRecording) replayHandler() http.Handler {
+	served := make(map[string]int)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/oauth2/v2.0/token") {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": replayToken(r.TenantId),
+				"token_type":   "Bearer",
+				"expires_in":   3600,
+			})
+			return
+		}
+
+		r.lock.Lock()
+		key := fmt.Sprintf("%s %s", req.Method, req.URL.RequestURI())
+		matches := make([]apiInteraction, 0)
+		for _, item := range r.Interactions {
+			if item.Method == req.Method && item.URI == req.URL.RequestURI() {
+				matches = append(matches, item)
+			}
+		}
+		index := served[key]
+		served[key]++
+		r.lock.Unlock()
+
+		if len(matches) == 0 {
+			// this is returned as a 501 since this isn't retried by the Client
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotImplemented)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    "NotRecorded",
+					"message": fmt.Sprintf("no response was recorded for %s", key),
+				},
+			})
+			return
+		}
+
+		// once each of the recorded responses has been served the last one is reused
+		if index >= len(matches) {
+			index = len(matches) - 1
+		}
+		r.respond(w, req, matches[index])
+	})
+}
+
+// respond writes the recorded response, replacing any references to the Resource Manager endpoint (e.g. within
+// a `nextLink`) with the local server - so that subsequent requests are also recorded or replayed
+func (r *apiRecording) respond(w http.ResponseWriter, req *http.Request, interaction apiInteraction) {
+	if interaction.ContentType != "" {
+		w.Header().Set("Content-Type", interaction.ContentType)
+	}
+	w.WriteHeader(interaction.StatusCode)
+	_, _ = w.Write([]byte(strings.ReplaceAll(interaction.Body, r.Endpoint, fmt.Sprintf("http://%s", req.Host))))
+}
+
+// replayToken returns an unsigned token containing the claims used by the Client to determine the
+// authenticated Principal, which is sufficient since the local server doesn't check it
+func replayToken(tenantId string) string {
+	encode := func(input map[string]interface{}) string {
+		contents, _ := json.Marshal(input)
+		return base64.RawURLEncoding.EncodeToString(contents)
+	}
+
+	header := encode(map[string]interface{}{
+		"alg": "none",
+		"typ": "JWT",
+	})
+	claims := encode(map[string]interface{}{
+		"appid": replayClientId,
+		"oid":   replayClientId,
+		"tid":   tenantId,
+	})
+	return fmt.Sprintf("%s.%s.", header, claims)
+}
+
+func diagnosticsToString(diags diag.Diagnostics) string {
+	messages := make([]string, 0)
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		message := d.Summary
+		if d.Detail != "" {
+			message = fmt.Sprintf("%s: %s", message, d.Detail)
+		}
+		messages = append(messages, message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func writeFile(path, contents string) error {
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		return fmt.Errorf("writing %q: %+v", path, err)
+	}
+	log.Printf("[DEBUG] Written %q", path)
+	return nil
+}
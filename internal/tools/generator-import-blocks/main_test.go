@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/claims"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/importids"
+)
+
+func prefixValidator(prefix string) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		if !strings.HasPrefix(strings.ToLower(id), strings.ToLower(prefix)) {
+			return fmt.Errorf("parsing %q: expected the prefix %q", id, prefix)
+		}
+		return nil
+	}
+}
+
+// compositeValidator mimics the validation functions for composite Resource IDs, which panic when the
+// Resource ID doesn't contain the separator
+func compositeValidator(id string) error {
+	if strings.Split(id, "|")[1] == "" {
+		return fmt.Errorf("expected %q to contain a second Resource ID", id)
+	}
+	return nil
+}
+
+func TestResourceMapper(t *testing.T) {
+	vmPrefix := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Compute/virtualMachines/"
+	vnetPrefix := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/"
+
+	importer := pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		return nil
+	})
+	resources := map[string]*schema.Resource{
+		"azurerm_linux_virtual_machine": {
+			Importer: importer,
+		},
+		"azurerm_windows_virtual_machine": {
+			Importer: importer,
+		},
+		"azurerm_virtual_machine": {
+			Importer:           importer,
+			DeprecationMessage: "superseded",
+		},
+		"azurerm_virtual_network": {
+			Importer: importer,
+		},
+		"azurerm_legacy_network": {
+			Importer:           importer,
+			DeprecationMessage: "superseded",
+		},
+		"azurerm_composite_association": {
+			Importer: importer,
+		},
+		"azurerm_unknown_importer": {
+			Importer: importer,
+		},
+		"azurerm_no_importer": {},
+	}
+	importIds := map[string]importids.Resource{
+		"azurerm_linux_virtual_machine": {
+			Validate: prefixValidator(vmPrefix),
+		},
+		"azurerm_windows_virtual_machine": {
+			Validate: prefixValidator(vmPrefix),
+		},
+		"azurerm_virtual_machine": {
+			Validate: prefixValidator(vmPrefix),
+		},
+		"azurerm_virtual_network": {
+			Validate: prefixValidator(vnetPrefix),
+		},
+		"azurerm_legacy_network": {
+			Validate: prefixValidator(vnetPrefix),
+		},
+		"azurerm_composite_association": {
+			Validate: compositeValidator,
+		},
+	}
+
+	input := []DiscoveredResource{
+		{
+			ID:   vmPrefix + "Web-01",
+			Name: "Web-01",
+			Type: "Microsoft.Compute/virtualMachines",
+		},
+		{
+			ID:   vnetPrefix + "network",
+			Name: "network",
+			Type: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			ID:   vnetPrefix + "NETWORK",
+			Name: "NETWORK",
+			Type: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Example/widgets/first",
+			Name: "first",
+			Type: "Microsoft.Example/widgets",
+		},
+	}
+
+	mapped, unmapped := NewResourceMapper(resources, importIds).Map(input)
+
+	expectedMapped := []MappedResource{
+		{
+			DiscoveredResource: input[1],
+			TerraformType:      "azurerm_virtual_network",
+			Label:              "network",
+		},
+		{
+			DiscoveredResource: input[2],
+			TerraformType:      "azurerm_virtual_network",
+			Label:              "network_2",
+		},
+	}
+	if !reflect.DeepEqual(mapped, expectedMapped) {
+		t.Fatalf("expected mapped resources %+v but got %+v", expectedMapped, mapped)
+	}
+
+	if len(unmapped) != 2 {
+		t.Fatalf("expected 2 unmapped resources but got %+v", unmapped)
+	}
+	if unmapped[0].ID != input[0].ID || !strings.Contains(unmapped[0].Reason, "azurerm_linux_virtual_machine, azurerm_windows_virtual_machine") {
+		t.Fatalf("expected %q to be unmapped as ambiguous but got %+v", input[0].ID, unmapped[0])
+	}
+	if unmapped[1].ID != input[3].ID {
+		t.Fatalf("expected %q to be unmapped but got %+v", input[3].ID, unmapped[1])
+	}
+}
+
+func TestUniqueLabel(t *testing.T) {
+	cases := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "example",
+			expected: "example",
+		},
+		{
+			name:     "Example.Resource Name",
+			expected: "example_resource_name",
+		},
+		{
+			name:     "1example",
+			expected: "res_1example",
+		},
+		{
+			name:     "_other_",
+			expected: "other",
+		},
+		{
+			name:     "example",
+			expected: "example_2",
+		},
+	}
+
+	existing := make(map[string]struct{})
+	for _, v := range cases {
+		actual := uniqueLabel("azurerm_resource_group", v.name, existing)
+		if actual != v.expected {
+			t.Fatalf("expected %q for %q but got %q", v.expected, v.name, actual)
+		}
+	}
+}
+
+func TestHclString(t *testing.T) {
+	cases := map[string]string{
+		"example":           `"example"`,
+		"with \"quotes\"":   `"with \"quotes\""`,
+		"line\nbreak":       `"line\nbreak"`,
+		"${interpolation}":  `"$${interpolation}"`,
+		"%{directive}":      `"%%{directive}"`,
+		"100% $ discounted": `"100% $ discounted"`,
+	}
+
+	for input, expected := range cases {
+		if actual := hclString(input); actual != expected {
+			t.Fatalf("expected %s for %q but got %s", expected, input, actual)
+		}
+	}
+}
+
+func TestRenderResourceBlock(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"computed_only": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ports": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"keys": {
+							Type:      schema.TypeList,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	d := resource.TestResourceData()
+	d.SetId("/some/id")
+	values := map[string]interface{}{
+		"name":          "example",
+		"enabled":       false,
+		"computed_only": "ignored",
+		"password":      "P@ssw0rd1234!",
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+		"rule": []interface{}{
+			map[string]interface{}{
+				"ports": []interface{}{80, 443},
+				"keys":  []interface{}{"secret"},
+			},
+		},
+	}
+	for k, v := range values {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("setting %q: %+v", k, err)
+		}
+	}
+
+	item := MappedResource{
+		TerraformType: "azurerm_example",
+		Label:         "test",
+	}
+	expected := `resource "azurerm_example" "test" {
+  enabled = false
+  name = "example"
+  password = var.example_test_password
+  tags = {
+    "environment" = "production"
+  }
+
+  rule {
+    keys = var.example_test_rule_0_keys
+    ports = [80, 443]
+  }
+}
+
+`
+	actual, variables := renderResourceBlock(item, resource.SchemaMap(), d)
+	if actual != expected {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+
+	expectedVariables := `variable "example_test_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "example_test_rule_0_keys" {
+  type      = list(string)
+  sensitive = true
+}
+
+`
+	if actual := renderVariableBlocks(variables); actual != expectedVariables {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expectedVariables, actual)
+	}
+}
+
+func TestApiRecording(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if req.URL.Query().Get("page") == "2" {
+			_, _ = w.Write([]byte(`{"value": [{"name": "second"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"value": [{"name": "first"}], "nextLink": "http://%s/resources?page=2"}`, req.Host)))
+	}))
+	defer upstream.Close()
+
+	recording := &apiRecording{
+		Endpoint:       upstream.URL,
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		TenantId:       "87654321-1234-9876-4563-123456789012",
+	}
+	recorder := httptest.NewServer(recording.recordHandler())
+	firstPage := get(t, recorder.URL+"/resources", "Bearer token")
+	if expected := fmt.Sprintf(`"nextLink": "%s/resources?page=2"`, recorder.URL); !strings.Contains(firstPage, expected) {
+		t.Fatalf("expected the nextLink to refer to the recorder (%s) but got %s", expected, firstPage)
+	}
+	get(t, recorder.URL+"/resources?page=2", "Bearer token")
+	recorder.Close()
+
+	path := filepath.Join(t.TempDir(), "recording.json")
+	if err := recording.write(path); err != nil {
+		t.Fatalf("writing the recording: %+v", err)
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading the recording: %+v", err)
+	}
+	if strings.Contains(string(contents), "Bearer token") {
+		t.Fatalf("expected the recording not to contain the token but got %s", contents)
+	}
+
+	replay, err := loadRecording(path)
+	if err != nil {
+		t.Fatalf("loading the recording: %+v", err)
+	}
+	if len(replay.Interactions) != 2 {
+		t.Fatalf("expected 2 interactions but got %+v", replay.Interactions)
+	}
+
+	replayer := httptest.NewServer(replay.replayHandler())
+	defer replayer.Close()
+
+	firstPage = get(t, replayer.URL+"/resources", "")
+	if expected := fmt.Sprintf(`"nextLink": "%s/resources?page=2"`, replayer.URL); !strings.Contains(firstPage, expected) {
+		t.Fatalf("expected the nextLink to refer to the replayer (%s) but got %s", expected, firstPage)
+	}
+	if secondPage := get(t, replayer.URL+"/resources?page=2", ""); !strings.Contains(secondPage, "second") {
+		t.Fatalf("expected the second page but got %s", secondPage)
+	}
+
+	resp, err := http.Get(replayer.URL + "/unrecorded")
+	if err != nil {
+		t.Fatalf("requesting an unrecorded URI: %+v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected a 501 for an unrecorded URI but got %d", resp.StatusCode)
+	}
+
+	resp, err = http.PostForm(replayer.URL+"/"+replay.TenantId+"/oauth2/v2.0/token", url.Values{})
+	if err != nil {
+		t.Fatalf("requesting a token: %+v", err)
+	}
+	defer resp.Body.Close()
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		t.Fatalf("decoding the token: %+v", err)
+	}
+	segments := strings.Split(token.AccessToken, ".")
+	if len(segments) != 3 {
+		t.Fatalf("expected the token to contain 3 segments but got %q", token.AccessToken)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(segments[1])
+	if err != nil {
+		t.Fatalf("decoding the claims: %+v", err)
+	}
+	var tokenClaims claims.Claims
+	if err := json.Unmarshal(payload, &tokenClaims); err != nil {
+		t.Fatalf("parsing the claims: %+v", err)
+	}
+	if tokenClaims.TenantId != replay.TenantId || tokenClaims.ObjectId == "" {
+		t.Fatalf("expected the claims to contain the Tenant and Object ID but got %+v", tokenClaims)
+	}
+}
+
+func TestReplayReadResource(t *testing.T) {
+	recording := &apiRecording{
+		Endpoint:       "https://management.azure.com",
+		SubscriptionId: "12345678-1234-9876-4563-123456789012",
+		TenantId:       "87654321-1234-9876-4563-123456789012",
+		Interactions: []apiInteraction{
+			{
+				Method:      http.MethodGet,
+				URI:         "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/example?api-version=2020-06-01",
+				StatusCode:  http.StatusOK,
+				ContentType: "application/json",
+				Body:        `{"id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example", "name": "example", "location": "westeurope", "tags": {"environment": "production"}}`,
+			},
+		},
+	}
+	server := httptest.NewServer(recording.replayHandler())
+	defer server.Close()
+
+	ctx := context.Background()
+	client, err := buildClient(ctx, server.URL, replayCredentials(server.URL, recording.TenantId), recording.SubscriptionId)
+	if err != nil {
+		t.Fatalf("building the client: %+v", err)
+	}
+
+	resource := provider.AzureProvider().ResourcesMap["azurerm_resource_group"]
+	d, err := readResource(ctx, resource, "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example", client)
+	if err != nil {
+		t.Fatalf("reading the resource: %+v", err)
+	}
+	if location := d.Get("location").(string); location != "westeurope" {
+		t.Fatalf("expected the location `westeurope` but got %q", location)
+	}
+}
+
+func get(t *testing.T, uri, authorization string) string {
+	req, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		t.Fatalf("building the request: %+v", err)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("requesting %q: %+v", uri, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading the response from %q: %+v", uri, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 from %q but got %d: %s", uri, resp.StatusCode, body)
+	}
+	return string(body)
+}
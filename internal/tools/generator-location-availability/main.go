@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+// NOTE: since we're using `go run` for these tools all of the code needs to live within the main.go
+
+func main() {
+	f := flag.NewFlagSet("generator-location-availability", flag.ExitOnError)
+
+	outputPath := f.String("output", "../../acceptance/location_availability.json", "The path to the Location Availability Snapshot which should be written")
+	locations := f.String("locations", "", "(Optional) A comma separated list of Azure Regions to include, defaults to all of the physical regions available to the Subscription")
+
+	_ = f.Parse(os.Args[1:])
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Hour)
+	defer cancel()
+
+	if err := run(ctx, *outputPath, *locations); err != nil {
+		log.Print(err)
+		os.Exit(1)
+	}
+}
+
+// locationAvailability matches the format of the snapshot embedded within `./internal/acceptance`
+type locationAvailability struct {
+	GeneratedOn string                          `json:"generatedOn"`
+	Locations   map[string]locationCapabilities `json:"locations"`
+}
+
+type locationCapabilities struct {
+	AvailabilityZones []string `json:"availabilityZones"`
+	Services          []string `json:"services"`
+	SKUs              []string `json:"skus"`
+}
+
+func run(ctx context.Context, outputPath string, locationsFilter string) error {
+	log.Printf("[DEBUG] Configuring the Provider..")
+	p := provider.AzureProvider()
+	diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"features": []interface{}{
+			map[string]interface{}{},
+		},
+	}))
+	if diags.HasError() {
+		return fmt.Errorf("configuring the Provider: %+v", diags)
+	}
+	meta := p.Meta().(*clients.Client)
+	subscriptionId := commonids.NewSubscriptionID(meta.Account.SubscriptionId)
+
+	output := locationAvailability{
+		GeneratedOn: time.Now().UTC().Format("2006-01-02"),
+		Locations:   map[string]locationCapabilities{},
+	}
+
+	log.Printf("[DEBUG] Listing the Locations available to %s..", subscriptionId)
+	zones, err := listLocations(ctx, meta.Subscription.SubscriptionsClient, subscriptionId, locationsFilter)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Listing the Resource Providers registered within %s..", subscriptionId)
+	services, err := listServices(ctx, meta.Resource.ResourceProvidersClient, subscriptionId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Listing the Virtual Machine SKUs available to %s..", subscriptionId)
+	vmSkus, err := listSkus(ctx, meta.Compute.SkusClient, subscriptionId)
+	if err != nil {
+		return err
+	}
+
+	for name, availabilityZones := range zones {
+		log.Printf("[DEBUG] Retrieving the Compute Quota for %q..", name)
+		quota, err := listComputeQuota(ctx, meta.Compute.SkusClient, subscriptionId, name)
+		if err != nil {
+			return err
+		}
+
+		available := make([]string, 0)
+		for _, sku := range vmSkus[name] {
+			if quota.canAllocate(sku) {
+				available = append(available, sku.name)
+			}
+		}
+
+		output.Locations[name] = locationCapabilities{
+			AvailabilityZones: availabilityZones,
+			Services:          sortedKeys(services[name]),
+			SKUs:              sortedUnique(available),
+		}
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the snapshot: %+v", err)
+	}
+	if err := os.WriteFile(outputPath, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing the snapshot to %q: %+v", outputPath, err)
+	}
+
+	log.Printf("[DEBUG] Wrote the capabilities of %d Locations to %q", len(output.Locations), outputPath)
+	return nil
+}
+
+// listLocations returns a map of the normalized name of each physical region to the Availability Zones within it
+func listLocations(ctx context.Context, client *subscriptions.SubscriptionsClient, subscriptionId commonids.SubscriptionId, filter string) (map[string][]string, error) {
+	included := map[string]struct{}{}
+	for _, v := range strings.Split(filter, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			included[location.Normalize(v)] = struct{}{}
+		}
+	}
+
+	resp, err := client.ListLocations(ctx, subscriptionId, subscriptions.DefaultListLocationsOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the Locations within %s: %+v", subscriptionId, err)
+	}
+
+	output := make(map[string][]string)
+	if resp.Model == nil || resp.Model.Value == nil {
+		return output, nil
+	}
+
+	for _, item := range *resp.Model.Value {
+		if item.Name == nil || pointer.From(item.Type) != subscriptions.LocationTypeRegion {
+			continue
+		}
+		if item.Metadata == nil || pointer.From(item.Metadata.RegionType) != subscriptions.RegionTypePhysical {
+			continue
+		}
+
+		name := location.Normalize(*item.Name)
+		if _, ok := included[name]; len(included) > 0 && !ok {
+			continue
+		}
+
+		availabilityZones := make([]string, 0)
+		if item.AvailabilityZoneMappings != nil {
+			for _, mapping := range *item.AvailabilityZoneMappings {
+				if mapping.LogicalZone != nil {
+					availabilityZones = append(availabilityZones, *mapping.LogicalZone)
+				}
+			}
+		}
+		output[name] = sortedUnique(availabilityZones)
+	}
+
+	return output, nil
+}
+
+// listServices returns a map of the normalized name of each region to the registered Resource Provider namespaces available within it
+func listServices(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) (map[string]map[string]struct{}, error) {
+	resp, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the Resource Providers within %s: %+v", subscriptionId, err)
+	}
+
+	output := make(map[string]map[string]struct{})
+	for _, item := range resp.Items {
+		if item.Namespace == nil || item.ResourceTypes == nil || !strings.EqualFold(pointer.From(item.RegistrationState), "Registered") {
+			continue
+		}
+
+		for _, resourceType := range *item.ResourceTypes {
+			for _, v := range pointer.From(resourceType.Locations) {
+				name := location.Normalize(v)
+				if _, ok := output[name]; !ok {
+					output[name] = make(map[string]struct{})
+				}
+				output[name][*item.Namespace] = struct{}{}
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type virtualMachineSku struct {
+	name   string
+	family string
+	vCPUs  int64
+}
+
+// listSkus returns a map of the normalized name of each region to the Virtual Machine SKUs which aren't restricted within it
+func listSkus(ctx context.Context, client *skus.SkusClient, subscriptionId commonids.SubscriptionId) (map[string][]virtualMachineSku, error) {
+	resp, err := client.ResourceSkusListComplete(ctx, subscriptionId, skus.DefaultResourceSkusListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing the Resource SKUs within %s: %+v", subscriptionId, err)
+	}
+
+	output := make(map[string][]virtualMachineSku)
+	for _, item := range resp.Items {
+		if item.Name == nil || !strings.EqualFold(pointer.From(item.ResourceType), "virtualMachines") {
+			continue
+		}
+
+		sku := virtualMachineSku{
+			name:   *item.Name,
+			family: pointer.From(item.Family),
+		}
+		for _, capability := range pointer.From(item.Capabilities) {
+			if strings.EqualFold(pointer.From(capability.Name), "vCPUs") {
+				if v, err := strconv.ParseInt(pointer.From(capability.Value), 10, 64); err == nil {
+					sku.vCPUs = v
+				}
+			}
+		}
+
+		// SKUs which are restricted (e.g. `NotAvailableForSubscription`) within an entire region can't be used there
+		restricted := make(map[string]struct{})
+		for _, restriction := range pointer.From(item.Restrictions) {
+			if pointer.From(restriction.Type) != skus.ResourceSkuRestrictionsTypeLocation {
+				continue
+			}
+			for _, v := range pointer.From(restriction.Values) {
+				restricted[location.Normalize(v)] = struct{}{}
+			}
+		}
+
+		for _, v := range pointer.From(item.Locations) {
+			name := location.Normalize(v)
+			if _, ok := restricted[name]; ok {
+				continue
+			}
+			output[name] = append(output[name], sku)
+		}
+	}
+
+	return output, nil
+}
+
+type computeQuota struct {
+	// remaining is a map of the quota name (either `cores` or the SKU Family) to the number of vCPUs which can still be allocated
+	remaining map[string]int64
+}
+
+// canAllocate returns whether there's sufficient regional and SKU Family quota to provision a single instance of this SKU
+func (q computeQuota) canAllocate(sku virtualMachineSku) bool {
+	required := sku.vCPUs
+	if required == 0 {
+		required = 1
+	}
+
+	if v, ok := q.remaining["cores"]; ok && v < required {
+		return false
+	}
+	if v, ok := q.remaining[strings.ToLower(sku.family)]; ok && v < required {
+		return false
+	}
+	return true
+}
+
+// listComputeQuota retrieves the Compute Usages for the specified region, which isn't exposed within the SDK - as such this
+// uses the base client (and the API Version) from the SKUs Client, since the Compute Usages API is available in that version
+func listComputeQuota(ctx context.Context, skusClient *skus.SkusClient, subscriptionId commonids.SubscriptionId, locationName string) (*computeQuota, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       fmt.Sprintf("%s/providers/Microsoft.Compute/locations/%s/usages", subscriptionId.ID(), locationName),
+	}
+
+	req, err := skusClient.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building the request to list the Compute Usages for %q: %+v", locationName, err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing the Compute Usages for %q: %+v", locationName, err)
+	}
+
+	var usages struct {
+		Value []struct {
+			CurrentValue int64 `json:"currentValue"`
+			Limit        int64 `json:"limit"`
+			Name         struct {
+				Value string `json:"value"`
+			} `json:"name"`
+		} `json:"value"`
+	}
+	if err := resp.Unmarshal(&usages); err != nil {
+		return nil, fmt.Errorf("unmarshaling the Compute Usages for %q: %+v", locationName, err)
+	}
+
+	output := computeQuota{
+		remaining: make(map[string]int64),
+	}
+	for _, usage := range usages.Value {
+		output.remaining[strings.ToLower(usage.Name.Value)] = usage.Limit - usage.CurrentValue
+	}
+	return &output, nil
+}
+
+func sortedKeys(input map[string]struct{}) []string {
+	output := make([]string, 0, len(input))
+	for k := range input {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
+
+func sortedUnique(input []string) []string {
+	seen := make(map[string]struct{})
+	for _, v := range input {
+		seen[v] = struct{}{}
+	}
+	return sortedKeys(seen)
+}
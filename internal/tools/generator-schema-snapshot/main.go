@@ -4,24 +4,47 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"go/format"
 	"log"
 	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 
 	. "github.com/dave/jennifer/jen"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/importids"
 )
 
 const (
-	SchemaPath = "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	SchemaPath        = "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	StateUpgradesPath = "github.com/hashicorp/terraform-provider-azurerm/internal/tf/stateupgrades"
 )
 
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatal("Usage: generator-schema-snapshot <reource_type>")
+	flags := flag.NewFlagSet("generator-schema-snapshot", flag.ExitOnError)
+	stateUpgraderTests := flags.Bool("state-upgrader-tests", false, "Whether to generate the Schema Snapshots and tests for the State Upgraders of each Resource")
+	servicesPath := flags.String("services-path", "../../services", "The path to the `internal/services` directory. Used with `-state-upgrader-tests`")
+	serviceName := flags.String("service", "", "Only generate the tests for this Service Package (e.g. `compute`). Used with `-state-upgrader-tests`")
+	_ = flags.Parse(os.Args[1:])
+
+	if *stateUpgraderTests {
+		if err := generateStateUpgraderTests(*servicesPath, *serviceName); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if flags.NArg() != 1 {
+		log.Fatal("Usage: generator-schema-snapshot <reource_type> | -state-upgrader-tests [-service <service>]")
 	}
-	rt := os.Args[1]
+	rt := flags.Arg(0)
 	res, ok := provider.AzureProvider().ResourcesMap[rt]
 	if !ok {
 		log.Fatalf("unknown resource type %q", rt)
@@ -103,3 +126,179 @@ func SchemaValue(sch *pluginsdk.Schema) Dict {
 
 	return out
 }
+
+type servicePackage struct {
+	// name is the name of the Go Package, e.g. `compute`
+	name string
+
+	// path is the import path of the Go Package
+	path string
+
+	// registrationName is the name of the type of the Service Registration, e.g. `Registration`
+	registrationName string
+
+	// resources is a map of the Resource Type to the Resource, for Resources with State Upgraders
+	resources map[string]*pluginsdk.Resource
+}
+
+// generateStateUpgraderTests records a Schema Snapshot for each Resource with State Upgraders into the `testdata`
+// directory of the Service Package, and generates a test for each which verifies the State Upgraders against it
+func generateStateUpgraderTests(servicesPath, serviceName string) error {
+	services := make(map[string]*servicePackage)
+	findService := func(registration interface{}) *servicePackage {
+		typ := reflect.TypeOf(registration)
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		path := typ.PkgPath()
+		if _, ok := services[path]; !ok {
+			services[path] = &servicePackage{
+				name:             strings.Split(typ.String(), ".")[0],
+				path:             path,
+				registrationName: typ.Name(),
+				resources:        map[string]*pluginsdk.Resource{},
+			}
+		}
+		return services[path]
+	}
+
+	for _, registration := range provider.SupportedTypedServices() {
+		service := findService(registration)
+		for _, r := range registration.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				return fmt.Errorf("wrapping Resource %q: %+v", r.ResourceType(), err)
+			}
+			if len(resource.StateUpgraders) > 0 {
+				service.resources[r.ResourceType()] = resource
+			}
+		}
+	}
+	for _, registration := range provider.SupportedUntypedServices() {
+		service := findService(registration)
+		for resourceType, resource := range registration.SupportedResources() {
+			if len(resource.StateUpgraders) > 0 {
+				service.resources[resourceType] = resource
+			}
+		}
+	}
+
+	// the example Resource ID used within the synthetic state is generated from the Resource ID used at import time
+	importIds := importids.Resources()
+
+	failed := make([]string, 0)
+	for _, service := range services {
+		directory := filepath.Join(servicesPath, filepath.Base(service.path))
+		if serviceName != "" && filepath.Base(service.path) != serviceName {
+			continue
+		}
+		if len(service.resources) == 0 {
+			continue
+		}
+
+		serviceFailures, err := generateStateUpgraderTestsForService(directory, *service, importIds)
+		if err != nil {
+			return fmt.Errorf("generating the State Upgrader tests for %q: %+v", service.path, err)
+		}
+		failed = append(failed, serviceFailures...)
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("the State Upgraders couldn't be verified for %d Resources - update the state, `droppedAttributes` or `skipStateUpgradeReason` within the Snapshot and re-run this tool:\n\n%s", len(failed), strings.Join(failed, "\n"))
+	}
+
+	return nil
+}
+
+// generateStateUpgraderTestsForService generates the Snapshots and tests for each Resource within the Service Package,
+// returning a description of each Resource where the State Upgraders couldn't be verified using the Snapshot
+func generateStateUpgraderTestsForService(directory string, service servicePackage, importIds map[string]importids.Resource) ([]string, error) {
+	resourceTypes := make([]string, 0)
+	for resourceType := range service.resources {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	fileName := filepath.Join(directory, "state_upgraders_gen_test.go")
+
+	failed := make([]string, 0)
+	for _, resourceType := range resourceTypes {
+		resource := service.resources[resourceType]
+		path := filepath.Join(directory, stateupgrades.SnapshotPath(resourceType))
+
+		var existing *stateupgrades.Snapshot
+		if _, err := os.Stat(path); err == nil {
+			if existing, err = stateupgrades.LoadSnapshot(path); err != nil {
+				return nil, err
+			}
+		}
+
+		exampleResourceId, _ := importIds[resourceType].ExampleID()
+		snapshot, err := stateupgrades.BuildSnapshot(resourceType, resource, exampleResourceId, existing)
+		if err != nil {
+			return nil, fmt.Errorf("building the Snapshot for %q: %+v", resourceType, err)
+		}
+		if err := snapshot.Save(path); err != nil {
+			return nil, fmt.Errorf("saving the Snapshot for %q: %+v", resourceType, err)
+		}
+
+		// a test is generated for every Resource, where the Snapshot can't be verified the state needs updating by hand
+		if errs := snapshot.Verify(resource); len(errs) > 0 {
+			messages := make([]string, 0)
+			for _, err := range errs {
+				messages = append(messages, fmt.Sprintf("  * %+v", err))
+			}
+			failed = append(failed, fmt.Sprintf("%s (%s):\n%s", resourceType, path, strings.Join(messages, "\n")))
+		}
+	}
+
+	// the file is built by hand rather than via jen so that the imports are grouped as `goimports` expects
+	tests := make([]string, 0)
+	for _, resourceType := range resourceTypes {
+		tests = append(tests, fmt.Sprintf(`func Test%s_stateUpgraders(t *testing.T) {
+	stateupgrades.Test(t, %s.%s{}, %q)
+}
+`, testNameForResourceType(resourceType), service.name, service.registrationName, resourceType))
+	}
+
+	contents := fmt.Sprintf(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// NOTE: this file is generated via 'generator-schema-snapshot -state-upgrader-tests' - manual changes will be overwritten
+
+package %[1]s_test
+
+import (
+	"testing"
+
+	"%[2]s"
+	"%[3]s"
+)
+
+%[4]s`, service.name, service.path, StateUpgradesPath, strings.Join(tests, "\n"))
+
+	formatted, err := format.Source([]byte(contents))
+	if err != nil {
+		return nil, fmt.Errorf("formatting %q: %+v", fileName, err)
+	}
+
+	log.Printf("Generating %q..", fileName)
+	if err := os.WriteFile(fileName, formatted, 0644); err != nil {
+		return nil, err
+	}
+	return failed, nil
+}
+
+// testNameForResourceType returns the name used for the test, e.g. `UserAssignedIdentity` for `azurerm_user_assigned_identity`
+func testNameForResourceType(resourceType string) string {
+	output := ""
+	for _, segment := range strings.Split(strings.TrimPrefix(resourceType, "azurerm_"), "_") {
+		if segment == "" {
+			continue
+		}
+		output += strings.ToUpper(segment[:1]) + segment[1:]
+	}
+	return output
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package importids contains the Resource ID used to import each Resource within the Provider, for use by the tooling
+// (for example to determine which Resource can import a given Azure Resource ID).
+//
+// Typed Resources expose the function used to validate the Resource ID via `IDValidationFunc()`, however Untyped Resources
+// define this inline within their Importer - as such the Resource ID used by each Untyped Resource is listed explicitly
+// within `untypedResources`, which should be updated when a new Untyped Resource is added.
+package importids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/identityprovider"
+	dnsRecordsets "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/configurationassignments"
+	privatednsRecordsets "github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2022-04-01/endpoints"
+	applicationinsightsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/resourceidexample"
+)
+
+// Resource describes the Resource ID used to import a Resource
+type Resource struct {
+	// Validate returns an error if the Resource ID can't be imported by this Resource
+	Validate pluginsdk.IDValidationFunc
+
+	// Type is the type of Resource ID used by this Resource, which is nil when it isn't known
+	Type resourceids.Id
+}
+
+// ValidateID returns an error if the specified Resource ID can't be imported by this Resource.
+//
+// Since some validation functions assume the Resource ID has a given format (e.g. indexing into the segments
+// of a composite Resource ID) any panic raised whilst validating the Resource ID is returned as an error.
+func (r Resource) ValidateID(id string) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("validating %q: %+v", id, v)
+		}
+	}()
+
+	if r.Validate == nil {
+		return fmt.Errorf("the Resource ID used for import isn't known")
+	}
+
+	return r.Validate(id)
+}
+
+// ExampleID returns an example of the Resource ID used to import this Resource, built from the type of Resource ID.
+//
+// This returns false when the type of Resource ID isn't known, or when the example can't be imported by this Resource.
+func (r Resource) ExampleID() (string, bool) {
+	example, ok := resourceidexample.FromResourceIdType(r.Type)
+	if !ok {
+		return "", false
+	}
+
+	if err := r.ValidateID(example); err != nil {
+		return "", false
+	}
+
+	return example, true
+}
+
+// parser returns an IDValidationFunc which validates the Resource ID using the specified Resource ID parser
+func parser[T any](parse func(input string) (*T, error)) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		_, err := parse(id)
+		return err
+	}
+}
+
+// schemaValidateFunc returns an IDValidationFunc which validates the Resource ID using the specified SchemaValidateFunc
+func schemaValidateFunc(validateFunc pluginsdk.SchemaValidateFunc) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		if _, errs := validateFunc(id, "id"); len(errs) > 0 {
+			return errs[0]
+		}
+		return nil
+	}
+}
+
+// dnsRecordType returns an IDValidationFunc which validates the Resource ID is a DNS Record Set of the specified type,
+// since each type of DNS Record Set is managed by a different Resource
+func dnsRecordType(recordType dnsRecordsets.RecordType) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		parsed, err := dnsRecordsets.ParseRecordTypeID(id)
+		if err != nil {
+			return err
+		}
+		if parsed.RecordType != recordType {
+			return fmt.Errorf("expected a %q Record Set but got %q", string(recordType), string(parsed.RecordType))
+		}
+		return nil
+	}
+}
+
+// privateDnsRecordType returns an IDValidationFunc which validates the Resource ID is a Private DNS Record Set of the
+// specified type, since each type of Private DNS Record Set is managed by a different Resource
+func privateDnsRecordType(recordType privatednsRecordsets.RecordType) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		parsed, err := privatednsRecordsets.ParseRecordTypeID(id)
+		if err != nil {
+			return err
+		}
+		if parsed.RecordType != recordType {
+			return fmt.Errorf("expected a %q Record Set but got %q", string(recordType), string(parsed.RecordType))
+		}
+		return nil
+	}
+}
+
+// apiManagementIdentityProviderType returns an IDValidationFunc which validates the Resource ID is an API Management
+// Identity Provider of the specified type, since each type of Identity Provider is managed by a different Resource
+func apiManagementIdentityProviderType(providerType identityprovider.IdentityProviderType) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		parsed, err := identityprovider.ParseIdentityProviderID(id)
+		if err != nil {
+			return err
+		}
+		if parsed.IdentityProviderName != providerType {
+			return fmt.Errorf("expected a %q Identity Provider but got %q", string(providerType), string(parsed.IdentityProviderName))
+		}
+		return nil
+	}
+}
+
+// trafficManagerEndpointType returns an IDValidationFunc which validates the Resource ID is a Traffic Manager Endpoint
+// of the specified type, since each type of Endpoint is managed by a different Resource
+func trafficManagerEndpointType(endpointType endpoints.EndpointType) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		parsed, err := endpoints.ParseEndpointTypeID(id)
+		if err != nil {
+			return err
+		}
+		if parsed.EndpointType != endpointType {
+			return fmt.Errorf("expected a %q Endpoint but got %q", string(endpointType), string(parsed.EndpointType))
+		}
+		return nil
+	}
+}
+
+// maintenanceAssignmentScope returns an IDValidationFunc which validates the Resource ID is a Maintenance Configuration
+// Assignment scoped to the type of Resource parsed by parseScope, since each type of scope is managed by a different Resource
+func maintenanceAssignmentScope[T any](parseScope func(input string) (*T, error)) pluginsdk.IDValidationFunc {
+	return func(id string) error {
+		parsed, err := configurationassignments.ParseScopedConfigurationAssignmentID(id)
+		if err != nil {
+			return err
+		}
+		if _, err := parseScope(parsed.Scope); err != nil {
+			return fmt.Errorf("parsing the scope %q: %+v", parsed.Scope, err)
+		}
+		return nil
+	}
+}
+
+// applicationInsightsAnalyticsItem validates the Resource ID is either a Shared or User Analytics Item, both of which
+// are managed by the same Resource
+func applicationInsightsAnalyticsItem(id string) error {
+	if strings.Contains(id, "myAnalyticsItems") {
+		_, err := applicationinsightsParse.AnalyticsUserItemID(id)
+		return err
+	}
+
+	_, err := applicationinsightsParse.AnalyticsSharedItemID(id)
+	return err
+}
+
+// exampleScope returns an example of the specified type of Resource ID, for use as the scope of a Scoped Resource ID
+func exampleScope(id resourceids.Id) string {
+	example, _ := resourceidexample.FromResourceIdType(id)
+	return example
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importids
+
+import (
+	"strings"
+	"testing"
+
+	dnsRecordsets "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+)
+
+func TestResourceValidateID(t *testing.T) {
+	aRecordId := dnsRecordsets.NewRecordTypeID("12345678-1234-9876-4563-123456789012", "example-resource-group", "example.com", dnsRecordsets.RecordTypeA, "www").ID()
+	cnameRecordId := dnsRecordsets.NewRecordTypeID("12345678-1234-9876-4563-123456789012", "example-resource-group", "example.com", dnsRecordsets.RecordTypeCNAME, "www").ID()
+
+	cases := []struct {
+		name     string
+		resource Resource
+		id       string
+		valid    bool
+	}{
+		{
+			name:     "parser",
+			resource: Resource{Validate: parser(dnsRecordsets.ParseDnsZoneID)},
+			id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Network/dnsZones/example.com",
+			valid:    true,
+		},
+		{
+			name:     "parser with a different resource id",
+			resource: Resource{Validate: parser(dnsRecordsets.ParseDnsZoneID)},
+			id:       aRecordId,
+		},
+		{
+			name:     "record type",
+			resource: Resource{Validate: dnsRecordType(dnsRecordsets.RecordTypeA)},
+			id:       aRecordId,
+			valid:    true,
+		},
+		{
+			name:     "different record type",
+			resource: Resource{Validate: dnsRecordType(dnsRecordsets.RecordTypeA)},
+			id:       cnameRecordId,
+		},
+		{
+			name:     "unknown",
+			resource: Resource{},
+			id:       aRecordId,
+		},
+		{
+			name: "panicking validation function",
+			resource: Resource{Validate: func(id string) error {
+				_ = strings.Split(id, "|")[1]
+				return nil
+			}},
+			id: aRecordId,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			err := v.resource.ValidateID(v.id)
+			if v.valid && err != nil {
+				t.Fatalf("expected %q to be valid but got: %+v", v.id, err)
+			}
+			if !v.valid && err == nil {
+				t.Fatalf("expected %q to be invalid", v.id)
+			}
+		})
+	}
+}
+
+func TestResourceExampleID(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource Resource
+		expected string
+	}{
+		{
+			name: "record type",
+			resource: Resource{
+				Validate: dnsRecordType(dnsRecordsets.RecordTypeCNAME),
+				Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeCNAME},
+			},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Network/dnsZones/dnsZoneValue/CNAME/relativeRecordSetValue",
+		},
+		{
+			name: "type unknown",
+			resource: Resource{
+				Validate: parser(dnsRecordsets.ParseDnsZoneID),
+			},
+		},
+		{
+			name: "example can't be imported",
+			resource: Resource{
+				Validate: dnsRecordType(dnsRecordsets.RecordTypeCNAME),
+				Type:     dnsRecordsets.RecordTypeId{},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			actual, ok := v.resource.ExampleID()
+			if ok != (v.expected != "") {
+				t.Fatalf("expected ok to be %t but got %t (%q)", v.expected != "", ok, actual)
+			}
+			if actual != v.expected {
+				t.Fatalf("expected %q but got %q", v.expected, actual)
+			}
+		})
+	}
+}
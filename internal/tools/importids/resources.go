@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importids
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+// Resources returns the Resource ID used to import each of the Typed and Untyped Resources registered within the Provider.
+//
+// Untyped Resources which aren't listed within `untypedResources` (for example those using a custom Importer) aren't included.
+func Resources() map[string]Resource {
+	output := make(map[string]Resource)
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			output[r.ResourceType()] = Resource{
+				Validate: schemaValidateFunc(r.IDValidationFunc()),
+				Type:     typedResourceIdTypes[r.ResourceType()],
+			}
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for k, v := range service.SupportedResources() {
+			if v.Importer == nil {
+				continue
+			}
+
+			if resource, ok := untypedResources[k]; ok {
+				output[k] = resource
+			}
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importids
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// untypedResourcesNotListed are the Untyped Resources with an Importer which intentionally aren't listed within
+// `untypedResources`, since the ID they import can't be mapped from an Azure Resource ID
+var untypedResourcesNotListed = map[string]string{
+	"azurerm_app_service_source_control_token":                                       "the ID is the type of Source Control",
+	"azurerm_monitor_diagnostic_setting":                                             "the ID is in the format `{resourceId}|{name}`",
+	"azurerm_network_interface_application_gateway_backend_address_pool_association": "the ID is in the format `{ipConfigurationId}|{backendAddressPoolId}`",
+	"azurerm_network_interface_application_security_group_association":               "the ID is in the format `{networkInterfaceId}|{applicationSecurityGroupId}`",
+	"azurerm_network_interface_backend_address_pool_association":                     "the ID is in the format `{ipConfigurationId}|{backendAddressPoolId}`",
+	"azurerm_network_interface_nat_rule_association":                                 "the ID is in the format `{ipConfigurationId}|{inboundNatRuleId}`",
+	"azurerm_network_interface_security_group_association":                           "the ID is in the format `{networkInterfaceId}|{networkSecurityGroupId}`",
+	"azurerm_security_center_server_vulnerability_assessment":                        "the Importer doesn't validate the ID",
+}
+
+func TestResourcesAreRegistered(t *testing.T) {
+	typed := make(map[string]struct{})
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			typed[r.ResourceType()] = struct{}{}
+		}
+	}
+
+	untyped := make(map[string]struct{})
+	for _, service := range provider.SupportedUntypedServices() {
+		for k, v := range service.SupportedResources() {
+			if v.Importer == nil {
+				continue
+			}
+			untyped[k] = struct{}{}
+
+			_, listed := untypedResources[k]
+			_, notListed := untypedResourcesNotListed[k]
+			if !listed && !notListed {
+				t.Errorf("%q is an Untyped Resource with an Importer but isn't listed within `untypedResources`", k)
+			}
+			if listed && notListed {
+				t.Errorf("%q is listed within both `untypedResources` and `untypedResourcesNotListed`", k)
+			}
+		}
+	}
+
+	for k := range typedResourceIdTypes {
+		if _, ok := typed[k]; !ok {
+			t.Errorf("%q is listed within `typedResourceIdTypes` but isn't a registered Typed Resource", k)
+		}
+	}
+
+	for k := range untypedResourcesNotListed {
+		if _, ok := untyped[k]; !ok {
+			t.Errorf("%q is listed within `untypedResourcesNotListed` but isn't a registered Untyped Resource with an Importer", k)
+		}
+	}
+
+	for k, v := range untypedResources {
+		if _, ok := untyped[k]; !ok {
+			t.Errorf("%q is listed within `untypedResources` but isn't a registered Untyped Resource with an Importer", k)
+		}
+		if v.Validate == nil {
+			t.Errorf("%q is listed within `untypedResources` but doesn't specify a `Validate` function", k)
+		}
+	}
+}
+
+func TestResourcesExampleID(t *testing.T) {
+	for k, v := range Resources() {
+		if v.Type == nil {
+			continue
+		}
+
+		if _, ok := v.ExampleID(); !ok {
+			t.Errorf("an example Resource ID couldn't be generated for %q from %T", k, v.Type)
+		}
+	}
+}
+
+func TestUntypedResourcesImportExampleID(t *testing.T) {
+	// each Importer logs the Resource ID it's parsing
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for k, v := range service.SupportedResources() {
+			resource, ok := untypedResources[k]
+			if !ok || resource.Type == nil {
+				continue
+			}
+
+			example, ok := resource.ExampleID()
+			if !ok {
+				// this is covered by TestResourcesExampleID
+				continue
+			}
+
+			if err := importID(v, example); err != nil {
+				t.Errorf("the example Resource ID for %q is valid within `untypedResources` but is rejected by the Importer: %+v", k, err)
+			}
+		}
+	}
+}
+
+// importID runs the Importer for the Resource with the specified Resource ID, returning the error raised when the
+// Importer rejects the Resource ID.
+//
+// Since no Provider is configured a panic raised by the Importer (which happens once the Resource ID has been
+// validated, when an Importer looks up the Resource) is treated as the Resource ID being valid.
+func importID(resource *pluginsdk.Resource, id string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = nil
+		}
+	}()
+
+	d := resource.TestResourceData()
+	d.SetId(id)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := resource.Importer.StateContext(ctx, d, nil); err != nil {
+		return fmt.Errorf("importing %q: %+v", id, err)
+	}
+	return nil
+}
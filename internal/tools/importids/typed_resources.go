@@ -0,0 +1,461 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importids
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/aadb2c/2021-04-01-preview/tenants"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2023-03-01/prometheusrulegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-11-20/workbooktemplatesapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2022-04-01/workbooksapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2023-11-01-preview/appplatform"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2019-06-01/softwareupdateconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2020-01-13-preview/watcher"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/connectiontype"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworker"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/hybridrunbookworkergroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/module"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/python3package"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/sourcecontrol"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/experiments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplications"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryapplicationversions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/certificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/containerapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/daprcomponents"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2023-05-01/managedenvironmentsstorages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/connectedregistries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/cacherules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-06-01-preview/credentialsets"
+	containerserviceMaintenanceconfigurations "github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-09-02-preview/maintenanceconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/fleetupdatestrategies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/updateruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/sqldedicatedgateway"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/costmanagement/2022-10-01/scheduledactions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dashboard/2023-09-01/grafanaresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/devices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2023-05-01/backuppolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2023-04-01/galleries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/timeseriesdatabaseconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsforwardingrulesets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/forwardingrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
+	dnsresolverVirtualnetworklinks "github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/virtualnetworklinks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/consumergroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/fluidrelay/2022-05-26/fluidrelayservers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/graphservices/2023-04-13/graphservicesprods"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machineextensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/privatelinkscopes"
+	insightsScheduledqueryrules_v2021_08_01 "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionruleassociations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/dataconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/lab"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/labplan"
+	labservicesSchedule "github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/schedule"
+	labservicesUser "github.com/hashicorp/go-azure-sdk/resource-manager/labservices/2022-08-01/user"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2023-10-01/datastore"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2023-01-31/managedidentities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/accountfilters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/attacheddatanetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/datanetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcorecontrolplane"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/packetcoredataplane"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/service"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/sim"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simgroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/simpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/site"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mobilenetwork/2022-11-01/slice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/netappaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumequotarules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/adminrulecollections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/adminrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/connectivityconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/networkgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/networkmanagerconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/networkmanagers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/networkvirtualappliances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/scopeconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/securityadminconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/staticmembers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/virtualwans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
+	newrelicMonitors "github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2022-07-01/monitors"
+	newrelicTagrules "github.com/hashicorp/go-azure-sdk/resource-manager/newrelic/2022-07-01/tagrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2023-09-01/nginxcertificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2023-09-01/nginxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2023-09-01/nginxdeployment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypackqueries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationsmanagement/2015-11-01-preview/solution"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contact"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contactprofile"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/spacecraft"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/certificateobjectlocalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/fqdnlistlocalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/localrulestacks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2022-08-29/prefixlistlocalrulestack"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2023-09-01/firewalls"
+	postgresqlhscConfigurations "github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/configurations"
+	postgresqlhscFirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/firewallrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresqlhsc/2022-11-08/roles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectionpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/resourceguardproxy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationfabrics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationnetworkmappings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotectioncontainermappings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationrecoveryplans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redhatopenshift/2023-09-04/openshiftclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resourceconnector/2022-10-27/appliances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/privatelinkassociation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-10-01/deploymentscripts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/sharedprivatelinkresources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/metadata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlistitems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabricmanagedcluster/2021-05-01/managedcluster"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2022-05-01/servicelinker"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-05-01-preview/associationsinterface"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-05-01-preview/frontendsinterface"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2023-05-01-preview/trafficcontrollerinterface"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2023-02-01/signalr"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/availabilitygrouplisteners"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/localusers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/amlfilesystems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/agents"
+	storagemoverEndpoints "github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/endpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/jobdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/projects"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagemover/2023-03-01/storagemovers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagepool/2021-08-01/iscsitargets"
+	streamanalyticsClusters "github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/inputs"
+	streamanalyticsPrivateendpoints "github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/privateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/datastores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/communicationsgateways"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/voiceservices/2023-04-03/testlines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
+	apimanagementParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	appserviceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	automanageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/automanage/parse"
+	batchParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	botParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	containerappsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/parse"
+	containersParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	costmanagementParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
+	domainservicesParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/parse"
+	iotcentralParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/parse"
+	iothubParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
+	loadbalancerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	mssqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	mssqlmanagedinstanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
+	mysqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	policyParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	recoveryservicesParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	sentinelParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	springcloudParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	streamanalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
+	webParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+)
+
+// typedResourceIdTypes is the Resource ID type used by each of the Typed Resources - the function used to validate the
+// Resource ID is exposed by the Resource via `IDValidationFunc()`, however the Resource ID type isn't.
+//
+// Any fields which can only have a single value (e.g. the Password Name of a Container Registry Token Password, or the
+// type of Source Control for a Source Control Token) are set, so that the example Resource ID can be imported.
+var typedResourceIdTypes = map[string]resourceids.Id{
+	"azurerm_aadb2c_directory":                                                     tenants.B2CDirectoryId{},
+	"azurerm_active_directory_domain_service_trust":                                domainservicesParse.DomainServiceTrustId{},
+	"azurerm_api_management_notification_recipient_email":                          apimanagementParse.NotificationRecipientEmailId{},
+	"azurerm_api_management_notification_recipient_user":                           apimanagementParse.NotificationRecipientUserId{},
+	"azurerm_app_service_connection":                                               servicelinker.ScopedLinkerId{},
+	"azurerm_app_service_environment_v3":                                           webParse.AppServiceEnvironmentId{},
+	"azurerm_application_insights_workbook":                                        workbooksapis.WorkbookId{},
+	"azurerm_application_insights_workbook_template":                               workbooktemplatesapis.WorkbookTemplateId{},
+	"azurerm_application_load_balancer":                                            trafficcontrollerinterface.TrafficControllerId{},
+	"azurerm_application_load_balancer_frontend":                                   frontendsinterface.FrontendId{},
+	"azurerm_application_load_balancer_subnet_association":                         associationsinterface.AssociationId{},
+	"azurerm_arc_machine_extension":                                                machineextensions.ExtensionId{},
+	"azurerm_arc_private_link_scope":                                               privatelinkscopes.ProviderPrivateLinkScopeId{},
+	"azurerm_arc_resource_bridge_appliance":                                        appliances.ApplianceId{},
+	"azurerm_automanage_configuration":                                             automanageParse.AutomanageConfigurationId{},
+	"azurerm_automation_connection_type":                                           connectiontype.ConnectionTypeId{},
+	"azurerm_automation_hybrid_runbook_worker":                                     hybridrunbookworker.HybridRunbookWorkerId{},
+	"azurerm_automation_hybrid_runbook_worker_group":                               hybridrunbookworkergroup.HybridRunbookWorkerGroupId{},
+	"azurerm_automation_powershell72_module":                                       module.PowerShell72ModuleId{},
+	"azurerm_automation_python3_package":                                           python3package.Python3PackageId{},
+	"azurerm_automation_software_update_configuration":                             softwareupdateconfiguration.SoftwareUpdateConfigurationId{},
+	"azurerm_automation_source_control":                                            sourcecontrol.SourceControlId{},
+	"azurerm_automation_watcher":                                                   watcher.WatcherId{},
+	"azurerm_backup_policy_vm_workload":                                            protectionpolicies.BackupPolicyId{},
+	"azurerm_batch_job":                                                            batchParse.JobId{},
+	"azurerm_billing_account_cost_management_export":                               costmanagementParse.BillingAccountCostManagementExportId{},
+	"azurerm_bot_service_azure_bot":                                                botParse.BotServiceId{},
+	"azurerm_chaos_studio_capability":                                              commonids.ChaosStudioCapabilityId{},
+	"azurerm_chaos_studio_experiment":                                              experiments.ExperimentId{},
+	"azurerm_cognitive_deployment":                                                 deployments.DeploymentId{},
+	"azurerm_consumption_budget_management_group":                                  budgets.ScopedBudgetId{},
+	"azurerm_consumption_budget_resource_group":                                    budgets.ScopedBudgetId{},
+	"azurerm_consumption_budget_subscription":                                      budgets.ScopedBudgetId{},
+	"azurerm_container_app":                                                        containerapps.ContainerAppId{},
+	"azurerm_container_app_custom_domain":                                          containerappsParse.ContainerAppCustomDomainId{},
+	"azurerm_container_app_environment":                                            managedenvironments.ManagedEnvironmentId{},
+	"azurerm_container_app_environment_certificate":                                certificates.CertificateId{},
+	"azurerm_container_app_environment_dapr_component":                             daprcomponents.DaprComponentId{},
+	"azurerm_container_app_environment_storage":                                    managedenvironmentsstorages.StorageId{},
+	"azurerm_container_app_job":                                                    jobs.JobId{},
+	"azurerm_container_connected_registry":                                         connectedregistries.ConnectedRegistryId{},
+	"azurerm_container_registry_cache_rule":                                        cacherules.CacheRuleId{},
+	"azurerm_container_registry_credential_set":                                    credentialsets.CredentialSetId{},
+	"azurerm_container_registry_export_policy":                                     containersParse.ContainerRegistryExportPolicyId{ExportPolicyName: "default"},
+	"azurerm_container_registry_retention_policy":                                  containersParse.ContainerRegistryRetentionPolicyId{RetentionPolicyName: "default"},
+	"azurerm_container_registry_task":                                              tasks.TaskId{},
+	"azurerm_container_registry_task_schedule_run_now":                             containersParse.ContainerRegistryTaskScheduleId{},
+	"azurerm_container_registry_token_password":                                    containersParse.ContainerRegistryTokenPasswordId{PasswordName: "password"},
+	"azurerm_cosmosdb_mongo_role_definition":                                       mongorbacs.MongodbRoleDefinitionId{},
+	"azurerm_cosmosdb_mongo_user_definition":                                       mongorbacs.MongodbUserDefinitionId{},
+	"azurerm_cosmosdb_postgresql_coordinator_configuration":                        postgresqlhscConfigurations.CoordinatorConfigurationId{},
+	"azurerm_cosmosdb_postgresql_firewall_rule":                                    postgresqlhscFirewallrules.FirewallRuleId{},
+	"azurerm_cosmosdb_postgresql_node_configuration":                               postgresqlhscConfigurations.NodeConfigurationId{},
+	"azurerm_cosmosdb_postgresql_role":                                             roles.RoleId{},
+	"azurerm_cosmosdb_sql_dedicated_gateway":                                       sqldedicatedgateway.ServiceId{},
+	"azurerm_cost_management_scheduled_action":                                     scheduledactions.ScopedScheduledActionId{},
+	"azurerm_dashboard_grafana":                                                    grafanaresource.GrafanaId{},
+	"azurerm_data_protection_backup_policy_kubernetes_cluster":                     backuppolicies.BackupPolicyId{},
+	"azurerm_databox_edge_device":                                                  devices.DataBoxEdgeDeviceId{},
+	"azurerm_databricks_access_connector":                                          accessconnector.AccessConnectorId{},
+	"azurerm_dev_center_gallery":                                                   galleries.GalleryId{},
+	"azurerm_digital_twins_time_series_database_connection":                        timeseriesdatabaseconnections.TimeSeriesDatabaseConnectionId{},
+	"azurerm_disk_pool_iscsi_target":                                               iscsitargets.IscsiTargetId{},
+	"azurerm_eventhub_consumer_group":                                              consumergroups.ConsumerGroupId{},
+	"azurerm_federated_identity_credential":                                        managedidentities.FederatedIdentityCredentialId{},
+	"azurerm_fluid_relay_server":                                                   fluidrelayservers.FluidRelayServerId{},
+	"azurerm_function_app_connection":                                              servicelinker.ScopedLinkerId{},
+	"azurerm_function_app_function":                                                webapps.FunctionId{},
+	"azurerm_function_app_hybrid_connection":                                       webapps.RelayId{},
+	"azurerm_gallery_application":                                                  galleryapplications.ApplicationId{},
+	"azurerm_gallery_application_version":                                          galleryapplicationversions.ApplicationVersionId{},
+	"azurerm_graph_account":                                                        graphservicesprods.AccountId{},
+	"azurerm_iotcentral_application_network_rule_set":                              apps.IotAppId{},
+	"azurerm_iotcentral_organization":                                              iotcentralParse.OrganizationId{},
+	"azurerm_iothub_device_update_account":                                         deviceupdates.AccountId{},
+	"azurerm_iothub_device_update_instance":                                        deviceupdates.InstanceId{},
+	"azurerm_iothub_endpoint_cosmosdb_account":                                     iothubParse.EndpointCosmosDBAccountId{},
+	"azurerm_iothub_file_upload":                                                   iothubParse.IotHubId{},
+	"azurerm_kubernetes_cluster_maintenance_configuration":                         containerserviceMaintenanceconfigurations.MaintenanceConfigurationId{},
+	"azurerm_kubernetes_fleet_update_run":                                          updateruns.UpdateRunId{},
+	"azurerm_kubernetes_fleet_update_strategy":                                     fleetupdatestrategies.UpdateStrategyId{},
+	"azurerm_kusto_cosmosdb_data_connection":                                       dataconnections.DataConnectionId{},
+	"azurerm_lab_service_lab":                                                      lab.LabId{},
+	"azurerm_lab_service_plan":                                                     labplan.LabPlanId{},
+	"azurerm_lab_service_schedule":                                                 labservicesSchedule.ScheduleId{},
+	"azurerm_lab_service_user":                                                     labservicesUser.UserId{},
+	"azurerm_lb_backend_address_pool_address":                                      loadbalancerParse.BackendAddressPoolAddressId{},
+	"azurerm_linux_function_app_slot":                                              webapps.SlotId{},
+	"azurerm_linux_web_app":                                                        commonids.AppServiceId{},
+	"azurerm_linux_web_app_slot":                                                   webapps.SlotId{},
+	"azurerm_log_analytics_query_pack":                                             querypacks.QueryPackId{},
+	"azurerm_log_analytics_query_pack_query":                                       querypackqueries.QueryId{},
+	"azurerm_log_analytics_solution":                                               solution.SolutionId{},
+	"azurerm_log_analytics_workspace_table":                                        tables.TableId{},
+	"azurerm_machine_learning_datastore_blobstorage":                               datastore.DataStoreId{},
+	"azurerm_machine_learning_datastore_datalake_gen2":                             datastore.DataStoreId{},
+	"azurerm_machine_learning_datastore_fileshare":                                 datastore.DataStoreId{},
+	"azurerm_managed_lustre_file_system":                                           amlfilesystems.AmlFilesystemId{},
+	"azurerm_management_group_policy_assignment":                                   policyParse.ManagementGroupAssignmentId{},
+	"azurerm_media_services_account_filter":                                        accountfilters.AccountFilterId{},
+	"azurerm_mobile_network":                                                       mobilenetwork.MobileNetworkId{},
+	"azurerm_mobile_network_attached_data_network":                                 attacheddatanetwork.AttachedDataNetworkId{},
+	"azurerm_mobile_network_data_network":                                          datanetwork.DataNetworkId{},
+	"azurerm_mobile_network_packet_core_control_plane":                             packetcorecontrolplane.PacketCoreControlPlaneId{},
+	"azurerm_mobile_network_packet_core_data_plane":                                packetcoredataplane.PacketCoreDataPlaneId{},
+	"azurerm_mobile_network_service":                                               service.ServiceId{},
+	"azurerm_mobile_network_sim":                                                   sim.SimId{},
+	"azurerm_mobile_network_sim_group":                                             simgroup.SimGroupId{},
+	"azurerm_mobile_network_sim_policy":                                            simpolicy.SimPolicyId{},
+	"azurerm_mobile_network_site":                                                  site.SiteId{},
+	"azurerm_mobile_network_slice":                                                 slice.SliceId{},
+	"azurerm_monitor_alert_processing_rule_action_group":                           alertprocessingrules.ActionRuleId{},
+	"azurerm_monitor_alert_processing_rule_suppression":                            alertprocessingrules.ActionRuleId{},
+	"azurerm_monitor_alert_prometheus_rule_group":                                  prometheusrulegroups.PrometheusRuleGroupId{},
+	"azurerm_monitor_data_collection_endpoint":                                     datacollectionendpoints.DataCollectionEndpointId{},
+	"azurerm_monitor_data_collection_rule":                                         datacollectionrules.DataCollectionRuleId{},
+	"azurerm_monitor_data_collection_rule_association":                             datacollectionruleassociations.ScopedDataCollectionRuleAssociationId{},
+	"azurerm_monitor_scheduled_query_rules_alert_v2":                               insightsScheduledqueryrules_v2021_08_01.ScheduledQueryRuleId{},
+	"azurerm_monitor_workspace":                                                    azuremonitorworkspaces.AccountId{},
+	"azurerm_mssql_failover_group":                                                 mssqlParse.FailoverGroupId{},
+	"azurerm_mssql_managed_database":                                               mssqlmanagedinstanceParse.ManagedDatabaseId{},
+	"azurerm_mssql_managed_instance":                                               mssqlmanagedinstanceParse.ManagedInstanceId{},
+	"azurerm_mssql_managed_instance_active_directory_administrator":                mssqlmanagedinstanceParse.ManagedInstanceAzureActiveDirectoryAdministratorId{},
+	"azurerm_mssql_managed_instance_failover_group":                                mssqlmanagedinstanceParse.ManagedInstanceFailoverGroupId{},
+	"azurerm_mssql_server_dns_alias":                                               mssqlParse.ServerDNSAliasId{},
+	"azurerm_mssql_virtual_machine_availability_group_listener":                    availabilitygrouplisteners.AvailabilityGroupListenerId{},
+	"azurerm_mssql_virtual_machine_group":                                          availabilitygrouplisteners.SqlVirtualMachineGroupId{},
+	"azurerm_mysql_flexible_server_active_directory_administrator":                 mysqlParse.FlexibleServerAzureActiveDirectoryAdministratorId{},
+	"azurerm_netapp_account_encryption":                                            netappaccounts.NetAppAccountId{},
+	"azurerm_netapp_volume_group_sap_hana":                                         volumegroups.VolumeGroupId{},
+	"azurerm_netapp_volume_quota_rule":                                             volumequotarules.VolumeQuotaRuleId{},
+	"azurerm_network_function_azure_traffic_collector":                             azuretrafficcollectors.AzureTrafficCollectorId{},
+	"azurerm_network_function_collector_policy":                                    collectorpolicies.CollectorPolicyId{},
+	"azurerm_network_manager":                                                      networkmanagers.NetworkManagerId{},
+	"azurerm_network_manager_admin_rule":                                           adminrules.RuleId{},
+	"azurerm_network_manager_admin_rule_collection":                                adminrulecollections.RuleCollectionId{},
+	"azurerm_network_manager_connectivity_configuration":                           connectivityconfigurations.ConnectivityConfigurationId{},
+	"azurerm_network_manager_management_group_connection":                          networkmanagerconnections.Providers2NetworkManagerConnectionId{},
+	"azurerm_network_manager_network_group":                                        networkgroups.NetworkGroupId{},
+	"azurerm_network_manager_scope_connection":                                     scopeconnections.ScopeConnectionId{},
+	"azurerm_network_manager_security_admin_configuration":                         securityadminconfigurations.SecurityAdminConfigurationId{},
+	"azurerm_network_manager_static_member":                                        staticmembers.StaticMemberId{},
+	"azurerm_network_manager_subscription_connection":                              networkmanagerconnections.NetworkManagerConnectionId{},
+	"azurerm_new_relic_monitor":                                                    newrelicMonitors.MonitorId{},
+	"azurerm_new_relic_tag_rule":                                                   newrelicTagrules.TagRuleId{},
+	"azurerm_nginx_certificate":                                                    nginxcertificate.CertificateId{},
+	"azurerm_nginx_configuration":                                                  nginxconfiguration.ConfigurationId{},
+	"azurerm_nginx_deployment":                                                     nginxdeployment.NginxDeploymentId{},
+	"azurerm_orbital_contact":                                                      contact.ContactId{},
+	"azurerm_orbital_contact_profile":                                              contactprofile.ContactProfileId{},
+	"azurerm_orbital_spacecraft":                                                   spacecraft.SpacecraftId{},
+	"azurerm_palo_alto_local_rulestack":                                            localrulestacks.LocalRulestackId{},
+	"azurerm_palo_alto_local_rulestack_certificate":                                certificateobjectlocalrulestack.LocalRulestackCertificateId{},
+	"azurerm_palo_alto_local_rulestack_fqdn_list":                                  fqdnlistlocalrulestack.LocalRulestackFqdnListId{},
+	"azurerm_palo_alto_local_rulestack_outbound_trust_certificate_association":     certificateobjectlocalrulestack.LocalRulestackCertificateId{},
+	"azurerm_palo_alto_local_rulestack_outbound_untrust_certificate_association":   certificateobjectlocalrulestack.LocalRulestackCertificateId{},
+	"azurerm_palo_alto_local_rulestack_prefix_list":                                prefixlistlocalrulestack.LocalRulestackPrefixListId{},
+	"azurerm_palo_alto_local_rulestack_rule":                                       localrules.LocalRuleId{},
+	"azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack":       firewalls.FirewallId{},
+	"azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama":              firewalls.FirewallId{},
+	"azurerm_palo_alto_next_generation_firewall_virtual_network_local_rulestack":   firewalls.FirewallId{},
+	"azurerm_palo_alto_next_generation_firewall_virtual_network_panorama":          firewalls.FirewallId{},
+	"azurerm_palo_alto_virtual_network_appliance":                                  networkvirtualappliances.NetworkVirtualApplianceId{},
+	"azurerm_private_dns_resolver":                                                 dnsresolvers.DnsResolverId{},
+	"azurerm_private_dns_resolver_dns_forwarding_ruleset":                          dnsforwardingrulesets.DnsForwardingRulesetId{},
+	"azurerm_private_dns_resolver_forwarding_rule":                                 forwardingrules.ForwardingRuleId{},
+	"azurerm_private_dns_resolver_inbound_endpoint":                                inboundendpoints.InboundEndpointId{},
+	"azurerm_private_dns_resolver_outbound_endpoint":                               outboundendpoints.OutboundEndpointId{},
+	"azurerm_private_dns_resolver_virtual_network_link":                            dnsresolverVirtualnetworklinks.VirtualNetworkLinkId{},
+	"azurerm_recovery_services_vault_resource_guard_association":                   resourceguardproxy.BackupResourceGuardProxyId{},
+	"azurerm_redhat_openshift_cluster":                                             openshiftclusters.ProviderOpenShiftClusterId{},
+	"azurerm_resource_deployment_script_azure_cli":                                 deploymentscripts.DeploymentScriptId{},
+	"azurerm_resource_deployment_script_azure_power_shell":                         deploymentscripts.DeploymentScriptId{},
+	"azurerm_resource_group_cost_management_export":                                costmanagementParse.ResourceGroupCostManagementExportId{},
+	"azurerm_resource_group_cost_management_view":                                  costmanagementParse.ResourceGroupCostManagementViewId{},
+	"azurerm_resource_group_policy_assignment":                                     policyParse.ResourceGroupAssignmentId{},
+	"azurerm_resource_management_private_link":                                     resourcemanagementprivatelink.ResourceManagementPrivateLinkId{},
+	"azurerm_resource_management_private_link_association":                         privatelinkassociation.PrivateLinkAssociationId{},
+	"azurerm_resource_provider_registration":                                       resourceParse.ResourceProviderId{},
+	"azurerm_route_map":                                                            networkParse.RouteMapId{},
+	"azurerm_search_shared_private_link_service":                                   sharedprivatelinkresources.SharedPrivateLinkResourceId{},
+	"azurerm_security_center_storage_defender":                                     commonids.StorageAccountId{},
+	"azurerm_sentinel_alert_rule_anomaly_built_in":                                 sentinelParse.MLAnalyticsSettingsId{},
+	"azurerm_sentinel_alert_rule_anomaly_duplicate":                                sentinelParse.MLAnalyticsSettingsId{},
+	"azurerm_sentinel_alert_rule_threat_intelligence":                              alertrules.AlertRuleId{},
+	"azurerm_sentinel_data_connector_aws_s3":                                       sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_dynamics_365":                                 sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_iot":                                          sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_microsoft_threat_intelligence":                sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_microsoft_threat_protection":                  sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_office_365_project":                           sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_office_irm":                                   sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_office_power_bi":                              sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_data_connector_threat_intelligence_taxii":                    sentinelParse.DataConnectorId{},
+	"azurerm_sentinel_log_analytics_workspace_onboarding":                          sentinelonboardingstates.OnboardingStateId{},
+	"azurerm_sentinel_metadata":                                                    metadata.MetadataId{},
+	"azurerm_sentinel_threat_intelligence_indicator":                               sentinelParse.ThreatIntelligenceIndicatorId{},
+	"azurerm_sentinel_watchlist":                                                   watchlists.WatchlistId{},
+	"azurerm_sentinel_watchlist_item":                                              watchlistitems.WatchlistItemId{},
+	"azurerm_service_fabric_managed_cluster":                                       managedcluster.ManagedClusterId{},
+	"azurerm_service_plan":                                                         commonids.AppServicePlanId{},
+	"azurerm_signalr_service_custom_certificate":                                   signalr.CustomCertificateId{},
+	"azurerm_signalr_service_custom_domain":                                        signalr.CustomDomainId{},
+	"azurerm_site_recovery_hyperv_network_mapping":                                 replicationnetworkmappings.ReplicationNetworkMappingId{},
+	"azurerm_site_recovery_hyperv_replication_policy":                              replicationpolicies.ReplicationPolicyId{},
+	"azurerm_site_recovery_hyperv_replication_policy_association":                  replicationprotectioncontainermappings.ReplicationProtectionContainerMappingId{},
+	"azurerm_site_recovery_replication_recovery_plan":                              replicationrecoveryplans.ReplicationRecoveryPlanId{},
+	"azurerm_site_recovery_services_vault_hyperv_site":                             replicationfabrics.ReplicationFabricId{},
+	"azurerm_site_recovery_vmware_replicated_vm":                                   replicationprotecteditems.ReplicationProtectedItemId{},
+	"azurerm_site_recovery_vmware_replication_policy":                              recoveryservicesParse.ReplicationPolicyId{},
+	"azurerm_site_recovery_vmware_replication_policy_association":                  recoveryservicesParse.ReplicationProtectionContainerMappingsId{},
+	"azurerm_source_control_token":                                                 appserviceParse.AppServiceSourceControlTokenId{Type: "GitHub"},
+	"azurerm_spring_cloud_accelerator":                                             springcloudParse.SpringCloudAcceleratorId{},
+	"azurerm_spring_cloud_api_portal":                                              appplatform.ApiPortalId{},
+	"azurerm_spring_cloud_app_dynamics_application_performance_monitoring":         appplatform.ApmId{},
+	"azurerm_spring_cloud_application_insights_application_performance_monitoring": appplatform.ApmId{},
+	"azurerm_spring_cloud_application_live_view":                                   springcloudParse.SpringCloudApplicationLiveViewId{},
+	"azurerm_spring_cloud_configuration_service":                                   springcloudParse.SpringCloudConfigurationServiceId{},
+	"azurerm_spring_cloud_connection":                                              servicelinker.ScopedLinkerId{},
+	"azurerm_spring_cloud_customized_accelerator":                                  appplatform.CustomizedAcceleratorId{},
+	"azurerm_spring_cloud_dev_tool_portal":                                         springcloudParse.SpringCloudDevToolPortalId{},
+	"azurerm_spring_cloud_dynatrace_application_performance_monitoring":            appplatform.ApmId{},
+	"azurerm_spring_cloud_elastic_application_performance_monitoring":              appplatform.ApmId{},
+	"azurerm_spring_cloud_gateway":                                                 appplatform.GatewayId{},
+	"azurerm_spring_cloud_new_relic_application_performance_monitoring":            appplatform.ApmId{},
+	"azurerm_storage_account_blob_properties":                                      storageParse.StorageAccountBlobPropertiesId{},
+	"azurerm_storage_account_local_user":                                           localusers.LocalUserId{},
+	"azurerm_storage_account_queue_properties":                                     storageParse.StorageAccountQueuePropertiesId{},
+	"azurerm_storage_account_share_properties":                                     storageParse.StorageAccountSharePropertiesId{},
+	"azurerm_storage_account_static_website":                                       storageParse.StorageAccountStaticWebsiteId{},
+	"azurerm_storage_container_immutability_policy":                                storageParse.StorageContainerImmutabilityPolicyId{},
+	"azurerm_storage_container_legal_hold":                                         storageParse.StorageContainerLegalHoldId{},
+	"azurerm_storage_mover":                                                        storagemovers.StorageMoverId{},
+	"azurerm_storage_mover_agent":                                                  agents.AgentId{},
+	"azurerm_storage_mover_job_definition":                                         jobdefinitions.JobDefinitionId{},
+	"azurerm_storage_mover_project":                                                projects.ProjectId{},
+	"azurerm_storage_mover_source_endpoint":                                        storagemoverEndpoints.EndpointId{},
+	"azurerm_storage_mover_target_endpoint":                                        storagemoverEndpoints.EndpointId{},
+	"azurerm_stream_analytics_cluster":                                             streamanalyticsClusters.ClusterId{},
+	"azurerm_stream_analytics_job_schedule":                                        streamanalyticsParse.StreamingJobScheduleId{},
+	"azurerm_stream_analytics_managed_private_endpoint":                            streamanalyticsPrivateendpoints.PrivateEndpointId{},
+	"azurerm_stream_analytics_output_cosmosdb":                                     outputs.OutputId{},
+	"azurerm_stream_analytics_output_function":                                     outputs.OutputId{},
+	"azurerm_stream_analytics_output_powerbi":                                      outputs.OutputId{},
+	"azurerm_stream_analytics_output_table":                                        outputs.OutputId{},
+	"azurerm_stream_analytics_stream_input_eventhub_v2":                            inputs.InputId{},
+	"azurerm_subscription_cost_management_export":                                  costmanagementParse.SubscriptionCostManagementExportId{},
+	"azurerm_subscription_cost_management_view":                                    costmanagementParse.SubscriptionCostManagementViewId{},
+	"azurerm_subscription_policy_assignment":                                       policyParse.SubscriptionAssignmentId{},
+	"azurerm_virtual_hub_routing_intent":                                           virtualwans.RoutingIntentId{},
+	"azurerm_virtual_machine_run_command":                                          virtualmachineruncommands.VirtualMachineRunCommandId{},
+	"azurerm_vmware_netapp_volume_attachment":                                      datastores.DataStoreId{},
+	"azurerm_voice_services_communications_gateway":                                communicationsgateways.CommunicationsGatewayId{},
+	"azurerm_voice_services_communications_gateway_test_line":                      testlines.TestLineId{},
+	"azurerm_web_app_active_slot":                                                  commonids.AppServiceId{},
+	"azurerm_web_app_hybrid_connection":                                            webapps.RelayId{},
+	"azurerm_web_pubsub_custom_certificate":                                        webpubsub.CustomCertificateId{},
+	"azurerm_web_pubsub_custom_domain":                                             webpubsub.CustomDomainId{},
+	"azurerm_windows_function_app_slot":                                            webapps.SlotId{},
+	"azurerm_windows_web_app":                                                      commonids.AppServiceId{},
+	"azurerm_windows_web_app_slot":                                                 webapps.SlotId{},
+}
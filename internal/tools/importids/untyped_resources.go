@@ -0,0 +1,3379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importids
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	analysisservicesServers "github.com/hashicorp/go-azure-sdk/resource-manager/analysisservices/2017-08-01/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apidiagnostic"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apimanagementservice"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperation"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apioperationtag"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apipolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apirelease"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apischema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apitag"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apitagdescription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apiversionset"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/authorizationserver"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/backend"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/cache"
+	apimanagementCertificate "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/certificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/diagnostic"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/emailtemplates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gateway"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gatewayapi"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gatewaycertificateauthority"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/gatewayhostnameconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/group"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/groupuser"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/identityprovider"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/logger"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/openidconnectprovider"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/product"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/productapi"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/productgroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/productpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/producttag"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/schema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/subscription"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/tag"
+	apimanagementUser "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/user"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2023-03-01/configurationstores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/attestation/2020-10-01/attestationproviders"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2015-10-31/webhook"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/automationaccount"
+	automationCertificate "github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/certificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/connection"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/credential"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/dscconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/dscnodeconfiguration"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/jobschedule"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/module"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/runbook"
+	automationSchedule "github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/schedule"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2023-11-01/variable"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azureactivedirectory/2017-04-01/diagnosticsettings"
+	azurestackhciClusters "github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2023-08-01/clusters"
+	batchApplication "github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/application"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/batchaccount"
+	batchCertificate "github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/certificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2023-05-01/pool"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/blueprints/2018-11-01-preview/assignment"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2023-05-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/capacityreservationgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/capacityreservations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/sshpublickeys"
+	computeVirtualmachines "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/diskaccesses"
+	computeSnapshots "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/confidentialledger/2022-05-13/confidentialledger"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2023-05-01/containerinstance"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/agentpools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/registries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/scopemaps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/tokens"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2021-08-01-preview/webhooks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/managedcassandras"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/customproviders/2018-09-01-preview/customresourceprovider"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databoxedge/2022-03-01/orders"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2023-02-01/vnetpeering"
+	databricksWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2023-02-01/workspaces"
+	datadogMonitorsresource "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01/monitorsresource"
+	datadogRules "github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datadog/2021-03-01/singlesignon"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datamigration/2018-04-19/projectresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datamigration/2018-04-19/serviceresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2023-05-01/backupinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2023-05-01/backuppolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2023-05-01/backupvaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2023-05-01/resourceguards"
+	datashareAccount "github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/dataset"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/datashare/2019-11-01/share"
+	desktopvirtualizationApplication "github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/application"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/applicationgroup"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/hostpool"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/scalingplan"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2022-02-10-preview/workspace"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/dpscertificate"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/iotdpsresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/globalschedules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/labs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/policies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/schedules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/virtualnetworks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/digitaltwinsinstance"
+	digitaltwinsEndpoints "github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/endpoints"
+	dnsRecordsets "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	elasticMonitorsresource "github.com/hashicorp/go-azure-sdk/resource-manager/elastic/2023-06-01/monitorsresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/domains"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/domaintopics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/eventsubscriptions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/systemtopics"
+	eventgridTopics "github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2022-06-15/topics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
+	eventhubDisasterrecoveryconfigs "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/disasterrecoveryconfigs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubsclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/schemaregistry"
+	eventhubNamespaces "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/guestconfiguration/2020-06-25/guestconfigurationassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hardwaresecuritymodules/2021-11-30/dedicatedhsms"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthbot/2022-08-08/healthbots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/dicomservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/fhirservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/iotconnectors"
+	healthcareapisResource "github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/resource"
+	healthcareapisWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridkubernetes/2021-10-01/connectedclusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2016-03-01/logprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2018-03-01/metricalerts"
+	insightsScheduledqueryrules_v2018_04_16 "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2018-04-16/scheduledqueryrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2019-10-17-preview/privatelinkscopedresources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2019-10-17-preview/privatelinkscopesapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2020-10-01/activitylogalertsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2022-10-01/autoscalesettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-01-01/actiongroupsapis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/attacheddatabaseconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/clusterprincipalassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/databaseprincipalassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/dataconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/managedprivateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2023-08-15/scripts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountagreements"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountassemblies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountbatchconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountcertificates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountmaps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountpartners"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountschemas"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountsessions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationserviceenvironments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowtriggers"
+	logzMonitors "github.com/hashicorp/go-azure-sdk/resource-manager/logz/2020-10-01/monitors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logz/2020-10-01/subaccount"
+	logzTagrules "github.com/hashicorp/go-azure-sdk/resource-manager/logz/2020-10-01/tagrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2023-10-01/machinelearningcomputes"
+	machinelearningservicesWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2023-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/configurationassignments"
+	maintenanceMaintenanceconfigurations "github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/maintenanceconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applicationdefinitions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedapplications/2021-07-01/applications"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2019-06-01/registrationassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/managedservices/2022-10-01/registrationdefinitions"
+	mapsAccounts "github.com/hashicorp/go-azure-sdk/resource-manager/maps/2021-02-01/accounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maps/2021-02-01/creators"
+	mariadbConfigurations "github.com/hashicorp/go-azure-sdk/resource-manager/mariadb/2018-06-01/configurations"
+	mariadbDatabases "github.com/hashicorp/go-azure-sdk/resource-manager/mariadb/2018-06-01/databases"
+	mariadbFirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/mariadb/2018-06-01/firewallrules"
+	mariadbServers "github.com/hashicorp/go-azure-sdk/resource-manager/mariadb/2018-06-01/servers"
+	mariadbVirtualnetworkrules "github.com/hashicorp/go-azure-sdk/resource-manager/mariadb/2018-06-01/virtualnetworkrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/marketplaceordering/2015-06-01/agreements"
+	mediaAccounts "github.com/hashicorp/go-azure-sdk/resource-manager/media/2021-11-01/accounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-07-01/encodings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/assetsandassetfilters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/contentkeypolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/liveevents"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/liveoutputs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/streamingendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/media/2022-08-01/streamingpoliciesandstreaminglocators"
+	mixedrealityResource "github.com/hashicorp/go-azure-sdk/resource-manager/mixedreality/2021-01-01/resource"
+	mysqlConfigurations_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2017-12-01/configurations"
+	mysqlDatabases_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2017-12-01/databases"
+	mysqlFirewallrules_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2017-12-01/firewallrules"
+	mysqlServers_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2017-12-01/servers"
+	mysqlVirtualnetworkrules "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2017-12-01/virtualnetworkrules"
+	mysqlServerkeys "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2020-01-01/serverkeys"
+	mysqlConfigurations_v2022_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2022-01-01/configurations"
+	mysqlDatabases_v2022_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2022-01-01/databases"
+	mysqlFirewallrules_v2022_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2022-01-01/firewallrules"
+	mysqlServers_v2022_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2022-01-01/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/capacitypools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/netappaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/snapshotpolicy"
+	netappSnapshots "github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/snapshots"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2023-05-01/volumes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/applicationsecuritygroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/azurefirewalls"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/bastionhosts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/connectionmonitors"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/ddosprotectionplans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/firewallpolicyrulecollectiongroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/flowlogs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/loadbalancers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/networkprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/networkwatchers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/packetcaptures"
+	networkPrivateendpoints "github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/privateendpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/routefilters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/routes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/routetables"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/securityrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/virtualwans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-06-01/webapplicationfirewallpolicies"
+	notificationhubsNamespaces "github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2017-04-01/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2017-04-01/notificationhubs"
+	operationalinsightsClusters "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/dataexport"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/datasources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/linkedservices"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/linkedstorageaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/storageinsights"
+	operationalinsightsWorkspaces "github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/portal/2019-01-01-preview/dashboard"
+	postgresqlConfigurations_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/configurations"
+	postgresqlDatabases_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/databases"
+	postgresqlFirewallrules_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/firewallrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/serveradministrators"
+	postgresqlServers_v2017_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/servers"
+	postgresqlVirtualnetworkrules "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2017-12-01/virtualnetworkrules"
+	postgresqlServerkeys "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2020-01-01/serverkeys"
+	postgresqlConfigurations_v2021_06_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/configurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/administrators"
+	postgresqlDatabases_v2022_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/databases"
+	postgresqlFirewallrules_v2022_12_01 "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/firewallrules"
+	postgresqlServers_v2023_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2023-06-01-preview/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/capacities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/privatezones"
+	privatednsRecordsets "github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/recordsets"
+	privatednsVirtualnetworklinks "github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2020-06-01/virtualnetworklinks"
+	purviewAccount "github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-07-01/account"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2022-10-01/vaults"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectioncontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2023-02-01/protectionpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationnetworkmappings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2022-10-01/replicationprotectioncontainers"
+	redisFirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/redis/2023-08-01/firewallrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2023-08-01/redis"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2023-10-01-preview/redisenterprise"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	relayNamespaces "github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2023-11-01/services"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/security/2021-06-01/assessmentsmetadata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	servicebusDisasterrecoveryconfigs "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/disasterrecoveryconfigs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/namespacesauthorizationrule"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/queues"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/queuesauthorizationrule"
+	servicebusRules "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/rules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/subscriptions"
+	servicebusTopics "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/topics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2021-06-01-preview/topicsauthorizationrule"
+	servicebusNamespaces "github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2022-10-01-preview/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicefabric/2021-06-01/cluster"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2023-02-01/signalr"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-01-01/encryptionscopes"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/caches"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/storagetargets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/cloudendpointresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/storagesyncservicesresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/syncgroupresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/functions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/inputs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/streamingjobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/timeseriesinsights/2020-05-15/accesspolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/timeseriesinsights/2020-05-15/environments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/timeseriesinsights/2020-05-15/eventsources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/timeseriesinsights/2020-05-15/referencedatasets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2022-04-01/endpoints"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/trafficmanager/2022-04-01/profiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/videoanalyzer/2021-05-01-preview/edgemodules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/videoanalyzer/2021-05-01-preview/videoanalyzers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/authorizations"
+	vmwareClusters "github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/privateclouds"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2016-06-01/connections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2023-02-01/webpubsub"
+	apimanagementParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	applicationinsightsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/parse"
+	authorizationParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	botParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	cdnParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/parse"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	containersParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	cosmosParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	datafactoryParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	desktopvirtualizationParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/parse"
+	domainservicesParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/parse"
+	firewallParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	frontdoorParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
+	iothubParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/parse"
+	keyvaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	loadbalancerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	logicParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	managementgroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	monitorParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	mssqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	mssqlmanagedinstanceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssqlmanagedinstance/parse"
+	mysqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	policyParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	portalParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/portal/parse"
+	recoveryservicesParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/recoveryservices/parse"
+	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	securitycenterParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/parse"
+	sentinelParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	springcloudParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/parse"
+	sqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storagecacheParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/parse"
+	subscriptionParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/parse"
+	synapseParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	webParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/blob/blobs"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/filesystems"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/datalakestore/paths"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/directories"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/files"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/table/entities"
+)
+
+// untypedResources is the Resource ID used to import each of the Untyped Resources, since the function used to validate the
+// Resource ID is defined inline within the Importer of these Resources.
+//
+// Resources using a custom Importer, or an Importer which requires more than the Resource ID, aren't listed.
+var untypedResources = map[string]Resource{
+	"azurerm_active_directory_domain_service": {
+		Validate: parser(domainservicesParse.DomainServiceID),
+		Type:     domainservicesParse.DomainServiceId{},
+	},
+	"azurerm_active_directory_domain_service_replica_set": {
+		Validate: parser(domainservicesParse.DomainServiceReplicaSetID),
+		Type:     domainservicesParse.DomainServiceReplicaSetId{},
+	},
+	"azurerm_advanced_threat_protection": {
+		Validate: parser(securitycenterParse.AdvancedThreatProtectionID),
+	},
+	"azurerm_analysis_services_server": {
+		Validate: parser(analysisservicesServers.ParseServerID),
+		Type:     analysisservicesServers.ServerId{},
+	},
+	"azurerm_api_connection": {
+		Validate: parser(connections.ParseConnectionID),
+		Type:     connections.ConnectionId{},
+	},
+	"azurerm_api_management": {
+		Validate: parser(apimanagementservice.ParseServiceID),
+		Type:     apimanagementservice.ServiceId{},
+	},
+	"azurerm_api_management_api": {
+		Validate: parser(api.ParseApiID),
+		Type:     api.ApiId{},
+	},
+	"azurerm_api_management_api_diagnostic": {
+		Validate: parser(apidiagnostic.ParseApiDiagnosticID),
+		Type:     apidiagnostic.ApiDiagnosticId{},
+	},
+	"azurerm_api_management_api_operation": {
+		Validate: parser(apioperation.ParseOperationID),
+		Type:     apioperation.OperationId{},
+	},
+	"azurerm_api_management_api_operation_policy": {
+		Validate: parser(apioperationpolicy.ParseOperationID),
+		Type:     apioperationpolicy.OperationId{},
+	},
+	"azurerm_api_management_api_operation_tag": {
+		Validate: parser(apioperationtag.ParseOperationTagID),
+		Type:     apioperationtag.OperationTagId{},
+	},
+	"azurerm_api_management_api_policy": {
+		Validate: parser(apipolicy.ParseApiID),
+		Type:     apipolicy.ApiId{},
+	},
+	"azurerm_api_management_api_release": {
+		Validate: parser(apirelease.ParseReleaseID),
+		Type:     apirelease.ReleaseId{},
+	},
+	"azurerm_api_management_api_schema": {
+		Validate: parser(apischema.ParseApiSchemaID),
+		Type:     apischema.ApiSchemaId{},
+	},
+	"azurerm_api_management_api_tag": {
+		Validate: parser(apitag.ParseApiTagID),
+		Type:     apitag.ApiTagId{},
+	},
+	"azurerm_api_management_api_tag_description": {
+		Validate: parser(apitagdescription.ParseTagDescriptionID),
+		Type:     apitagdescription.TagDescriptionId{},
+	},
+	"azurerm_api_management_api_version_set": {
+		Validate: parser(apiversionset.ParseApiVersionSetID),
+		Type:     apiversionset.ApiVersionSetId{},
+	},
+	"azurerm_api_management_authorization_server": {
+		Validate: parser(authorizationserver.ParseAuthorizationServerID),
+		Type:     authorizationserver.AuthorizationServerId{},
+	},
+	"azurerm_api_management_backend": {
+		Validate: parser(backend.ParseBackendID),
+		Type:     backend.BackendId{},
+	},
+	"azurerm_api_management_certificate": {
+		Validate: parser(apimanagementCertificate.ParseCertificateID),
+		Type:     apimanagementCertificate.CertificateId{},
+	},
+	"azurerm_api_management_custom_domain": {
+		Validate: parser(apimanagementParse.CustomDomainID),
+		Type:     apimanagementParse.CustomDomainId{},
+	},
+	"azurerm_api_management_diagnostic": {
+		Validate: parser(diagnostic.ParseDiagnosticID),
+		Type:     diagnostic.DiagnosticId{},
+	},
+	"azurerm_api_management_email_template": {
+		Validate: parser(emailtemplates.ParseTemplateIDInsensitively),
+		Type:     emailtemplates.TemplateId{},
+	},
+	"azurerm_api_management_gateway": {
+		Validate: parser(gateway.ParseGatewayID),
+		Type:     gateway.GatewayId{},
+	},
+	"azurerm_api_management_gateway_api": {
+		Validate: parser(gatewayapi.ParseGatewayApiID),
+		Type:     gatewayapi.GatewayApiId{},
+	},
+	"azurerm_api_management_gateway_certificate_authority": {
+		Validate: parser(gatewaycertificateauthority.ParseCertificateAuthorityID),
+		Type:     gatewaycertificateauthority.CertificateAuthorityId{},
+	},
+	"azurerm_api_management_gateway_host_name_configuration": {
+		Validate: parser(gatewayhostnameconfiguration.ParseHostnameConfigurationID),
+		Type:     gatewayhostnameconfiguration.HostnameConfigurationId{},
+	},
+	"azurerm_api_management_global_schema": {
+		Validate: parser(schema.ParseSchemaID),
+		Type:     schema.SchemaId{},
+	},
+	"azurerm_api_management_group": {
+		Validate: parser(group.ParseGroupID),
+		Type:     group.GroupId{},
+	},
+	"azurerm_api_management_group_user": {
+		Validate: parser(groupuser.ParseGroupUserID),
+		Type:     groupuser.GroupUserId{},
+	},
+	"azurerm_api_management_identity_provider_aad": {
+		Validate: apiManagementIdentityProviderType(identityprovider.IdentityProviderTypeAad),
+		Type:     identityprovider.IdentityProviderId{IdentityProviderName: identityprovider.IdentityProviderTypeAad},
+	},
+	"azurerm_api_management_identity_provider_aadb2c": {
+		Validate: apiManagementIdentityProviderType(identityprovider.IdentityProviderTypeAadBTwoC),
+		Type:     identityprovider.IdentityProviderId{IdentityProviderName: identityprovider.IdentityProviderTypeAadBTwoC},
+	},
+	"azurerm_api_management_identity_provider_facebook": {
+		Validate: apiManagementIdentityProviderType(identityprovider.IdentityProviderTypeFacebook),
+		Type:     identityprovider.IdentityProviderId{IdentityProviderName: identityprovider.IdentityProviderTypeFacebook},
+	},
+	"azurerm_api_management_identity_provider_google": {
+		Validate: apiManagementIdentityProviderType(identityprovider.IdentityProviderTypeGoogle),
+		Type:     identityprovider.IdentityProviderId{IdentityProviderName: identityprovider.IdentityProviderTypeGoogle},
+	},
+	"azurerm_api_management_identity_provider_microsoft": {
+		Validate: apiManagementIdentityProviderType(identityprovider.IdentityProviderTypeMicrosoft),
+		Type:     identityprovider.IdentityProviderId{IdentityProviderName: identityprovider.IdentityProviderTypeMicrosoft},
+	},
+	"azurerm_api_management_identity_provider_twitter": {
+		Validate: apiManagementIdentityProviderType(identityprovider.IdentityProviderTypeTwitter),
+		Type:     identityprovider.IdentityProviderId{IdentityProviderName: identityprovider.IdentityProviderTypeTwitter},
+	},
+	"azurerm_api_management_logger": {
+		Validate: parser(logger.ParseLoggerID),
+		Type:     logger.LoggerId{},
+	},
+	"azurerm_api_management_named_value": {
+		Validate: parser(namedvalue.ParseNamedValueID),
+		Type:     namedvalue.NamedValueId{},
+	},
+	"azurerm_api_management_openid_connect_provider": {
+		Validate: parser(openidconnectprovider.ParseOpenidConnectProviderID),
+		Type:     openidconnectprovider.OpenidConnectProviderId{},
+	},
+	"azurerm_api_management_policy": {
+		Validate: parser(policy.ParseServiceID),
+		Type:     policy.ServiceId{},
+	},
+	"azurerm_api_management_product": {
+		Validate: parser(product.ParseProductID),
+		Type:     product.ProductId{},
+	},
+	"azurerm_api_management_product_api": {
+		Validate: parser(productapi.ParseProductApiID),
+		Type:     productapi.ProductApiId{},
+	},
+	"azurerm_api_management_product_group": {
+		Validate: parser(productgroup.ParseProductGroupID),
+		Type:     productgroup.ProductGroupId{},
+	},
+	"azurerm_api_management_product_policy": {
+		Validate: parser(productpolicy.ParseProductID),
+		Type:     productpolicy.ProductId{},
+	},
+	"azurerm_api_management_product_tag": {
+		Validate: parser(producttag.ParseProductTagID),
+		Type:     producttag.ProductTagId{},
+	},
+	"azurerm_api_management_redis_cache": {
+		Validate: parser(cache.ParseCacheID),
+		Type:     cache.CacheId{},
+	},
+	"azurerm_api_management_subscription": {
+		Validate: parser(subscription.ParseSubscriptions2ID),
+		Type:     subscription.Subscriptions2Id{},
+	},
+	"azurerm_api_management_tag": {
+		Validate: parser(tag.ParseTagID),
+		Type:     tag.TagId{},
+	},
+	"azurerm_api_management_user": {
+		Validate: parser(apimanagementUser.ParseUserID),
+		Type:     apimanagementUser.UserId{},
+	},
+	"azurerm_app_configuration": {
+		Validate: parser(configurationstores.ParseConfigurationStoreID),
+		Type:     configurationstores.ConfigurationStoreId{},
+	},
+	"azurerm_app_service": {
+		Validate: parser(webParse.AppServiceID),
+		Type:     webParse.AppServiceId{},
+	},
+	"azurerm_app_service_active_slot": {
+		Validate: parser(webParse.AppServiceID),
+		Type:     webParse.AppServiceId{},
+	},
+	"azurerm_app_service_certificate": {
+		Validate: parser(webParse.CertificateID),
+		Type:     webParse.CertificateId{},
+	},
+	"azurerm_app_service_certificate_binding": {
+		Validate: parser(webParse.CertificateBindingID),
+	},
+	"azurerm_app_service_certificate_order": {
+		Validate: parser(webParse.CertificateOrderID),
+		Type:     webParse.CertificateOrderId{},
+	},
+	"azurerm_app_service_custom_hostname_binding": {
+		Validate: parser(webParse.AppServiceCustomHostnameBindingID),
+	},
+	"azurerm_app_service_environment": {
+		Validate: parser(webParse.AppServiceEnvironmentID),
+		Type:     webParse.AppServiceEnvironmentId{},
+	},
+	"azurerm_app_service_hybrid_connection": {
+		Validate: parser(webParse.HybridConnectionID),
+		Type:     webParse.HybridConnectionId{},
+	},
+	"azurerm_app_service_managed_certificate": {
+		Validate: parser(webParse.ManagedCertificateID),
+		Type:     webParse.ManagedCertificateId{},
+	},
+	"azurerm_app_service_plan": {
+		Validate: parser(webParse.AppServicePlanID),
+		Type:     webParse.AppServicePlanId{},
+	},
+	"azurerm_app_service_public_certificate": {
+		Validate: parser(webapps.ParsePublicCertificateID),
+		Type:     webapps.PublicCertificateId{},
+	},
+	"azurerm_app_service_slot": {
+		Validate: parser(webParse.AppServiceSlotID),
+		Type:     webParse.AppServiceSlotId{},
+	},
+	"azurerm_app_service_slot_custom_hostname_binding": {
+		Validate: parser(webParse.AppServiceSlotCustomHostnameBindingID),
+		Type:     webParse.AppServiceSlotCustomHostnameBindingId{},
+	},
+	"azurerm_app_service_slot_virtual_network_swift_connection": {
+		Validate: parser(webParse.SlotVirtualNetworkSwiftConnectionID),
+		Type:     webParse.SlotVirtualNetworkSwiftConnectionId{},
+	},
+	"azurerm_app_service_virtual_network_swift_connection": {
+		Validate: parser(webParse.VirtualNetworkSwiftConnectionID),
+		Type:     webParse.VirtualNetworkSwiftConnectionId{},
+	},
+	"azurerm_application_gateway": {
+		Validate: parser(networkParse.ApplicationGatewayID),
+		Type:     networkParse.ApplicationGatewayId{},
+	},
+	"azurerm_application_insights": {
+		Validate: parser(applicationinsightsParse.ComponentID),
+		Type:     applicationinsightsParse.ComponentId{},
+	},
+	"azurerm_application_insights_analytics_item": {
+		Validate: applicationInsightsAnalyticsItem,
+		Type:     applicationinsightsParse.AnalyticsSharedItemId{},
+	},
+	"azurerm_application_insights_api_key": {
+		Validate: parser(applicationinsightsParse.ApiKeyID),
+		Type:     applicationinsightsParse.ApiKeyId{},
+	},
+	"azurerm_application_insights_smart_detection_rule": {
+		Validate: parser(applicationinsightsParse.SmartDetectionRuleID),
+		Type:     applicationinsightsParse.SmartDetectionRuleId{},
+	},
+	"azurerm_application_insights_web_test": {
+		Validate: parser(applicationinsightsParse.WebTestID),
+		Type:     applicationinsightsParse.WebTestId{},
+	},
+	"azurerm_application_security_group": {
+		Validate: parser(applicationsecuritygroups.ParseApplicationSecurityGroupID),
+		Type:     applicationsecuritygroups.ApplicationSecurityGroupId{},
+	},
+	"azurerm_arc_kubernetes_cluster": {
+		Validate: parser(connectedclusters.ParseConnectedClusterID),
+		Type:     connectedclusters.ConnectedClusterId{},
+	},
+	"azurerm_attestation_provider": {
+		Validate: parser(attestationproviders.ParseAttestationProvidersID),
+		Type:     attestationproviders.AttestationProvidersId{},
+	},
+	"azurerm_automation_account": {
+		Validate: parser(automationaccount.ParseAutomationAccountID),
+		Type:     automationaccount.AutomationAccountId{},
+	},
+	"azurerm_automation_certificate": {
+		Validate: parser(automationCertificate.ParseCertificateID),
+		Type:     automationCertificate.CertificateId{},
+	},
+	"azurerm_automation_connection": {
+		Validate: parser(connection.ParseConnectionID),
+		Type:     connection.ConnectionId{},
+	},
+	"azurerm_automation_connection_certificate": {
+		Validate: parser(connection.ParseConnectionID),
+		Type:     connection.ConnectionId{},
+	},
+	"azurerm_automation_connection_classic_certificate": {
+		Validate: parser(connection.ParseConnectionID),
+		Type:     connection.ConnectionId{},
+	},
+	"azurerm_automation_connection_service_principal": {
+		Validate: parser(connection.ParseConnectionID),
+		Type:     connection.ConnectionId{},
+	},
+	"azurerm_automation_credential": {
+		Validate: parser(credential.ParseCredentialID),
+		Type:     credential.CredentialId{},
+	},
+	"azurerm_automation_dsc_configuration": {
+		Validate: parser(dscconfiguration.ParseConfigurationID),
+		Type:     dscconfiguration.ConfigurationId{},
+	},
+	"azurerm_automation_dsc_nodeconfiguration": {
+		Validate: parser(dscnodeconfiguration.ParseNodeConfigurationID),
+		Type:     dscnodeconfiguration.NodeConfigurationId{},
+	},
+	"azurerm_automation_job_schedule": {
+		Validate: parser(jobschedule.ParseJobScheduleID),
+		Type:     jobschedule.JobScheduleId{},
+	},
+	"azurerm_automation_module": {
+		Validate: parser(module.ParseModuleID),
+		Type:     module.ModuleId{},
+	},
+	"azurerm_automation_runbook": {
+		Validate: parser(runbook.ParseRunbookID),
+		Type:     runbook.RunbookId{},
+	},
+	"azurerm_automation_schedule": {
+		Validate: parser(automationSchedule.ParseScheduleID),
+		Type:     automationSchedule.ScheduleId{},
+	},
+	"azurerm_automation_variable_bool": {
+		Validate: parser(variable.ParseVariableID),
+		Type:     variable.VariableId{},
+	},
+	"azurerm_automation_variable_datetime": {
+		Validate: parser(variable.ParseVariableID),
+		Type:     variable.VariableId{},
+	},
+	"azurerm_automation_variable_int": {
+		Validate: parser(variable.ParseVariableID),
+		Type:     variable.VariableId{},
+	},
+	"azurerm_automation_variable_object": {
+		Validate: parser(variable.ParseVariableID),
+		Type:     variable.VariableId{},
+	},
+	"azurerm_automation_variable_string": {
+		Validate: parser(variable.ParseVariableID),
+		Type:     variable.VariableId{},
+	},
+	"azurerm_automation_webhook": {
+		Validate: parser(webhook.ParseWebHookID),
+		Type:     webhook.WebHookId{},
+	},
+	"azurerm_availability_set": {
+		Validate: parser(commonids.ParseAvailabilitySetID),
+		Type:     commonids.AvailabilitySetId{},
+	},
+	"azurerm_backup_container_storage_account": {
+		Validate: parser(protectioncontainers.ParseProtectionContainerID),
+		Type:     protectioncontainers.ProtectionContainerId{},
+	},
+	"azurerm_backup_policy_file_share": {
+		Validate: parser(protectionpolicies.ParseBackupPolicyID),
+		Type:     protectionpolicies.BackupPolicyId{},
+	},
+	"azurerm_backup_policy_vm": {
+		Validate: parser(protectionpolicies.ParseBackupPolicyID),
+		Type:     protectionpolicies.BackupPolicyId{},
+	},
+	"azurerm_backup_protected_file_share": {
+		Validate: parser(recoveryservicesParse.ProtectedItemID),
+		Type:     recoveryservicesParse.ProtectedItemId{},
+	},
+	"azurerm_backup_protected_vm": {
+		Validate: parser(protecteditems.ParseProtectedItemID),
+		Type:     protecteditems.ProtectedItemId{},
+	},
+	"azurerm_bastion_host": {
+		Validate: parser(bastionhosts.ParseBastionHostID),
+		Type:     bastionhosts.BastionHostId{},
+	},
+	"azurerm_batch_account": {
+		Validate: parser(batchaccount.ParseBatchAccountID),
+		Type:     batchaccount.BatchAccountId{},
+	},
+	"azurerm_batch_application": {
+		Validate: parser(batchApplication.ParseApplicationID),
+		Type:     batchApplication.ApplicationId{},
+	},
+	"azurerm_batch_certificate": {
+		Validate: parser(batchCertificate.ParseCertificateID),
+		Type:     batchCertificate.CertificateId{},
+	},
+	"azurerm_batch_pool": {
+		Validate: parser(pool.ParsePoolID),
+		Type:     pool.PoolId{},
+	},
+	"azurerm_blueprint_assignment": {
+		Validate: parser(assignment.ParseScopedBlueprintAssignmentID),
+		Type:     assignment.ScopedBlueprintAssignmentId{},
+	},
+	"azurerm_bot_channel_alexa": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_direct_line_speech": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_directline": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_email": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_facebook": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_line": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_ms_teams": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_slack": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_sms": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channel_web_chat": {
+		Validate: parser(botParse.BotChannelID),
+		Type:     botParse.BotChannelId{},
+	},
+	"azurerm_bot_channels_registration": {
+		Validate: parser(botParse.BotServiceID),
+		Type:     botParse.BotServiceId{},
+	},
+	"azurerm_bot_connection": {
+		Validate: parser(botParse.BotConnectionID),
+		Type:     botParse.BotConnectionId{},
+	},
+	"azurerm_bot_web_app": {
+		Validate: parser(botParse.BotServiceID),
+		Type:     botParse.BotServiceId{},
+	},
+	"azurerm_capacity_reservation": {
+		Validate: parser(capacityreservations.ParseCapacityReservationID),
+		Type:     capacityreservations.CapacityReservationId{},
+	},
+	"azurerm_capacity_reservation_group": {
+		Validate: parser(capacityreservationgroups.ParseCapacityReservationGroupID),
+		Type:     capacityreservationgroups.CapacityReservationGroupId{},
+	},
+	"azurerm_cdn_endpoint": {
+		Validate: parser(cdnParse.EndpointID),
+		Type:     cdnParse.EndpointId{},
+	},
+	"azurerm_cdn_endpoint_custom_domain": {
+		Validate: parser(cdnParse.CustomDomainID),
+		Type:     cdnParse.CustomDomainId{},
+	},
+	"azurerm_cdn_frontdoor_custom_domain": {
+		Validate: parser(cdnParse.FrontDoorCustomDomainID),
+		Type:     cdnParse.FrontDoorCustomDomainId{},
+	},
+	"azurerm_cdn_frontdoor_custom_domain_association": {
+		Validate: parser(cdnParse.FrontDoorCustomDomainAssociationID),
+		Type:     cdnParse.FrontDoorCustomDomainAssociationId{},
+	},
+	"azurerm_cdn_frontdoor_endpoint": {
+		Validate: parser(cdnParse.FrontDoorEndpointID),
+		Type:     cdnParse.FrontDoorEndpointId{},
+	},
+	"azurerm_cdn_frontdoor_firewall_policy": {
+		Validate: parser(cdnParse.FrontDoorFirewallPolicyID),
+		Type:     cdnParse.FrontDoorFirewallPolicyId{},
+	},
+	"azurerm_cdn_frontdoor_origin": {
+		Validate: parser(cdnParse.FrontDoorOriginID),
+		Type:     cdnParse.FrontDoorOriginId{},
+	},
+	"azurerm_cdn_frontdoor_origin_group": {
+		Validate: parser(cdnParse.FrontDoorOriginGroupID),
+		Type:     cdnParse.FrontDoorOriginGroupId{},
+	},
+	"azurerm_cdn_frontdoor_profile": {
+		Validate: parser(cdnParse.FrontDoorProfileID),
+		Type:     cdnParse.FrontDoorProfileId{},
+	},
+	"azurerm_cdn_frontdoor_route": {
+		Validate: parser(cdnParse.FrontDoorRouteID),
+		Type:     cdnParse.FrontDoorRouteId{},
+	},
+	"azurerm_cdn_frontdoor_route_disable_link_to_default_domain": {
+		Validate: parser(cdnParse.FrontDoorRouteDisableLinkToDefaultDomainID),
+		Type:     cdnParse.FrontDoorRouteDisableLinkToDefaultDomainId{},
+	},
+	"azurerm_cdn_frontdoor_rule": {
+		Validate: parser(cdnParse.FrontDoorRuleID),
+		Type:     cdnParse.FrontDoorRuleId{},
+	},
+	"azurerm_cdn_frontdoor_rule_set": {
+		Validate: parser(cdnParse.FrontDoorRuleSetID),
+		Type:     cdnParse.FrontDoorRuleSetId{},
+	},
+	"azurerm_cdn_frontdoor_secret": {
+		Validate: parser(cdnParse.FrontDoorSecretID),
+		Type:     cdnParse.FrontDoorSecretId{},
+	},
+	"azurerm_cdn_frontdoor_security_policy": {
+		Validate: parser(cdnParse.FrontDoorSecurityPolicyID),
+		Type:     cdnParse.FrontDoorSecurityPolicyId{},
+	},
+	"azurerm_cdn_profile": {
+		Validate: parser(cdnParse.ProfileID),
+		Type:     cdnParse.ProfileId{},
+	},
+	"azurerm_cognitive_account": {
+		Validate: parser(cognitiveservicesaccounts.ParseAccountID),
+		Type:     cognitiveservicesaccounts.AccountId{},
+	},
+	"azurerm_cognitive_account_customer_managed_key": {
+		Validate: parser(cognitiveservicesaccounts.ParseAccountID),
+		Type:     cognitiveservicesaccounts.AccountId{},
+	},
+	"azurerm_confidential_ledger": {
+		Validate: parser(confidentialledger.ParseLedgerID),
+		Type:     confidentialledger.LedgerId{},
+	},
+	"azurerm_container_group": {
+		Validate: parser(containerinstance.ParseContainerGroupID),
+		Type:     containerinstance.ContainerGroupId{},
+	},
+	"azurerm_container_registry": {
+		Validate: parser(registries.ParseRegistryID),
+		Type:     registries.RegistryId{},
+	},
+	"azurerm_container_registry_agent_pool": {
+		Validate: parser(agentpools.ParseAgentPoolID),
+		Type:     agentpools.AgentPoolId{},
+	},
+	"azurerm_container_registry_scope_map": {
+		Validate: parser(scopemaps.ParseScopeMapID),
+		Type:     scopemaps.ScopeMapId{},
+	},
+	"azurerm_container_registry_token": {
+		Validate: parser(tokens.ParseTokenID),
+		Type:     tokens.TokenId{},
+	},
+	"azurerm_container_registry_webhook": {
+		Validate: parser(webhooks.ParseWebHookID),
+		Type:     webhooks.WebHookId{},
+	},
+	"azurerm_cosmosdb_account": {
+		Validate: parser(cosmosParse.DatabaseAccountID),
+		Type:     cosmosParse.DatabaseAccountId{},
+	},
+	"azurerm_cosmosdb_cassandra_cluster": {
+		Validate: parser(cosmosParse.CassandraClusterID),
+		Type:     cosmosParse.CassandraClusterId{},
+	},
+	"azurerm_cosmosdb_cassandra_datacenter": {
+		Validate: parser(managedcassandras.ParseDataCenterID),
+		Type:     managedcassandras.DataCenterId{},
+	},
+	"azurerm_cosmosdb_cassandra_keyspace": {
+		Validate: parser(cosmosParse.CassandraKeyspaceID),
+		Type:     cosmosParse.CassandraKeyspaceId{},
+	},
+	"azurerm_cosmosdb_cassandra_table": {
+		Validate: parser(cosmosParse.CassandraTableID),
+		Type:     cosmosParse.CassandraTableId{},
+	},
+	"azurerm_cosmosdb_gremlin_database": {
+		Validate: parser(cosmosParse.GremlinDatabaseID),
+		Type:     cosmosParse.GremlinDatabaseId{},
+	},
+	"azurerm_cosmosdb_gremlin_graph": {
+		Validate: parser(cosmosParse.GremlinGraphID),
+		Type:     cosmosParse.GremlinGraphId{},
+	},
+	"azurerm_cosmosdb_mongo_collection": {
+		Validate: parser(cosmosParse.MongodbCollectionID),
+		Type:     cosmosParse.MongodbCollectionId{},
+	},
+	"azurerm_cosmosdb_mongo_database": {
+		Validate: parser(cosmosParse.MongodbDatabaseID),
+		Type:     cosmosParse.MongodbDatabaseId{},
+	},
+	"azurerm_cosmosdb_notebook_workspace": {
+		Validate: parser(cosmosParse.NotebookWorkspaceID),
+		Type:     cosmosParse.NotebookWorkspaceId{},
+	},
+	"azurerm_cosmosdb_sql_container": {
+		Validate: parser(cosmosParse.SqlContainerID),
+		Type:     cosmosParse.SqlContainerId{},
+	},
+	"azurerm_cosmosdb_sql_database": {
+		Validate: parser(cosmosParse.SqlDatabaseID),
+		Type:     cosmosParse.SqlDatabaseId{},
+	},
+	"azurerm_cosmosdb_sql_function": {
+		Validate: parser(cosmosParse.SqlFunctionID),
+		Type:     cosmosParse.SqlFunctionId{},
+	},
+	"azurerm_cosmosdb_sql_role_assignment": {
+		Validate: parser(cosmosParse.SqlRoleAssignmentID),
+		Type:     cosmosParse.SqlRoleAssignmentId{},
+	},
+	"azurerm_cosmosdb_sql_role_definition": {
+		Validate: parser(cosmosParse.SqlRoleDefinitionID),
+		Type:     cosmosParse.SqlRoleDefinitionId{},
+	},
+	"azurerm_cosmosdb_sql_stored_procedure": {
+		Validate: parser(cosmosParse.SqlStoredProcedureID),
+		Type:     cosmosParse.SqlStoredProcedureId{},
+	},
+	"azurerm_cosmosdb_sql_trigger": {
+		Validate: parser(cosmosParse.SqlTriggerID),
+		Type:     cosmosParse.SqlTriggerId{},
+	},
+	"azurerm_cosmosdb_table": {
+		Validate: parser(cosmosParse.TableID),
+		Type:     cosmosParse.TableId{},
+	},
+	"azurerm_custom_provider": {
+		Validate: parser(customresourceprovider.ParseResourceProviderID),
+		Type:     customresourceprovider.ResourceProviderId{},
+	},
+	"azurerm_dashboard": {
+		Validate: parser(portalParse.DashboardID),
+		Type:     portalParse.DashboardId{},
+	},
+	"azurerm_data_factory": {
+		Validate: parser(factories.ParseFactoryID),
+		Type:     factories.FactoryId{},
+	},
+	"azurerm_data_factory_custom_dataset": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_data_flow": {
+		Validate: parser(datafactoryParse.DataFlowID),
+		Type:     datafactoryParse.DataFlowId{},
+	},
+	"azurerm_data_factory_dataset_azure_blob": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_binary": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_cosmosdb_sqlapi": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_delimited_text": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_http": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_json": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_mysql": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_parquet": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_postgresql": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_snowflake": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_dataset_sql_server_table": {
+		Validate: parser(datafactoryParse.DataSetID),
+		Type:     datafactoryParse.DataSetId{},
+	},
+	"azurerm_data_factory_flowlet_data_flow": {
+		Validate: parser(datafactoryParse.DataFlowID),
+		Type:     datafactoryParse.DataFlowId{},
+	},
+	"azurerm_data_factory_integration_runtime_azure": {
+		Validate: parser(datafactoryParse.IntegrationRuntimeID),
+		Type:     datafactoryParse.IntegrationRuntimeId{},
+	},
+	"azurerm_data_factory_integration_runtime_azure_ssis": {
+		Validate: parser(datafactoryParse.IntegrationRuntimeID),
+		Type:     datafactoryParse.IntegrationRuntimeId{},
+	},
+	"azurerm_data_factory_integration_runtime_managed": {
+		Validate: parser(datafactoryParse.IntegrationRuntimeID),
+		Type:     datafactoryParse.IntegrationRuntimeId{},
+	},
+	"azurerm_data_factory_integration_runtime_self_hosted": {
+		Validate: parser(datafactoryParse.IntegrationRuntimeID),
+		Type:     datafactoryParse.IntegrationRuntimeId{},
+	},
+	"azurerm_data_factory_linked_custom_service": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_azure_blob_storage": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_azure_databricks": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_azure_file_storage": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_azure_function": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_azure_search": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_azure_sql_database": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_azure_table_storage": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_cosmosdb": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_cosmosdb_mongoapi": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_key_vault": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_kusto": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_mysql": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_odata": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_odbc": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_postgresql": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_sftp": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_snowflake": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_sql_server": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_synapse": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_linked_service_web": {
+		Validate: parser(datafactoryParse.LinkedServiceID),
+		Type:     datafactoryParse.LinkedServiceId{},
+	},
+	"azurerm_data_factory_managed_private_endpoint": {
+		Validate: parser(datafactoryParse.ManagedPrivateEndpointID),
+		Type:     datafactoryParse.ManagedPrivateEndpointId{},
+	},
+	"azurerm_data_factory_pipeline": {
+		Validate: parser(datafactoryParse.PipelineID),
+		Type:     datafactoryParse.PipelineId{},
+	},
+	"azurerm_data_factory_trigger_blob_event": {
+		Validate: parser(datafactoryParse.TriggerID),
+		Type:     datafactoryParse.TriggerId{},
+	},
+	"azurerm_data_factory_trigger_custom_event": {
+		Validate: parser(datafactoryParse.TriggerID),
+		Type:     datafactoryParse.TriggerId{},
+	},
+	"azurerm_data_factory_trigger_schedule": {
+		Validate: parser(datafactoryParse.TriggerID),
+		Type:     datafactoryParse.TriggerId{},
+	},
+	"azurerm_data_factory_trigger_tumbling_window": {
+		Validate: parser(datafactoryParse.TriggerID),
+		Type:     datafactoryParse.TriggerId{},
+	},
+	"azurerm_data_protection_backup_instance_blob_storage": {
+		Validate: parser(backupinstances.ParseBackupInstanceID),
+		Type:     backupinstances.BackupInstanceId{},
+	},
+	"azurerm_data_protection_backup_instance_disk": {
+		Validate: parser(backupinstances.ParseBackupInstanceID),
+		Type:     backupinstances.BackupInstanceId{},
+	},
+	"azurerm_data_protection_backup_instance_postgresql": {
+		Validate: parser(backupinstances.ParseBackupInstanceID),
+		Type:     backupinstances.BackupInstanceId{},
+	},
+	"azurerm_data_protection_backup_policy_blob_storage": {
+		Validate: parser(backuppolicies.ParseBackupPolicyID),
+		Type:     backuppolicies.BackupPolicyId{},
+	},
+	"azurerm_data_protection_backup_policy_disk": {
+		Validate: parser(backuppolicies.ParseBackupPolicyID),
+		Type:     backuppolicies.BackupPolicyId{},
+	},
+	"azurerm_data_protection_backup_policy_postgresql": {
+		Validate: parser(backuppolicies.ParseBackupPolicyID),
+		Type:     backuppolicies.BackupPolicyId{},
+	},
+	"azurerm_data_protection_backup_vault": {
+		Validate: parser(backupvaults.ParseBackupVaultIDInsensitively),
+		Type:     backupvaults.BackupVaultId{},
+	},
+	"azurerm_data_protection_resource_guard": {
+		Validate: parser(resourceguards.ParseResourceGuardID),
+		Type:     resourceguards.ResourceGuardId{},
+	},
+	"azurerm_data_share": {
+		Validate: parser(share.ParseShareID),
+		Type:     share.ShareId{},
+	},
+	"azurerm_data_share_account": {
+		Validate: parser(datashareAccount.ParseAccountID),
+		Type:     datashareAccount.AccountId{},
+	},
+	"azurerm_data_share_dataset_blob_storage": {
+		Validate: parser(dataset.ParseDataSetID),
+		Type:     dataset.DataSetId{},
+	},
+	"azurerm_data_share_dataset_data_lake_gen2": {
+		Validate: parser(dataset.ParseDataSetID),
+		Type:     dataset.DataSetId{},
+	},
+	"azurerm_data_share_dataset_kusto_cluster": {
+		Validate: parser(dataset.ParseDataSetID),
+		Type:     dataset.DataSetId{},
+	},
+	"azurerm_data_share_dataset_kusto_database": {
+		Validate: parser(dataset.ParseDataSetID),
+		Type:     dataset.DataSetId{},
+	},
+	"azurerm_database_migration_project": {
+		Validate: parser(projectresource.ParseProjectID),
+		Type:     projectresource.ProjectId{},
+	},
+	"azurerm_database_migration_service": {
+		Validate: parser(serviceresource.ParseServiceID),
+		Type:     serviceresource.ServiceId{},
+	},
+	"azurerm_databox_edge_order": {
+		Validate: parser(orders.ParseDataBoxEdgeDeviceID),
+		Type:     orders.DataBoxEdgeDeviceId{},
+	},
+	"azurerm_databricks_virtual_network_peering": {
+		Validate: parser(vnetpeering.ParseVirtualNetworkPeeringID),
+		Type:     vnetpeering.VirtualNetworkPeeringId{},
+	},
+	"azurerm_databricks_workspace": {
+		Validate: parser(databricksWorkspaces.ParseWorkspaceID),
+		Type:     databricksWorkspaces.WorkspaceId{},
+	},
+	"azurerm_databricks_workspace_customer_managed_key": {
+		Validate: parser(databricksWorkspaces.ParseWorkspaceID),
+		Type:     databricksWorkspaces.WorkspaceId{},
+	},
+	"azurerm_databricks_workspace_root_dbfs_customer_managed_key": {
+		Validate: parser(databricksWorkspaces.ParseWorkspaceID),
+		Type:     databricksWorkspaces.WorkspaceId{},
+	},
+	"azurerm_datadog_monitor": {
+		Validate: parser(datadogMonitorsresource.ParseMonitorID),
+		Type:     datadogMonitorsresource.MonitorId{},
+	},
+	"azurerm_datadog_monitor_sso_configuration": {
+		Validate: parser(singlesignon.ParseSingleSignOnConfigurationID),
+		Type:     singlesignon.SingleSignOnConfigurationId{},
+	},
+	"azurerm_datadog_monitor_tag_rule": {
+		Validate: parser(datadogRules.ParseTagRuleID),
+		Type:     datadogRules.TagRuleId{},
+	},
+	"azurerm_dedicated_hardware_security_module": {
+		Validate: parser(dedicatedhsms.ParseDedicatedHSMID),
+		Type:     dedicatedhsms.DedicatedHSMId{},
+	},
+	"azurerm_dedicated_host": {
+		Validate: parser(commonids.ParseDedicatedHostID),
+		Type:     commonids.DedicatedHostId{},
+	},
+	"azurerm_dedicated_host_group": {
+		Validate: parser(commonids.ParseDedicatedHostGroupID),
+		Type:     commonids.DedicatedHostGroupId{},
+	},
+	"azurerm_dev_test_global_vm_shutdown_schedule": {
+		Validate: parser(globalschedules.ParseScheduleID),
+		Type:     globalschedules.ScheduleId{},
+	},
+	"azurerm_dev_test_lab": {
+		Validate: parser(labs.ParseLabID),
+		Type:     labs.LabId{},
+	},
+	"azurerm_dev_test_linux_virtual_machine": {
+		Validate: parser(virtualmachines.ParseVirtualMachineID),
+		Type:     virtualmachines.VirtualMachineId{},
+	},
+	"azurerm_dev_test_policy": {
+		Validate: parser(policies.ParsePolicyID),
+		Type:     policies.PolicyId{},
+	},
+	"azurerm_dev_test_schedule": {
+		Validate: parser(schedules.ParseLabScheduleID),
+		Type:     schedules.LabScheduleId{},
+	},
+	"azurerm_dev_test_virtual_network": {
+		Validate: parser(virtualnetworks.ParseVirtualNetworkID),
+		Type:     virtualnetworks.VirtualNetworkId{},
+	},
+	"azurerm_dev_test_windows_virtual_machine": {
+		Validate: parser(virtualmachines.ParseVirtualMachineID),
+		Type:     virtualmachines.VirtualMachineId{},
+	},
+	"azurerm_digital_twins_endpoint_eventgrid": {
+		Validate: parser(digitaltwinsEndpoints.ParseEndpointID),
+		Type:     digitaltwinsEndpoints.EndpointId{},
+	},
+	"azurerm_digital_twins_endpoint_eventhub": {
+		Validate: parser(digitaltwinsEndpoints.ParseEndpointID),
+		Type:     digitaltwinsEndpoints.EndpointId{},
+	},
+	"azurerm_digital_twins_endpoint_servicebus": {
+		Validate: parser(digitaltwinsEndpoints.ParseEndpointID),
+		Type:     digitaltwinsEndpoints.EndpointId{},
+	},
+	"azurerm_digital_twins_instance": {
+		Validate: parser(digitaltwinsinstance.ParseDigitalTwinsInstanceID),
+		Type:     digitaltwinsinstance.DigitalTwinsInstanceId{},
+	},
+	"azurerm_disk_access": {
+		Validate: parser(diskaccesses.ParseDiskAccessID),
+		Type:     diskaccesses.DiskAccessId{},
+	},
+	"azurerm_disk_encryption_set": {
+		Validate: parser(computeParse.DiskEncryptionSetID),
+		Type:     computeParse.DiskEncryptionSetId{},
+	},
+	"azurerm_dns_a_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeA),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeA},
+	},
+	"azurerm_dns_aaaa_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeAAAA),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeAAAA},
+	},
+	"azurerm_dns_caa_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeCAA),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeCAA},
+	},
+	"azurerm_dns_cname_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeCNAME),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeCNAME},
+	},
+	"azurerm_dns_mx_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeMX),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeMX},
+	},
+	"azurerm_dns_ns_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeNS),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeNS},
+	},
+	"azurerm_dns_ptr_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypePTR),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypePTR},
+	},
+	"azurerm_dns_srv_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeSRV),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeSRV},
+	},
+	"azurerm_dns_txt_record": {
+		Validate: dnsRecordType(dnsRecordsets.RecordTypeTXT),
+		Type:     dnsRecordsets.RecordTypeId{RecordType: dnsRecordsets.RecordTypeTXT},
+	},
+	"azurerm_dns_zone": {
+		Validate: parser(zones.ParseDnsZoneID),
+		Type:     zones.DnsZoneId{},
+	},
+	"azurerm_elastic_cloud_elasticsearch": {
+		Validate: parser(elasticMonitorsresource.ParseMonitorID),
+		Type:     elasticMonitorsresource.MonitorId{},
+	},
+	"azurerm_eventgrid_domain": {
+		Validate: parser(domains.ParseDomainID),
+		Type:     domains.DomainId{},
+	},
+	"azurerm_eventgrid_domain_topic": {
+		Validate: parser(domaintopics.ParseDomainTopicID),
+		Type:     domaintopics.DomainTopicId{},
+	},
+	"azurerm_eventgrid_event_subscription": {
+		Validate: parser(eventsubscriptions.ParseScopedEventSubscriptionID),
+		Type:     eventsubscriptions.ScopedEventSubscriptionId{},
+	},
+	"azurerm_eventgrid_system_topic": {
+		Validate: parser(systemtopics.ParseSystemTopicID),
+		Type:     systemtopics.SystemTopicId{},
+	},
+	"azurerm_eventgrid_system_topic_event_subscription": {
+		Validate: parser(eventsubscriptions.ParseSystemTopicEventSubscriptionID),
+		Type:     eventsubscriptions.SystemTopicEventSubscriptionId{},
+	},
+	"azurerm_eventgrid_topic": {
+		Validate: parser(eventgridTopics.ParseTopicID),
+		Type:     eventgridTopics.TopicId{},
+	},
+	"azurerm_eventhub": {
+		Validate: parser(eventhubs.ParseEventhubID),
+		Type:     eventhubs.EventhubId{},
+	},
+	"azurerm_eventhub_authorization_rule": {
+		Validate: parser(eventhubs.ParseEventhubAuthorizationRuleID),
+		Type:     eventhubs.EventhubAuthorizationRuleId{},
+	},
+	"azurerm_eventhub_cluster": {
+		Validate: parser(eventhubsclusters.ParseClusterID),
+		Type:     eventhubsclusters.ClusterId{},
+	},
+	"azurerm_eventhub_namespace": {
+		Validate: parser(eventhubNamespaces.ParseNamespaceID),
+		Type:     eventhubNamespaces.NamespaceId{},
+	},
+	"azurerm_eventhub_namespace_authorization_rule": {
+		Validate: parser(authorizationrulesnamespaces.ParseAuthorizationRuleID),
+		Type:     authorizationrulesnamespaces.AuthorizationRuleId{},
+	},
+	"azurerm_eventhub_namespace_customer_managed_key": {
+		Validate: parser(eventhubNamespaces.ParseNamespaceID),
+		Type:     eventhubNamespaces.NamespaceId{},
+	},
+	"azurerm_eventhub_namespace_disaster_recovery_config": {
+		Validate: parser(eventhubDisasterrecoveryconfigs.ParseDisasterRecoveryConfigID),
+		Type:     eventhubDisasterrecoveryconfigs.DisasterRecoveryConfigId{},
+	},
+	"azurerm_eventhub_namespace_schema_group": {
+		Validate: parser(schemaregistry.ParseSchemaGroupID),
+		Type:     schemaregistry.SchemaGroupId{},
+	},
+	"azurerm_express_route_circuit": {
+		Validate: parser(networkParse.ExpressRouteCircuitID),
+		Type:     networkParse.ExpressRouteCircuitId{},
+	},
+	"azurerm_express_route_circuit_authorization": {
+		Validate: parser(networkParse.ExpressRouteCircuitAuthorizationID),
+		Type:     networkParse.ExpressRouteCircuitAuthorizationId{},
+	},
+	"azurerm_express_route_circuit_connection": {
+		Validate: parser(networkParse.ExpressRouteCircuitConnectionID),
+		Type:     networkParse.ExpressRouteCircuitConnectionId{},
+	},
+	"azurerm_express_route_circuit_peering": {
+		Validate: parser(networkParse.ExpressRouteCircuitPeeringID),
+		Type:     networkParse.ExpressRouteCircuitPeeringId{},
+	},
+	"azurerm_express_route_connection": {
+		Validate: parser(networkParse.ExpressRouteConnectionID),
+		Type:     networkParse.ExpressRouteConnectionId{},
+	},
+	"azurerm_express_route_gateway": {
+		Validate: parser(networkParse.ExpressRouteGatewayID),
+		Type:     networkParse.ExpressRouteGatewayId{},
+	},
+	"azurerm_express_route_port": {
+		Validate: parser(networkParse.ExpressRoutePortID),
+		Type:     networkParse.ExpressRoutePortId{},
+	},
+	"azurerm_express_route_port_authorization": {
+		Validate: parser(networkParse.ExpressRoutePortAuthorizationID),
+		Type:     networkParse.ExpressRoutePortAuthorizationId{},
+	},
+	"azurerm_firewall": {
+		Validate: parser(azurefirewalls.ParseAzureFirewallID),
+		Type:     azurefirewalls.AzureFirewallId{},
+	},
+	"azurerm_firewall_application_rule_collection": {
+		Validate: parser(firewallParse.FirewallApplicationRuleCollectionID),
+		Type:     firewallParse.FirewallApplicationRuleCollectionId{},
+	},
+	"azurerm_firewall_nat_rule_collection": {
+		Validate: parser(firewallParse.FirewallNatRuleCollectionID),
+		Type:     firewallParse.FirewallNatRuleCollectionId{},
+	},
+	"azurerm_firewall_network_rule_collection": {
+		Validate: parser(firewallParse.FirewallNetworkRuleCollectionID),
+		Type:     firewallParse.FirewallNetworkRuleCollectionId{},
+	},
+	"azurerm_firewall_policy": {
+		Validate: parser(firewallpolicies.ParseFirewallPolicyID),
+		Type:     firewallpolicies.FirewallPolicyId{},
+	},
+	"azurerm_firewall_policy_rule_collection_group": {
+		Validate: parser(firewallpolicyrulecollectiongroups.ParseRuleCollectionGroupID),
+		Type:     firewallpolicyrulecollectiongroups.RuleCollectionGroupId{},
+	},
+	"azurerm_frontdoor": {
+		Validate: parser(frontdoors.ParseFrontDoorID),
+		Type:     frontdoors.FrontDoorId{},
+	},
+	"azurerm_frontdoor_custom_https_configuration": {
+		Validate: parser(frontdoorParse.CustomHttpsConfigurationID),
+		Type:     frontdoorParse.CustomHttpsConfigurationId{},
+	},
+	"azurerm_frontdoor_firewall_policy": {
+		Validate: parser(frontdoorParse.WebApplicationFirewallPolicyIDInsensitively),
+		Type:     frontdoorParse.WebApplicationFirewallPolicyId{},
+	},
+	"azurerm_frontdoor_rules_engine": {
+		Validate: parser(frontdoorParse.RulesEngineID),
+		Type:     frontdoorParse.RulesEngineId{},
+	},
+	"azurerm_function_app": {
+		Validate: parser(webParse.FunctionAppID),
+		Type:     webParse.FunctionAppId{},
+	},
+	"azurerm_function_app_slot": {
+		Validate: parser(webParse.FunctionAppSlotID),
+		Type:     webParse.FunctionAppSlotId{},
+	},
+	"azurerm_hdinsight_hadoop_cluster": {
+		Validate: parser(commonids.ParseHDInsightClusterID),
+		Type:     commonids.HDInsightClusterId{},
+	},
+	"azurerm_hdinsight_hbase_cluster": {
+		Validate: parser(commonids.ParseHDInsightClusterID),
+		Type:     commonids.HDInsightClusterId{},
+	},
+	"azurerm_hdinsight_interactive_query_cluster": {
+		Validate: parser(commonids.ParseHDInsightClusterID),
+		Type:     commonids.HDInsightClusterId{},
+	},
+	"azurerm_hdinsight_kafka_cluster": {
+		Validate: parser(commonids.ParseHDInsightClusterID),
+		Type:     commonids.HDInsightClusterId{},
+	},
+	"azurerm_hdinsight_spark_cluster": {
+		Validate: parser(commonids.ParseHDInsightClusterID),
+		Type:     commonids.HDInsightClusterId{},
+	},
+	"azurerm_healthbot": {
+		Validate: parser(healthbots.ParseHealthBotID),
+		Type:     healthbots.HealthBotId{},
+	},
+	"azurerm_healthcare_dicom_service": {
+		Validate: parser(dicomservices.ParseDicomServiceID),
+		Type:     dicomservices.DicomServiceId{},
+	},
+	"azurerm_healthcare_fhir_service": {
+		Validate: parser(fhirservices.ParseFhirServiceID),
+		Type:     fhirservices.FhirServiceId{},
+	},
+	"azurerm_healthcare_medtech_service": {
+		Validate: parser(iotconnectors.ParseIotConnectorID),
+		Type:     iotconnectors.IotConnectorId{},
+	},
+	"azurerm_healthcare_medtech_service_fhir_destination": {
+		Validate: parser(iotconnectors.ParseFhirDestinationID),
+		Type:     iotconnectors.FhirDestinationId{},
+	},
+	"azurerm_healthcare_service": {
+		Validate: parser(healthcareapisResource.ParseServiceID),
+		Type:     healthcareapisResource.ServiceId{},
+	},
+	"azurerm_healthcare_workspace": {
+		Validate: parser(healthcareapisWorkspaces.ParseWorkspaceID),
+		Type:     healthcareapisWorkspaces.WorkspaceId{},
+	},
+	"azurerm_hpc_cache": {
+		Validate: parser(caches.ParseCacheID),
+		Type:     caches.CacheId{},
+	},
+	"azurerm_hpc_cache_access_policy": {
+		Validate: parser(storagecacheParse.CacheAccessPolicyID),
+		Type:     storagecacheParse.CacheAccessPolicyId{},
+	},
+	"azurerm_hpc_cache_blob_nfs_target": {
+		Validate: parser(storagetargets.ParseStorageTargetID),
+		Type:     storagetargets.StorageTargetId{},
+	},
+	"azurerm_hpc_cache_blob_target": {
+		Validate: parser(storagetargets.ParseStorageTargetID),
+		Type:     storagetargets.StorageTargetId{},
+	},
+	"azurerm_hpc_cache_nfs_target": {
+		Validate: parser(storagetargets.ParseStorageTargetID),
+		Type:     storagetargets.StorageTargetId{},
+	},
+	"azurerm_image": {
+		Validate: parser(images.ParseImageID),
+		Type:     images.ImageId{},
+	},
+	"azurerm_integration_service_environment": {
+		Validate: parser(integrationserviceenvironments.ParseIntegrationServiceEnvironmentID),
+		Type:     integrationserviceenvironments.IntegrationServiceEnvironmentId{},
+	},
+	"azurerm_iot_security_device_group": {
+		Validate: parser(securitycenterParse.IotSecurityDeviceGroupID),
+	},
+	"azurerm_iot_security_solution": {
+		Validate: parser(securitycenterParse.IotSecuritySolutionID),
+		Type:     securitycenterParse.IotSecuritySolutionId{},
+	},
+	"azurerm_iot_time_series_insights_access_policy": {
+		Validate: parser(accesspolicies.ParseAccessPolicyID),
+		Type:     accesspolicies.AccessPolicyId{},
+	},
+	"azurerm_iot_time_series_insights_event_source_eventhub": {
+		Validate: parser(eventsources.ParseEventSourceID),
+		Type:     eventsources.EventSourceId{},
+	},
+	"azurerm_iot_time_series_insights_event_source_iothub": {
+		Validate: parser(eventsources.ParseEventSourceID),
+		Type:     eventsources.EventSourceId{},
+	},
+	"azurerm_iot_time_series_insights_gen2_environment": {
+		Validate: parser(environments.ParseEnvironmentID),
+		Type:     environments.EnvironmentId{},
+	},
+	"azurerm_iot_time_series_insights_reference_data_set": {
+		Validate: parser(referencedatasets.ParseReferenceDataSetID),
+		Type:     referencedatasets.ReferenceDataSetId{},
+	},
+	"azurerm_iot_time_series_insights_standard_environment": {
+		Validate: parser(environments.ParseEnvironmentID),
+		Type:     environments.EnvironmentId{},
+	},
+	"azurerm_iotcentral_application": {
+		Validate: parser(apps.ParseIotAppID),
+		Type:     apps.IotAppId{},
+	},
+	"azurerm_iothub": {
+		Validate: parser(iothubParse.IotHubID),
+		Type:     iothubParse.IotHubId{},
+	},
+	"azurerm_iothub_certificate": {
+		Validate: parser(iothubParse.IotHubCertificateID),
+		Type:     iothubParse.IotHubCertificateId{},
+	},
+	"azurerm_iothub_consumer_group": {
+		Validate: parser(iothubParse.ConsumerGroupID),
+		Type:     iothubParse.ConsumerGroupId{},
+	},
+	"azurerm_iothub_dps": {
+		Validate: parser(commonids.ParseProvisioningServiceID),
+		Type:     commonids.ProvisioningServiceId{},
+	},
+	"azurerm_iothub_dps_certificate": {
+		Validate: parser(dpscertificate.ParseCertificateID),
+		Type:     dpscertificate.CertificateId{},
+	},
+	"azurerm_iothub_dps_shared_access_policy": {
+		Validate: parser(iotdpsresource.ParseKeyID),
+		Type:     iotdpsresource.KeyId{},
+	},
+	"azurerm_iothub_endpoint_eventhub": {
+		Validate: parser(iothubParse.EndpointEventhubID),
+		Type:     iothubParse.EndpointEventhubId{},
+	},
+	"azurerm_iothub_endpoint_servicebus_queue": {
+		Validate: parser(iothubParse.EndpointServiceBusQueueID),
+		Type:     iothubParse.EndpointServiceBusQueueId{},
+	},
+	"azurerm_iothub_endpoint_servicebus_topic": {
+		Validate: parser(iothubParse.EndpointServiceBusTopicID),
+		Type:     iothubParse.EndpointServiceBusTopicId{},
+	},
+	"azurerm_iothub_endpoint_storage_container": {
+		Validate: parser(iothubParse.EndpointStorageContainerID),
+		Type:     iothubParse.EndpointStorageContainerId{},
+	},
+	"azurerm_iothub_enrichment": {
+		Validate: parser(iothubParse.EnrichmentID),
+		Type:     iothubParse.EnrichmentId{},
+	},
+	"azurerm_iothub_fallback_route": {
+		Validate: parser(iothubParse.FallbackRouteID),
+		Type:     iothubParse.FallbackRouteId{},
+	},
+	"azurerm_iothub_route": {
+		Validate: parser(iothubParse.RouteID),
+		Type:     iothubParse.RouteId{},
+	},
+	"azurerm_iothub_shared_access_policy": {
+		Validate: parser(iothubParse.SharedAccessPolicyID),
+		Type:     iothubParse.SharedAccessPolicyId{},
+	},
+	"azurerm_ip_group": {
+		Validate: parser(networkParse.IpGroupID),
+		Type:     networkParse.IpGroupId{},
+	},
+	"azurerm_ip_group_cidr": {
+		Validate: parser(networkParse.IpGroupCidrID),
+		Type:     networkParse.IpGroupCidrId{},
+	},
+	"azurerm_key_vault": {
+		Validate: parser(commonids.ParseKeyVaultID),
+		Type:     commonids.KeyVaultId{},
+	},
+	"azurerm_key_vault_access_policy": {
+		Validate: parser(keyvaultParse.AccessPolicyID),
+	},
+	"azurerm_key_vault_certificate": {
+		Validate: parser(keyvaultParse.ParseNestedItemID),
+	},
+	"azurerm_key_vault_certificate_issuer": {
+		Validate: parser(keyvaultParse.IssuerID),
+	},
+	"azurerm_key_vault_key": {
+		Validate: parser(keyvaultParse.ParseNestedItemID),
+	},
+	"azurerm_key_vault_managed_hardware_security_module": {
+		Validate: parser(managedhsms.ParseManagedHSMID),
+		Type:     managedhsms.ManagedHSMId{},
+	},
+	"azurerm_key_vault_managed_storage_account": {
+		Validate: parser(keyvaultParse.ParseOptionallyVersionedNestedItemID),
+	},
+	"azurerm_key_vault_managed_storage_account_sas_token_definition": {
+		Validate: parser(keyvaultParse.SasDefinitionID),
+	},
+	"azurerm_key_vault_secret": {
+		Validate: parser(keyvaultParse.ParseNestedItemID),
+	},
+	"azurerm_kubernetes_cluster": {
+		Validate: parser(commonids.ParseKubernetesClusterID),
+		Type:     commonids.KubernetesClusterId{},
+	},
+	"azurerm_kubernetes_cluster_node_pool": {
+		Validate: parser(containersParse.NodePoolID),
+		Type:     containersParse.NodePoolId{},
+	},
+	"azurerm_kusto_attached_database_configuration": {
+		Validate: parser(attacheddatabaseconfigurations.ParseAttachedDatabaseConfigurationID),
+		Type:     attacheddatabaseconfigurations.AttachedDatabaseConfigurationId{},
+	},
+	"azurerm_kusto_cluster": {
+		Validate: parser(commonids.ParseKustoClusterID),
+		Type:     commonids.KustoClusterId{},
+	},
+	"azurerm_kusto_cluster_customer_managed_key": {
+		Validate: parser(commonids.ParseKustoClusterID),
+		Type:     commonids.KustoClusterId{},
+	},
+	"azurerm_kusto_cluster_managed_private_endpoint": {
+		Validate: parser(managedprivateendpoints.ParseManagedPrivateEndpointID),
+		Type:     managedprivateendpoints.ManagedPrivateEndpointId{},
+	},
+	"azurerm_kusto_cluster_principal_assignment": {
+		Validate: parser(clusterprincipalassignments.ParsePrincipalAssignmentID),
+		Type:     clusterprincipalassignments.PrincipalAssignmentId{},
+	},
+	"azurerm_kusto_database": {
+		Validate: parser(commonids.ParseKustoDatabaseID),
+		Type:     commonids.KustoDatabaseId{},
+	},
+	"azurerm_kusto_database_principal_assignment": {
+		Validate: parser(databaseprincipalassignments.ParseDatabasePrincipalAssignmentID),
+		Type:     databaseprincipalassignments.DatabasePrincipalAssignmentId{},
+	},
+	"azurerm_kusto_eventgrid_data_connection": {
+		Validate: parser(dataconnections.ParseDataConnectionID),
+		Type:     dataconnections.DataConnectionId{},
+	},
+	"azurerm_kusto_eventhub_data_connection": {
+		Validate: parser(dataconnections.ParseDataConnectionID),
+		Type:     dataconnections.DataConnectionId{},
+	},
+	"azurerm_kusto_iothub_data_connection": {
+		Validate: parser(dataconnections.ParseDataConnectionID),
+		Type:     dataconnections.DataConnectionId{},
+	},
+	"azurerm_kusto_script": {
+		Validate: parser(scripts.ParseScriptID),
+		Type:     scripts.ScriptId{},
+	},
+	"azurerm_lb": {
+		Validate: parser(loadbalancers.ParseLoadBalancerID),
+		Type:     loadbalancers.LoadBalancerId{},
+	},
+	"azurerm_lb_backend_address_pool": {
+		Validate: parser(loadbalancers.ParseLoadBalancerBackendAddressPoolID),
+		Type:     loadbalancers.LoadBalancerBackendAddressPoolId{},
+	},
+	"azurerm_lb_nat_pool": {
+		Validate: parser(loadbalancerParse.LoadBalancerInboundNatPoolID),
+		Type:     loadbalancerParse.LoadBalancerInboundNatPoolId{},
+	},
+	"azurerm_lb_nat_rule": {
+		Validate: parser(loadbalancers.ParseInboundNatRuleID),
+		Type:     loadbalancers.InboundNatRuleId{},
+	},
+	"azurerm_lb_outbound_rule": {
+		Validate: parser(loadbalancers.ParseOutboundRuleID),
+		Type:     loadbalancers.OutboundRuleId{},
+	},
+	"azurerm_lb_probe": {
+		Validate: parser(loadbalancers.ParseProbeID),
+		Type:     loadbalancers.ProbeId{},
+	},
+	"azurerm_lb_rule": {
+		Validate: parser(loadbalancers.ParseLoadBalancingRuleID),
+		Type:     loadbalancers.LoadBalancingRuleId{},
+	},
+	"azurerm_lighthouse_assignment": {
+		Validate: parser(registrationassignments.ParseScopedRegistrationAssignmentID),
+		Type:     registrationassignments.ScopedRegistrationAssignmentId{},
+	},
+	"azurerm_lighthouse_definition": {
+		Validate: parser(registrationdefinitions.ParseScopedRegistrationDefinitionID),
+		Type:     registrationdefinitions.ScopedRegistrationDefinitionId{},
+	},
+	"azurerm_linux_virtual_machine": {
+		Validate: parser(commonids.ParseVirtualMachineID),
+		Type:     commonids.VirtualMachineId{},
+	},
+	"azurerm_linux_virtual_machine_scale_set": {
+		Validate: parser(commonids.ParseVirtualMachineScaleSetID),
+		Type:     commonids.VirtualMachineScaleSetId{},
+	},
+	"azurerm_local_network_gateway": {
+		Validate: parser(networkParse.LocalNetworkGatewayID),
+		Type:     networkParse.LocalNetworkGatewayId{},
+	},
+	"azurerm_log_analytics_cluster": {
+		Validate: parser(operationalinsightsClusters.ParseClusterID),
+		Type:     operationalinsightsClusters.ClusterId{},
+	},
+	"azurerm_log_analytics_cluster_customer_managed_key": {
+		Validate: parser(operationalinsightsClusters.ParseClusterID),
+		Type:     operationalinsightsClusters.ClusterId{},
+	},
+	"azurerm_log_analytics_data_export_rule": {
+		Validate: parser(dataexport.ParseDataExportID),
+		Type:     dataexport.DataExportId{},
+	},
+	"azurerm_log_analytics_datasource_windows_event": {
+		Validate: parser(datasources.ParseDataSourceID),
+		Type:     datasources.DataSourceId{},
+	},
+	"azurerm_log_analytics_datasource_windows_performance_counter": {
+		Validate: parser(datasources.ParseDataSourceID),
+		Type:     datasources.DataSourceId{},
+	},
+	"azurerm_log_analytics_linked_service": {
+		Validate: parser(linkedservices.ParseLinkedServiceID),
+		Type:     linkedservices.LinkedServiceId{},
+	},
+	"azurerm_log_analytics_linked_storage_account": {
+		Validate: parser(linkedstorageaccounts.ParseDataSourceTypeID),
+		Type:     linkedstorageaccounts.DataSourceTypeId{},
+	},
+	"azurerm_log_analytics_saved_search": {
+		Validate: parser(savedsearches.ParseSavedSearchID),
+		Type:     savedsearches.SavedSearchId{},
+	},
+	"azurerm_log_analytics_storage_insights": {
+		Validate: parser(storageinsights.ParseStorageInsightConfigID),
+		Type:     storageinsights.StorageInsightConfigId{},
+	},
+	"azurerm_log_analytics_workspace": {
+		Validate: parser(operationalinsightsWorkspaces.ParseWorkspaceID),
+		Type:     operationalinsightsWorkspaces.WorkspaceId{},
+	},
+	"azurerm_logic_app_action_custom": {
+		Validate: parser(logicParse.ActionID),
+		Type:     logicParse.ActionId{},
+	},
+	"azurerm_logic_app_action_http": {
+		Validate: parser(logicParse.ActionID),
+		Type:     logicParse.ActionId{},
+	},
+	"azurerm_logic_app_integration_account": {
+		Validate: parser(integrationaccounts.ParseIntegrationAccountID),
+		Type:     integrationaccounts.IntegrationAccountId{},
+	},
+	"azurerm_logic_app_integration_account_agreement": {
+		Validate: parser(integrationaccountagreements.ParseAgreementID),
+		Type:     integrationaccountagreements.AgreementId{},
+	},
+	"azurerm_logic_app_integration_account_assembly": {
+		Validate: parser(integrationaccountassemblies.ParseAssemblyID),
+		Type:     integrationaccountassemblies.AssemblyId{},
+	},
+	"azurerm_logic_app_integration_account_batch_configuration": {
+		Validate: parser(integrationaccountbatchconfigurations.ParseBatchConfigurationID),
+		Type:     integrationaccountbatchconfigurations.BatchConfigurationId{},
+	},
+	"azurerm_logic_app_integration_account_certificate": {
+		Validate: parser(integrationaccountcertificates.ParseCertificateID),
+		Type:     integrationaccountcertificates.CertificateId{},
+	},
+	"azurerm_logic_app_integration_account_map": {
+		Validate: parser(integrationaccountmaps.ParseMapID),
+		Type:     integrationaccountmaps.MapId{},
+	},
+	"azurerm_logic_app_integration_account_partner": {
+		Validate: parser(integrationaccountpartners.ParsePartnerID),
+		Type:     integrationaccountpartners.PartnerId{},
+	},
+	"azurerm_logic_app_integration_account_schema": {
+		Validate: parser(integrationaccountschemas.ParseSchemaID),
+		Type:     integrationaccountschemas.SchemaId{},
+	},
+	"azurerm_logic_app_integration_account_session": {
+		Validate: parser(integrationaccountsessions.ParseSessionID),
+		Type:     integrationaccountsessions.SessionId{},
+	},
+	"azurerm_logic_app_standard": {
+		Validate: parser(logicParse.LogicAppStandardID),
+		Type:     logicParse.LogicAppStandardId{},
+	},
+	"azurerm_logic_app_trigger_custom": {
+		Validate: parser(workflowtriggers.ParseTriggerID),
+		Type:     workflowtriggers.TriggerId{},
+	},
+	"azurerm_logic_app_trigger_http_request": {
+		Validate: parser(workflowtriggers.ParseTriggerID),
+		Type:     workflowtriggers.TriggerId{},
+	},
+	"azurerm_logic_app_trigger_recurrence": {
+		Validate: parser(workflowtriggers.ParseTriggerID),
+		Type:     workflowtriggers.TriggerId{},
+	},
+	"azurerm_logic_app_workflow": {
+		Validate: parser(workflows.ParseWorkflowID),
+		Type:     workflows.WorkflowId{},
+	},
+	"azurerm_logz_monitor": {
+		Validate: parser(logzMonitors.ParseMonitorID),
+		Type:     logzMonitors.MonitorId{},
+	},
+	"azurerm_logz_sub_account": {
+		Validate: parser(subaccount.ParseAccountID),
+		Type:     subaccount.AccountId{},
+	},
+	"azurerm_logz_sub_account_tag_rule": {
+		Validate: parser(logzTagrules.ParseAccountTagRuleID),
+		Type:     logzTagrules.AccountTagRuleId{},
+	},
+	"azurerm_logz_tag_rule": {
+		Validate: parser(logzTagrules.ParseTagRuleID),
+		Type:     logzTagrules.TagRuleId{},
+	},
+	"azurerm_machine_learning_compute_cluster": {
+		Validate: parser(machinelearningcomputes.ParseComputeID),
+		Type:     machinelearningcomputes.ComputeId{},
+	},
+	"azurerm_machine_learning_compute_instance": {
+		Validate: parser(machinelearningcomputes.ParseComputeID),
+		Type:     machinelearningcomputes.ComputeId{},
+	},
+	"azurerm_machine_learning_inference_cluster": {
+		Validate: parser(machinelearningcomputes.ParseComputeID),
+		Type:     machinelearningcomputes.ComputeId{},
+	},
+	"azurerm_machine_learning_synapse_spark": {
+		Validate: parser(machinelearningcomputes.ParseComputeID),
+		Type:     machinelearningcomputes.ComputeId{},
+	},
+	"azurerm_machine_learning_workspace": {
+		Validate: parser(machinelearningservicesWorkspaces.ParseWorkspaceID),
+		Type:     machinelearningservicesWorkspaces.WorkspaceId{},
+	},
+	"azurerm_maintenance_assignment_dedicated_host": {
+		Validate: maintenanceAssignmentScope(commonids.ParseDedicatedHostID),
+		Type:     configurationassignments.ScopedConfigurationAssignmentId{Scope: exampleScope(commonids.DedicatedHostId{})},
+	},
+	"azurerm_maintenance_assignment_virtual_machine": {
+		Validate: maintenanceAssignmentScope(computeVirtualmachines.ParseVirtualMachineID),
+		Type:     configurationassignments.ScopedConfigurationAssignmentId{Scope: exampleScope(computeVirtualmachines.VirtualMachineId{})},
+	},
+	"azurerm_maintenance_assignment_virtual_machine_scale_set": {
+		Validate: maintenanceAssignmentScope(commonids.ParseVirtualMachineScaleSetID),
+		Type:     configurationassignments.ScopedConfigurationAssignmentId{Scope: exampleScope(commonids.VirtualMachineScaleSetId{})},
+	},
+	"azurerm_maintenance_configuration": {
+		Validate: parser(maintenanceMaintenanceconfigurations.ParseMaintenanceConfigurationIDInsensitively),
+		Type:     maintenanceMaintenanceconfigurations.MaintenanceConfigurationId{},
+	},
+	"azurerm_managed_application": {
+		Validate: parser(applications.ParseApplicationID),
+		Type:     applications.ApplicationId{},
+	},
+	"azurerm_managed_application_definition": {
+		Validate: parser(applicationdefinitions.ParseApplicationDefinitionID),
+		Type:     applicationdefinitions.ApplicationDefinitionId{},
+	},
+	"azurerm_managed_disk": {
+		Validate: parser(commonids.ParseManagedDiskID),
+		Type:     commonids.ManagedDiskId{},
+	},
+	"azurerm_managed_disk_sas_token": {
+		Validate: parser(commonids.ParseManagedDiskID),
+		Type:     commonids.ManagedDiskId{},
+	},
+	"azurerm_management_group": {
+		Validate: parser(managementgroupParse.ManagementGroupID),
+	},
+	"azurerm_management_group_policy_exemption": {
+		Validate: parser(policyParse.ResourcePolicyExemptionID),
+		Type:     policyParse.ResourcePolicyExemptionId{},
+	},
+	"azurerm_management_group_policy_remediation": {
+		Validate: parser(policyParse.ResourcePolicyRemediationID),
+		Type:     policyParse.ResourcePolicyRemediationId{},
+	},
+	"azurerm_management_group_subscription_association": {
+		Validate: parser(managementgroupParse.ManagementGroupSubscriptionAssociationID),
+	},
+	"azurerm_management_group_template_deployment": {
+		Validate: parser(resourceParse.ManagementGroupTemplateDeploymentID),
+		Type:     resourceParse.ManagementGroupTemplateDeploymentId{},
+	},
+	"azurerm_management_lock": {
+		Validate: parser(managementlocks.ParseScopedLockID),
+		Type:     managementlocks.ScopedLockId{},
+	},
+	"azurerm_maps_account": {
+		Validate: parser(mapsAccounts.ParseAccountID),
+		Type:     mapsAccounts.AccountId{},
+	},
+	"azurerm_maps_creator": {
+		Validate: parser(creators.ParseCreatorID),
+		Type:     creators.CreatorId{},
+	},
+	"azurerm_mariadb_configuration": {
+		Validate: parser(mariadbConfigurations.ParseConfigurationID),
+		Type:     mariadbConfigurations.ConfigurationId{},
+	},
+	"azurerm_mariadb_database": {
+		Validate: parser(mariadbDatabases.ParseDatabaseID),
+		Type:     mariadbDatabases.DatabaseId{},
+	},
+	"azurerm_mariadb_firewall_rule": {
+		Validate: parser(mariadbFirewallrules.ParseFirewallRuleID),
+		Type:     mariadbFirewallrules.FirewallRuleId{},
+	},
+	"azurerm_mariadb_server": {
+		Validate: parser(mariadbServers.ParseServerID),
+		Type:     mariadbServers.ServerId{},
+	},
+	"azurerm_mariadb_virtual_network_rule": {
+		Validate: parser(mariadbVirtualnetworkrules.ParseVirtualNetworkRuleID),
+		Type:     mariadbVirtualnetworkrules.VirtualNetworkRuleId{},
+	},
+	"azurerm_marketplace_agreement": {
+		Validate: parser(agreements.ParsePlanID),
+		Type:     agreements.PlanId{},
+	},
+	"azurerm_media_asset": {
+		Validate: parser(assetsandassetfilters.ParseAssetID),
+		Type:     assetsandassetfilters.AssetId{},
+	},
+	"azurerm_media_asset_filter": {
+		Validate: parser(assetsandassetfilters.ParseAssetFilterID),
+		Type:     assetsandassetfilters.AssetFilterId{},
+	},
+	"azurerm_media_content_key_policy": {
+		Validate: parser(contentkeypolicies.ParseContentKeyPolicyID),
+		Type:     contentkeypolicies.ContentKeyPolicyId{},
+	},
+	"azurerm_media_job": {
+		Validate: parser(encodings.ParseJobID),
+		Type:     encodings.JobId{},
+	},
+	"azurerm_media_live_event": {
+		Validate: parser(liveevents.ParseLiveEventID),
+		Type:     liveevents.LiveEventId{},
+	},
+	"azurerm_media_live_event_output": {
+		Validate: parser(liveoutputs.ParseLiveOutputID),
+		Type:     liveoutputs.LiveOutputId{},
+	},
+	"azurerm_media_services_account": {
+		Validate: parser(mediaAccounts.ParseMediaServiceID),
+		Type:     mediaAccounts.MediaServiceId{},
+	},
+	"azurerm_media_streaming_endpoint": {
+		Validate: parser(streamingendpoints.ParseStreamingEndpointID),
+		Type:     streamingendpoints.StreamingEndpointId{},
+	},
+	"azurerm_media_streaming_locator": {
+		Validate: parser(streamingpoliciesandstreaminglocators.ParseStreamingLocatorID),
+		Type:     streamingpoliciesandstreaminglocators.StreamingLocatorId{},
+	},
+	"azurerm_media_streaming_policy": {
+		Validate: parser(streamingpoliciesandstreaminglocators.ParseStreamingPolicyID),
+		Type:     streamingpoliciesandstreaminglocators.StreamingPolicyId{},
+	},
+	"azurerm_media_transform": {
+		Validate: parser(encodings.ParseTransformID),
+		Type:     encodings.TransformId{},
+	},
+	"azurerm_monitor_aad_diagnostic_setting": {
+		Validate: parser(diagnosticsettings.ParseDiagnosticSettingID),
+		Type:     diagnosticsettings.DiagnosticSettingId{},
+	},
+	"azurerm_monitor_action_group": {
+		Validate: parser(actiongroupsapis.ParseActionGroupID),
+		Type:     actiongroupsapis.ActionGroupId{},
+	},
+	"azurerm_monitor_action_rule_action_group": {
+		Validate: parser(monitorParse.ActionRuleID),
+		Type:     monitorParse.ActionRuleId{},
+	},
+	"azurerm_monitor_action_rule_suppression": {
+		Validate: parser(monitorParse.ActionRuleID),
+		Type:     monitorParse.ActionRuleId{},
+	},
+	"azurerm_monitor_activity_log_alert": {
+		Validate: parser(activitylogalertsapis.ParseActivityLogAlertID),
+		Type:     activitylogalertsapis.ActivityLogAlertId{},
+	},
+	"azurerm_monitor_autoscale_setting": {
+		Validate: parser(autoscalesettings.ParseAutoScaleSettingID),
+		Type:     autoscalesettings.AutoScaleSettingId{},
+	},
+	"azurerm_monitor_log_profile": {
+		Validate: parser(logprofiles.ParseLogProfileID),
+		Type:     logprofiles.LogProfileId{},
+	},
+	"azurerm_monitor_metric_alert": {
+		Validate: parser(metricalerts.ParseMetricAlertID),
+		Type:     metricalerts.MetricAlertId{},
+	},
+	"azurerm_monitor_private_link_scope": {
+		Validate: parser(privatelinkscopesapis.ParsePrivateLinkScopeID),
+		Type:     privatelinkscopesapis.PrivateLinkScopeId{},
+	},
+	"azurerm_monitor_private_link_scoped_service": {
+		Validate: parser(privatelinkscopedresources.ParseScopedResourceID),
+		Type:     privatelinkscopedresources.ScopedResourceId{},
+	},
+	"azurerm_monitor_scheduled_query_rules_alert": {
+		Validate: parser(insightsScheduledqueryrules_v2018_04_16.ParseScheduledQueryRuleID),
+		Type:     insightsScheduledqueryrules_v2018_04_16.ScheduledQueryRuleId{},
+	},
+	"azurerm_monitor_scheduled_query_rules_log": {
+		Validate: parser(insightsScheduledqueryrules_v2018_04_16.ParseScheduledQueryRuleID),
+		Type:     insightsScheduledqueryrules_v2018_04_16.ScheduledQueryRuleId{},
+	},
+	"azurerm_monitor_smart_detector_alert_rule": {
+		Validate: parser(monitorParse.SmartDetectorAlertRuleID),
+		Type:     monitorParse.SmartDetectorAlertRuleId{},
+	},
+	"azurerm_mssql_database": {
+		Validate: parser(commonids.ParseSqlDatabaseID),
+		Type:     commonids.SqlDatabaseId{},
+	},
+	"azurerm_mssql_database_extended_auditing_policy": {
+		Validate: parser(mssqlParse.DatabaseExtendedAuditingPolicyID),
+		Type:     mssqlParse.DatabaseExtendedAuditingPolicyId{},
+	},
+	"azurerm_mssql_database_vulnerability_assessment_rule_baseline": {
+		Validate: parser(mssqlParse.DatabaseVulnerabilityAssessmentRuleBaselineID),
+		Type:     mssqlParse.DatabaseVulnerabilityAssessmentRuleBaselineId{},
+	},
+	"azurerm_mssql_elasticpool": {
+		Validate: parser(mssqlParse.ElasticPoolID),
+		Type:     mssqlParse.ElasticPoolId{},
+	},
+	"azurerm_mssql_firewall_rule": {
+		Validate: parser(sqlParse.FirewallRuleID),
+		Type:     sqlParse.FirewallRuleId{},
+	},
+	"azurerm_mssql_job_agent": {
+		Validate: parser(mssqlParse.JobAgentID),
+		Type:     mssqlParse.JobAgentId{},
+	},
+	"azurerm_mssql_job_credential": {
+		Validate: parser(mssqlParse.JobCredentialID),
+		Type:     mssqlParse.JobCredentialId{},
+	},
+	"azurerm_mssql_managed_instance_security_alert_policy": {
+		Validate: parser(mssqlmanagedinstanceParse.ManagedInstancesSecurityAlertPolicyID),
+		Type:     mssqlmanagedinstanceParse.ManagedInstancesSecurityAlertPolicyId{},
+	},
+	"azurerm_mssql_managed_instance_transparent_data_encryption": {
+		Validate: parser(mssqlmanagedinstanceParse.ManagedInstanceEncryptionProtectorID),
+		Type:     mssqlmanagedinstanceParse.ManagedInstanceEncryptionProtectorId{},
+	},
+	"azurerm_mssql_managed_instance_vulnerability_assessment": {
+		Validate: parser(mssqlmanagedinstanceParse.ManagedInstanceVulnerabilityAssessmentID),
+		Type:     mssqlmanagedinstanceParse.ManagedInstanceVulnerabilityAssessmentId{},
+	},
+	"azurerm_mssql_outbound_firewall_rule": {
+		Validate: parser(mssqlParse.OutboundFirewallRuleID),
+		Type:     mssqlParse.OutboundFirewallRuleId{},
+	},
+	"azurerm_mssql_server": {
+		Validate: parser(mssqlParse.ServerID),
+		Type:     mssqlParse.ServerId{},
+	},
+	"azurerm_mssql_server_extended_auditing_policy": {
+		Validate: parser(mssqlParse.ServerExtendedAuditingPolicyID),
+		Type:     mssqlParse.ServerExtendedAuditingPolicyId{},
+	},
+	"azurerm_mssql_server_microsoft_support_auditing_policy": {
+		Validate: parser(mssqlParse.ServerMicrosoftSupportAuditingPolicyID),
+		Type:     mssqlParse.ServerMicrosoftSupportAuditingPolicyId{},
+	},
+	"azurerm_mssql_server_security_alert_policy": {
+		Validate: parser(mssqlParse.ServerSecurityAlertPolicyID),
+		Type:     mssqlParse.ServerSecurityAlertPolicyId{},
+	},
+	"azurerm_mssql_server_transparent_data_encryption": {
+		Validate: parser(mssqlParse.EncryptionProtectorID),
+		Type:     mssqlParse.EncryptionProtectorId{},
+	},
+	"azurerm_mssql_server_vulnerability_assessment": {
+		Validate: parser(mssqlParse.ServerVulnerabilityAssessmentID),
+		Type:     mssqlParse.ServerVulnerabilityAssessmentId{},
+	},
+	"azurerm_mssql_virtual_machine": {
+		Validate: parser(sqlvirtualmachines.ParseSqlVirtualMachineID),
+		Type:     sqlvirtualmachines.SqlVirtualMachineId{},
+	},
+	"azurerm_mssql_virtual_network_rule": {
+		Validate: parser(mssqlParse.VirtualNetworkRuleID),
+		Type:     mssqlParse.VirtualNetworkRuleId{},
+	},
+	"azurerm_mysql_active_directory_administrator": {
+		Validate: parser(mysqlParse.AzureActiveDirectoryAdministratorID),
+		Type:     mysqlParse.AzureActiveDirectoryAdministratorId{},
+	},
+	"azurerm_mysql_configuration": {
+		Validate: parser(mysqlConfigurations_v2017_12_01.ParseConfigurationID),
+		Type:     mysqlConfigurations_v2017_12_01.ConfigurationId{},
+	},
+	"azurerm_mysql_database": {
+		Validate: parser(mysqlDatabases_v2017_12_01.ParseDatabaseID),
+		Type:     mysqlDatabases_v2017_12_01.DatabaseId{},
+	},
+	"azurerm_mysql_firewall_rule": {
+		Validate: parser(mysqlFirewallrules_v2017_12_01.ParseFirewallRuleID),
+		Type:     mysqlFirewallrules_v2017_12_01.FirewallRuleId{},
+	},
+	"azurerm_mysql_flexible_database": {
+		Validate: parser(mysqlDatabases_v2022_01_01.ParseDatabaseID),
+		Type:     mysqlDatabases_v2022_01_01.DatabaseId{},
+	},
+	"azurerm_mysql_flexible_server": {
+		Validate: parser(mysqlServers_v2022_01_01.ParseFlexibleServerID),
+		Type:     mysqlServers_v2022_01_01.FlexibleServerId{},
+	},
+	"azurerm_mysql_flexible_server_configuration": {
+		Validate: parser(mysqlConfigurations_v2022_01_01.ParseConfigurationID),
+		Type:     mysqlConfigurations_v2022_01_01.ConfigurationId{},
+	},
+	"azurerm_mysql_flexible_server_firewall_rule": {
+		Validate: parser(mysqlFirewallrules_v2022_01_01.ParseFirewallRuleID),
+		Type:     mysqlFirewallrules_v2022_01_01.FirewallRuleId{},
+	},
+	"azurerm_mysql_server": {
+		Validate: parser(mysqlServers_v2017_12_01.ParseServerID),
+		Type:     mysqlServers_v2017_12_01.ServerId{},
+	},
+	"azurerm_mysql_server_key": {
+		Validate: parser(mysqlServerkeys.ParseKeyID),
+		Type:     mysqlServerkeys.KeyId{},
+	},
+	"azurerm_mysql_virtual_network_rule": {
+		Validate: parser(mysqlVirtualnetworkrules.ParseVirtualNetworkRuleID),
+		Type:     mysqlVirtualnetworkrules.VirtualNetworkRuleId{},
+	},
+	"azurerm_nat_gateway": {
+		Validate: parser(networkParse.NatGatewayID),
+		Type:     networkParse.NatGatewayId{},
+	},
+	"azurerm_nat_gateway_public_ip_association": {
+		Validate: parser(networkParse.NatGatewayPublicIPAddressAssociationID),
+	},
+	"azurerm_nat_gateway_public_ip_prefix_association": {
+		Validate: parser(networkParse.NatGatewayPublicIPPrefixAssociationID),
+	},
+	"azurerm_netapp_account": {
+		Validate: parser(netappaccounts.ParseNetAppAccountID),
+		Type:     netappaccounts.NetAppAccountId{},
+	},
+	"azurerm_netapp_pool": {
+		Validate: parser(capacitypools.ParseCapacityPoolID),
+		Type:     capacitypools.CapacityPoolId{},
+	},
+	"azurerm_netapp_snapshot": {
+		Validate: parser(netappSnapshots.ParseSnapshotID),
+		Type:     netappSnapshots.SnapshotId{},
+	},
+	"azurerm_netapp_snapshot_policy": {
+		Validate: parser(snapshotpolicy.ParseSnapshotPolicyID),
+		Type:     snapshotpolicy.SnapshotPolicyId{},
+	},
+	"azurerm_netapp_volume": {
+		Validate: parser(volumes.ParseVolumeID),
+		Type:     volumes.VolumeId{},
+	},
+	"azurerm_network_connection_monitor": {
+		Validate: parser(connectionmonitors.ParseConnectionMonitorID),
+		Type:     connectionmonitors.ConnectionMonitorId{},
+	},
+	"azurerm_network_ddos_protection_plan": {
+		Validate: parser(ddosprotectionplans.ParseDdosProtectionPlanID),
+		Type:     ddosprotectionplans.DdosProtectionPlanId{},
+	},
+	"azurerm_network_interface": {
+		Validate: parser(commonids.ParseNetworkInterfaceID),
+		Type:     commonids.NetworkInterfaceId{},
+	},
+	"azurerm_network_packet_capture": {
+		Validate: parser(packetcaptures.ParsePacketCaptureID),
+		Type:     packetcaptures.PacketCaptureId{},
+	},
+	"azurerm_network_profile": {
+		Validate: parser(networkprofiles.ParseNetworkProfileID),
+		Type:     networkprofiles.NetworkProfileId{},
+	},
+	"azurerm_network_security_group": {
+		Validate: parser(networkParse.NetworkSecurityGroupID),
+		Type:     networkParse.NetworkSecurityGroupId{},
+	},
+	"azurerm_network_security_rule": {
+		Validate: parser(securityrules.ParseSecurityRuleID),
+		Type:     securityrules.SecurityRuleId{},
+	},
+	"azurerm_network_watcher": {
+		Validate: parser(networkwatchers.ParseNetworkWatcherID),
+		Type:     networkwatchers.NetworkWatcherId{},
+	},
+	"azurerm_network_watcher_flow_log": {
+		Validate: parser(flowlogs.ParseFlowLogID),
+		Type:     flowlogs.FlowLogId{},
+	},
+	"azurerm_notification_hub": {
+		Validate: parser(notificationhubs.ParseNotificationHubID),
+		Type:     notificationhubs.NotificationHubId{},
+	},
+	"azurerm_notification_hub_authorization_rule": {
+		Validate: parser(notificationhubs.ParseNotificationHubAuthorizationRuleID),
+		Type:     notificationhubs.NotificationHubAuthorizationRuleId{},
+	},
+	"azurerm_notification_hub_namespace": {
+		Validate: parser(notificationhubsNamespaces.ParseNamespaceID),
+		Type:     notificationhubsNamespaces.NamespaceId{},
+	},
+	"azurerm_orchestrated_virtual_machine_scale_set": {
+		Validate: parser(commonids.ParseVirtualMachineScaleSetID),
+		Type:     commonids.VirtualMachineScaleSetId{},
+	},
+	"azurerm_point_to_site_vpn_gateway": {
+		Validate: parser(networkParse.PointToSiteVpnGatewayID),
+		Type:     networkParse.PointToSiteVpnGatewayId{},
+	},
+	"azurerm_policy_definition": {
+		Validate: parser(policyParse.PolicyDefinitionID),
+	},
+	"azurerm_policy_set_definition": {
+		Validate: parser(policyParse.PolicySetDefinitionID),
+	},
+	"azurerm_policy_virtual_machine_configuration_assignment": {
+		Validate: parser(guestconfigurationassignments.ParseProviders2GuestConfigurationAssignmentID),
+		Type:     guestconfigurationassignments.Providers2GuestConfigurationAssignmentId{},
+	},
+	"azurerm_portal_dashboard": {
+		Validate: parser(dashboard.ParseDashboardID),
+		Type:     dashboard.DashboardId{},
+	},
+	"azurerm_portal_tenant_configuration": {
+		Validate: parser(portalParse.PortalTenantConfigurationID),
+		Type:     portalParse.PortalTenantConfigurationId{},
+	},
+	"azurerm_postgresql_active_directory_administrator": {
+		Validate: parser(serveradministrators.ParseServerID),
+		Type:     serveradministrators.ServerId{},
+	},
+	"azurerm_postgresql_configuration": {
+		Validate: parser(postgresqlConfigurations_v2017_12_01.ParseConfigurationID),
+		Type:     postgresqlConfigurations_v2017_12_01.ConfigurationId{},
+	},
+	"azurerm_postgresql_database": {
+		Validate: parser(postgresqlDatabases_v2017_12_01.ParseDatabaseID),
+		Type:     postgresqlDatabases_v2017_12_01.DatabaseId{},
+	},
+	"azurerm_postgresql_firewall_rule": {
+		Validate: parser(postgresqlFirewallrules_v2017_12_01.ParseFirewallRuleID),
+		Type:     postgresqlFirewallrules_v2017_12_01.FirewallRuleId{},
+	},
+	"azurerm_postgresql_flexible_server": {
+		Validate: parser(postgresqlServers_v2023_06_01_preview.ParseFlexibleServerID),
+		Type:     postgresqlServers_v2023_06_01_preview.FlexibleServerId{},
+	},
+	"azurerm_postgresql_flexible_server_active_directory_administrator": {
+		Validate: parser(administrators.ParseAdministratorID),
+		Type:     administrators.AdministratorId{},
+	},
+	"azurerm_postgresql_flexible_server_configuration": {
+		Validate: parser(postgresqlConfigurations_v2021_06_01.ParseConfigurationID),
+		Type:     postgresqlConfigurations_v2021_06_01.ConfigurationId{},
+	},
+	"azurerm_postgresql_flexible_server_database": {
+		Validate: parser(postgresqlDatabases_v2022_12_01.ParseDatabaseID),
+		Type:     postgresqlDatabases_v2022_12_01.DatabaseId{},
+	},
+	"azurerm_postgresql_flexible_server_firewall_rule": {
+		Validate: parser(postgresqlFirewallrules_v2022_12_01.ParseFirewallRuleID),
+		Type:     postgresqlFirewallrules_v2022_12_01.FirewallRuleId{},
+	},
+	"azurerm_postgresql_server": {
+		Validate: parser(postgresqlServers_v2017_12_01.ParseServerID),
+		Type:     postgresqlServers_v2017_12_01.ServerId{},
+	},
+	"azurerm_postgresql_server_key": {
+		Validate: parser(postgresqlServerkeys.ParseKeyID),
+		Type:     postgresqlServerkeys.KeyId{},
+	},
+	"azurerm_postgresql_virtual_network_rule": {
+		Validate: parser(postgresqlVirtualnetworkrules.ParseVirtualNetworkRuleID),
+		Type:     postgresqlVirtualnetworkrules.VirtualNetworkRuleId{},
+	},
+	"azurerm_powerbi_embedded": {
+		Validate: parser(capacities.ParseCapacityID),
+		Type:     capacities.CapacityId{},
+	},
+	"azurerm_private_dns_a_record": {
+		Validate: privateDnsRecordType(privatednsRecordsets.RecordTypeA),
+		Type:     privatednsRecordsets.RecordTypeId{RecordType: privatednsRecordsets.RecordTypeA},
+	},
+	"azurerm_private_dns_aaaa_record": {
+		Validate: privateDnsRecordType(privatednsRecordsets.RecordTypeAAAA),
+		Type:     privatednsRecordsets.RecordTypeId{RecordType: privatednsRecordsets.RecordTypeAAAA},
+	},
+	"azurerm_private_dns_cname_record": {
+		Validate: privateDnsRecordType(privatednsRecordsets.RecordTypeCNAME),
+		Type:     privatednsRecordsets.RecordTypeId{RecordType: privatednsRecordsets.RecordTypeCNAME},
+	},
+	"azurerm_private_dns_mx_record": {
+		Validate: privateDnsRecordType(privatednsRecordsets.RecordTypeMX),
+		Type:     privatednsRecordsets.RecordTypeId{RecordType: privatednsRecordsets.RecordTypeMX},
+	},
+	"azurerm_private_dns_ptr_record": {
+		Validate: privateDnsRecordType(privatednsRecordsets.RecordTypePTR),
+		Type:     privatednsRecordsets.RecordTypeId{RecordType: privatednsRecordsets.RecordTypePTR},
+	},
+	"azurerm_private_dns_srv_record": {
+		Validate: privateDnsRecordType(privatednsRecordsets.RecordTypeSRV),
+		Type:     privatednsRecordsets.RecordTypeId{RecordType: privatednsRecordsets.RecordTypeSRV},
+	},
+	"azurerm_private_dns_txt_record": {
+		Validate: privateDnsRecordType(privatednsRecordsets.RecordTypeTXT),
+		Type:     privatednsRecordsets.RecordTypeId{RecordType: privatednsRecordsets.RecordTypeTXT},
+	},
+	"azurerm_private_dns_zone": {
+		Validate: parser(privatezones.ParsePrivateDnsZoneID),
+		Type:     privatezones.PrivateDnsZoneId{},
+	},
+	"azurerm_private_dns_zone_virtual_network_link": {
+		Validate: parser(privatednsVirtualnetworklinks.ParseVirtualNetworkLinkID),
+		Type:     privatednsVirtualnetworklinks.VirtualNetworkLinkId{},
+	},
+	"azurerm_private_endpoint": {
+		Validate: parser(networkPrivateendpoints.ParsePrivateEndpointID),
+		Type:     networkPrivateendpoints.PrivateEndpointId{},
+	},
+	"azurerm_private_link_service": {
+		Validate: parser(networkParse.PrivateLinkServiceID),
+		Type:     networkParse.PrivateLinkServiceId{},
+	},
+	"azurerm_proximity_placement_group": {
+		Validate: parser(proximityplacementgroups.ParseProximityPlacementGroupID),
+		Type:     proximityplacementgroups.ProximityPlacementGroupId{},
+	},
+	"azurerm_public_ip": {
+		Validate: parser(networkParse.PublicIpAddressID),
+		Type:     networkParse.PublicIpAddressId{},
+	},
+	"azurerm_public_ip_prefix": {
+		Validate: parser(networkParse.PublicIpPrefixID),
+		Type:     networkParse.PublicIpPrefixId{},
+	},
+	"azurerm_purview_account": {
+		Validate: parser(purviewAccount.ParseAccountID),
+		Type:     purviewAccount.AccountId{},
+	},
+	"azurerm_recovery_services_vault": {
+		Validate: parser(vaults.ParseVaultID),
+		Type:     vaults.VaultId{},
+	},
+	"azurerm_redis_cache": {
+		Validate: parser(redis.ParseRediID),
+		Type:     redis.RediId{},
+	},
+	"azurerm_redis_enterprise_cluster": {
+		Validate: parser(redisenterprise.ParseRedisEnterpriseID),
+		Type:     redisenterprise.RedisEnterpriseId{},
+	},
+	"azurerm_redis_enterprise_database": {
+		Validate: parser(redisenterprise.ParseDatabaseID),
+		Type:     redisenterprise.DatabaseId{},
+	},
+	"azurerm_redis_firewall_rule": {
+		Validate: parser(redisFirewallrules.ParseFirewallRuleID),
+		Type:     redisFirewallrules.FirewallRuleId{},
+	},
+	"azurerm_redis_linked_server": {
+		Validate: parser(redis.ParseLinkedServerID),
+		Type:     redis.LinkedServerId{},
+	},
+	"azurerm_relay_hybrid_connection": {
+		Validate: parser(hybridconnections.ParseHybridConnectionID),
+		Type:     hybridconnections.HybridConnectionId{},
+	},
+	"azurerm_relay_hybrid_connection_authorization_rule": {
+		Validate: parser(hybridconnections.ParseHybridConnectionAuthorizationRuleID),
+		Type:     hybridconnections.HybridConnectionAuthorizationRuleId{},
+	},
+	"azurerm_relay_namespace": {
+		Validate: parser(relayNamespaces.ParseNamespaceID),
+		Type:     relayNamespaces.NamespaceId{},
+	},
+	"azurerm_relay_namespace_authorization_rule": {
+		Validate: parser(relayNamespaces.ParseAuthorizationRuleID),
+		Type:     relayNamespaces.AuthorizationRuleId{},
+	},
+	"azurerm_resource_group": {
+		Validate: parser(resourceParse.ResourceGroupID),
+		Type:     resourceParse.ResourceGroupId{},
+	},
+	"azurerm_resource_group_policy_exemption": {
+		Validate: parser(policyParse.ResourceGroupPolicyExemptionID),
+		Type:     policyParse.ResourceGroupPolicyExemptionId{},
+	},
+	"azurerm_resource_group_policy_remediation": {
+		Validate: parser(policyParse.ResourceGroupPolicyRemediationID),
+		Type:     policyParse.ResourceGroupPolicyRemediationId{},
+	},
+	"azurerm_resource_group_template_deployment": {
+		Validate: parser(resourceParse.ResourceGroupTemplateDeploymentID),
+		Type:     resourceParse.ResourceGroupTemplateDeploymentId{},
+	},
+	"azurerm_resource_policy_exemption": {
+		Validate: parser(policyParse.ResourcePolicyExemptionID),
+		Type:     policyParse.ResourcePolicyExemptionId{},
+	},
+	"azurerm_resource_policy_remediation": {
+		Validate: parser(policyParse.ResourcePolicyRemediationID),
+		Type:     policyParse.ResourcePolicyRemediationId{},
+	},
+	"azurerm_role_assignment": {
+		Validate: parser(authorizationParse.RoleAssignmentID),
+	},
+	"azurerm_route": {
+		Validate: parser(routes.ParseRouteID),
+		Type:     routes.RouteId{},
+	},
+	"azurerm_route_filter": {
+		Validate: parser(routefilters.ParseRouteFilterID),
+		Type:     routefilters.RouteFilterId{},
+	},
+	"azurerm_route_server": {
+		Validate: parser(networkParse.VirtualHubID),
+		Type:     networkParse.VirtualHubId{},
+	},
+	"azurerm_route_server_bgp_connection": {
+		Validate: parser(networkParse.BgpConnectionID),
+		Type:     networkParse.BgpConnectionId{},
+	},
+	"azurerm_route_table": {
+		Validate: parser(routetables.ParseRouteTableID),
+		Type:     routetables.RouteTableId{},
+	},
+	"azurerm_search_service": {
+		Validate: parser(services.ParseSearchServiceID),
+		Type:     services.SearchServiceId{},
+	},
+	"azurerm_security_center_assessment": {
+		Validate: parser(securitycenterParse.AssessmentID),
+	},
+	"azurerm_security_center_assessment_policy": {
+		Validate: parser(assessmentsmetadata.ParseProviderAssessmentMetadataID),
+		Type:     assessmentsmetadata.ProviderAssessmentMetadataId{},
+	},
+	"azurerm_security_center_auto_provisioning": {
+		Validate: parser(securitycenterParse.AutoProvisioningSettingID),
+		Type:     securitycenterParse.AutoProvisioningSettingId{},
+	},
+	"azurerm_security_center_automation": {
+		Validate: parser(securitycenterParse.AutomationID),
+		Type:     securitycenterParse.AutomationId{},
+	},
+	"azurerm_security_center_contact": {
+		Validate: parser(securitycenterParse.ContactID),
+		Type:     securitycenterParse.ContactId{},
+	},
+	"azurerm_security_center_server_vulnerability_assessment_virtual_machine": {
+		Validate: parser(securitycenterParse.VulnerabilityAssessmentVmID),
+		Type:     securitycenterParse.VulnerabilityAssessmentVmId{},
+	},
+	"azurerm_security_center_server_vulnerability_assessments_setting": {
+		Validate: parser(securitycenterParse.VulnerabilityAssessmentsSettingID),
+		Type:     securitycenterParse.VulnerabilityAssessmentsSettingId{},
+	},
+	"azurerm_security_center_setting": {
+		Validate: parser(securitycenterParse.SettingID),
+		Type:     securitycenterParse.SettingId{},
+	},
+	"azurerm_security_center_subscription_pricing": {
+		Validate: parser(securitycenterParse.PricingID),
+		Type:     securitycenterParse.PricingId{},
+	},
+	"azurerm_security_center_workspace": {
+		Validate: parser(securitycenterParse.WorkspaceID),
+		Type:     securitycenterParse.WorkspaceId{},
+	},
+	"azurerm_sentinel_alert_rule_fusion": {
+		Validate: parser(alertrules.ParseAlertRuleID),
+		Type:     alertrules.AlertRuleId{},
+	},
+	"azurerm_sentinel_alert_rule_machine_learning_behavior_analytics": {
+		Validate: parser(alertrules.ParseAlertRuleID),
+		Type:     alertrules.AlertRuleId{},
+	},
+	"azurerm_sentinel_alert_rule_ms_security_incident": {
+		Validate: parser(alertrules.ParseAlertRuleID),
+		Type:     alertrules.AlertRuleId{},
+	},
+	"azurerm_sentinel_alert_rule_nrt": {
+		Validate: parser(alertrules.ParseAlertRuleID),
+		Type:     alertrules.AlertRuleId{},
+	},
+	"azurerm_sentinel_alert_rule_scheduled": {
+		Validate: parser(alertrules.ParseAlertRuleID),
+		Type:     alertrules.AlertRuleId{},
+	},
+	"azurerm_sentinel_automation_rule": {
+		Validate: parser(sentinelParse.AutomationRuleID),
+		Type:     sentinelParse.AutomationRuleId{},
+	},
+	"azurerm_sentinel_data_connector_aws_cloud_trail": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_azure_active_directory": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_azure_advanced_threat_protection": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_azure_security_center": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_microsoft_cloud_app_security": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_microsoft_defender_advanced_threat_protection": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_office_365": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_office_atp": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_sentinel_data_connector_threat_intelligence": {
+		Validate: parser(sentinelParse.DataConnectorID),
+		Type:     sentinelParse.DataConnectorId{},
+	},
+	"azurerm_service_fabric_cluster": {
+		Validate: parser(cluster.ParseClusterID),
+		Type:     cluster.ClusterId{},
+	},
+	"azurerm_servicebus_namespace": {
+		Validate: parser(servicebusNamespaces.ParseNamespaceID),
+		Type:     servicebusNamespaces.NamespaceId{},
+	},
+	"azurerm_servicebus_namespace_authorization_rule": {
+		Validate: parser(namespacesauthorizationrule.ParseAuthorizationRuleID),
+		Type:     namespacesauthorizationrule.AuthorizationRuleId{},
+	},
+	"azurerm_servicebus_namespace_disaster_recovery_config": {
+		Validate: parser(servicebusDisasterrecoveryconfigs.ParseDisasterRecoveryConfigID),
+		Type:     servicebusDisasterrecoveryconfigs.DisasterRecoveryConfigId{},
+	},
+	"azurerm_servicebus_namespace_network_rule_set": {
+		Validate: parser(servicebusNamespaces.ParseNamespaceID),
+		Type:     servicebusNamespaces.NamespaceId{},
+	},
+	"azurerm_servicebus_queue": {
+		Validate: parser(queues.ParseQueueID),
+		Type:     queues.QueueId{},
+	},
+	"azurerm_servicebus_queue_authorization_rule": {
+		Validate: parser(queuesauthorizationrule.ParseQueueAuthorizationRuleID),
+		Type:     queuesauthorizationrule.QueueAuthorizationRuleId{},
+	},
+	"azurerm_servicebus_subscription": {
+		Validate: parser(subscriptions.ParseSubscriptions2ID),
+		Type:     subscriptions.Subscriptions2Id{},
+	},
+	"azurerm_servicebus_subscription_rule": {
+		Validate: parser(servicebusRules.ParseRuleID),
+		Type:     servicebusRules.RuleId{},
+	},
+	"azurerm_servicebus_topic": {
+		Validate: parser(servicebusTopics.ParseTopicID),
+		Type:     servicebusTopics.TopicId{},
+	},
+	"azurerm_servicebus_topic_authorization_rule": {
+		Validate: parser(topicsauthorizationrule.ParseTopicAuthorizationRuleID),
+		Type:     topicsauthorizationrule.TopicAuthorizationRuleId{},
+	},
+	"azurerm_shared_image": {
+		Validate: parser(computeParse.SharedImageID),
+		Type:     computeParse.SharedImageId{},
+	},
+	"azurerm_shared_image_gallery": {
+		Validate: parser(commonids.ParseSharedImageGalleryID),
+		Type:     commonids.SharedImageGalleryId{},
+	},
+	"azurerm_shared_image_version": {
+		Validate: parser(computeParse.SharedImageVersionID),
+		Type:     computeParse.SharedImageVersionId{},
+	},
+	"azurerm_signalr_service": {
+		Validate: parser(signalr.ParseSignalRID),
+		Type:     signalr.SignalRId{},
+	},
+	"azurerm_signalr_service_network_acl": {
+		Validate: parser(signalr.ParseSignalRID),
+		Type:     signalr.SignalRId{},
+	},
+	"azurerm_signalr_shared_private_link_resource": {
+		Validate: parser(signalr.ParseSharedPrivateLinkResourceIDInsensitively),
+		Type:     signalr.SharedPrivateLinkResourceId{},
+	},
+	"azurerm_site_recovery_fabric": {
+		Validate: parser(recoveryservicesParse.ReplicationFabricID),
+		Type:     recoveryservicesParse.ReplicationFabricId{},
+	},
+	"azurerm_site_recovery_network_mapping": {
+		Validate: parser(replicationnetworkmappings.ParseReplicationNetworkMappingID),
+		Type:     replicationnetworkmappings.ReplicationNetworkMappingId{},
+	},
+	"azurerm_site_recovery_protection_container": {
+		Validate: parser(replicationprotectioncontainers.ParseReplicationProtectionContainerID),
+		Type:     replicationprotectioncontainers.ReplicationProtectionContainerId{},
+	},
+	"azurerm_site_recovery_protection_container_mapping": {
+		Validate: parser(recoveryservicesParse.ReplicationProtectionContainerMappingsID),
+		Type:     recoveryservicesParse.ReplicationProtectionContainerMappingsId{},
+	},
+	"azurerm_site_recovery_replicated_vm": {
+		Validate: parser(recoveryservicesParse.ReplicationProtectedItemID),
+		Type:     recoveryservicesParse.ReplicationProtectedItemId{},
+	},
+	"azurerm_site_recovery_replication_policy": {
+		Validate: parser(recoveryservicesParse.ReplicationPolicyID),
+		Type:     recoveryservicesParse.ReplicationPolicyId{},
+	},
+	"azurerm_snapshot": {
+		Validate: parser(computeSnapshots.ParseSnapshotID),
+		Type:     computeSnapshots.SnapshotId{},
+	},
+	"azurerm_spatial_anchors_account": {
+		Validate: parser(mixedrealityResource.ParseSpatialAnchorsAccountID),
+		Type:     mixedrealityResource.SpatialAnchorsAccountId{},
+	},
+	"azurerm_spring_cloud_active_deployment": {
+		Validate: parser(springcloudParse.SpringCloudAppID),
+		Type:     springcloudParse.SpringCloudAppId{},
+	},
+	"azurerm_spring_cloud_api_portal_custom_domain": {
+		Validate: parser(springcloudParse.SpringCloudAPIPortalCustomDomainID),
+		Type:     springcloudParse.SpringCloudAPIPortalCustomDomainId{},
+	},
+	"azurerm_spring_cloud_app": {
+		Validate: parser(springcloudParse.SpringCloudAppID),
+		Type:     springcloudParse.SpringCloudAppId{},
+	},
+	"azurerm_spring_cloud_app_cosmosdb_association": {
+		Validate: parser(springcloudParse.SpringCloudAppAssociationID),
+		Type:     springcloudParse.SpringCloudAppAssociationId{},
+	},
+	"azurerm_spring_cloud_app_mysql_association": {
+		Validate: parser(springcloudParse.SpringCloudAppAssociationID),
+		Type:     springcloudParse.SpringCloudAppAssociationId{},
+	},
+	"azurerm_spring_cloud_app_redis_association": {
+		Validate: parser(springcloudParse.SpringCloudAppAssociationID),
+		Type:     springcloudParse.SpringCloudAppAssociationId{},
+	},
+	"azurerm_spring_cloud_build_deployment": {
+		Validate: parser(springcloudParse.SpringCloudDeploymentID),
+		Type:     springcloudParse.SpringCloudDeploymentId{},
+	},
+	"azurerm_spring_cloud_build_pack_binding": {
+		Validate: parser(springcloudParse.SpringCloudBuildPackBindingID),
+		Type:     springcloudParse.SpringCloudBuildPackBindingId{},
+	},
+	"azurerm_spring_cloud_builder": {
+		Validate: parser(springcloudParse.SpringCloudBuildServiceBuilderID),
+		Type:     springcloudParse.SpringCloudBuildServiceBuilderId{},
+	},
+	"azurerm_spring_cloud_certificate": {
+		Validate: parser(springcloudParse.SpringCloudCertificateID),
+		Type:     springcloudParse.SpringCloudCertificateId{},
+	},
+	"azurerm_spring_cloud_container_deployment": {
+		Validate: parser(springcloudParse.SpringCloudDeploymentID),
+		Type:     springcloudParse.SpringCloudDeploymentId{},
+	},
+	"azurerm_spring_cloud_custom_domain": {
+		Validate: parser(springcloudParse.SpringCloudCustomDomainID),
+		Type:     springcloudParse.SpringCloudCustomDomainId{},
+	},
+	"azurerm_spring_cloud_gateway_custom_domain": {
+		Validate: parser(springcloudParse.SpringCloudGatewayCustomDomainID),
+		Type:     springcloudParse.SpringCloudGatewayCustomDomainId{},
+	},
+	"azurerm_spring_cloud_gateway_route_config": {
+		Validate: parser(springcloudParse.SpringCloudGatewayRouteConfigID),
+		Type:     springcloudParse.SpringCloudGatewayRouteConfigId{},
+	},
+	"azurerm_spring_cloud_java_deployment": {
+		Validate: parser(springcloudParse.SpringCloudDeploymentID),
+		Type:     springcloudParse.SpringCloudDeploymentId{},
+	},
+	"azurerm_spring_cloud_service": {
+		Validate: parser(springcloudParse.SpringCloudServiceID),
+		Type:     springcloudParse.SpringCloudServiceId{},
+	},
+	"azurerm_spring_cloud_storage": {
+		Validate: parser(springcloudParse.SpringCloudStorageID),
+		Type:     springcloudParse.SpringCloudStorageId{},
+	},
+	"azurerm_sql_active_directory_administrator": {
+		Validate: parser(sqlParse.AzureActiveDirectoryAdministratorID),
+		Type:     sqlParse.AzureActiveDirectoryAdministratorId{},
+	},
+	"azurerm_sql_database": {
+		Validate: parser(sqlParse.DatabaseID),
+		Type:     sqlParse.DatabaseId{},
+	},
+	"azurerm_sql_elasticpool": {
+		Validate: parser(sqlParse.ElasticPoolID),
+		Type:     sqlParse.ElasticPoolId{},
+	},
+	"azurerm_sql_failover_group": {
+		Validate: parser(sqlParse.FailoverGroupID),
+		Type:     sqlParse.FailoverGroupId{},
+	},
+	"azurerm_sql_firewall_rule": {
+		Validate: parser(sqlParse.FirewallRuleID),
+		Type:     sqlParse.FirewallRuleId{},
+	},
+	"azurerm_sql_managed_database": {
+		Validate: parser(sqlParse.ManagedDatabaseID),
+		Type:     sqlParse.ManagedDatabaseId{},
+	},
+	"azurerm_sql_managed_instance": {
+		Validate: parser(sqlParse.ManagedInstanceID),
+		Type:     sqlParse.ManagedInstanceId{},
+	},
+	"azurerm_sql_managed_instance_active_directory_administrator": {
+		Validate: parser(sqlParse.ManagedInstanceAzureActiveDirectoryAdministratorID),
+		Type:     sqlParse.ManagedInstanceAzureActiveDirectoryAdministratorId{},
+	},
+	"azurerm_sql_managed_instance_failover_group": {
+		Validate: parser(sqlParse.InstanceFailoverGroupID),
+		Type:     sqlParse.InstanceFailoverGroupId{},
+	},
+	"azurerm_sql_server": {
+		Validate: parser(sqlParse.ServerID),
+		Type:     sqlParse.ServerId{},
+	},
+	"azurerm_sql_virtual_network_rule": {
+		Validate: parser(sqlParse.VirtualNetworkRuleID),
+		Type:     sqlParse.VirtualNetworkRuleId{},
+	},
+	"azurerm_ssh_public_key": {
+		Validate: parser(sshpublickeys.ParseSshPublicKeyID),
+		Type:     sshpublickeys.SshPublicKeyId{},
+	},
+	"azurerm_stack_hci_cluster": {
+		Validate: parser(azurestackhciClusters.ParseClusterID),
+		Type:     azurestackhciClusters.ClusterId{},
+	},
+	"azurerm_static_site": {
+		Validate: parser(webParse.StaticSiteID),
+		Type:     webParse.StaticSiteId{},
+	},
+	"azurerm_static_site_custom_domain": {
+		Validate: parser(webParse.StaticSiteCustomDomainID),
+		Type:     webParse.StaticSiteCustomDomainId{},
+	},
+	"azurerm_storage_account": {
+		Validate: parser(commonids.ParseStorageAccountID),
+		Type:     commonids.StorageAccountId{},
+	},
+	"azurerm_storage_account_customer_managed_key": {
+		Validate: parser(commonids.ParseStorageAccountID),
+		Type:     commonids.StorageAccountId{},
+	},
+	"azurerm_storage_account_network_rules": {
+		Validate: parser(commonids.ParseStorageAccountID),
+		Type:     commonids.StorageAccountId{},
+	},
+	"azurerm_storage_blob": {
+		Validate: parser(blobs.ParseResourceID),
+	},
+	"azurerm_storage_blob_inventory_policy": {
+		Validate: parser(storageParse.BlobInventoryPolicyID),
+		Type:     storageParse.BlobInventoryPolicyId{},
+	},
+	"azurerm_storage_container": {
+		Validate: parser(storageParse.StorageContainerDataPlaneID),
+		Type:     storageParse.StorageContainerDataPlaneId{},
+	},
+	"azurerm_storage_data_lake_gen2_filesystem": {
+		Validate: parser(filesystems.ParseResourceID),
+	},
+	"azurerm_storage_data_lake_gen2_path": {
+		Validate: parser(paths.ParseResourceID),
+	},
+	"azurerm_storage_encryption_scope": {
+		Validate: parser(encryptionscopes.ParseEncryptionScopeID),
+		Type:     encryptionscopes.EncryptionScopeId{},
+	},
+	"azurerm_storage_management_policy": {
+		Validate: parser(storageParse.StorageAccountManagementPolicyID),
+		Type:     storageParse.StorageAccountManagementPolicyId{},
+	},
+	"azurerm_storage_object_replication": {
+		Validate: parser(storageParse.ObjectReplicationID),
+	},
+	"azurerm_storage_queue": {
+		Validate: parser(storageParse.StorageQueueDataPlaneID),
+		Type:     storageParse.StorageQueueDataPlaneId{},
+	},
+	"azurerm_storage_share": {
+		Validate: parser(storageParse.StorageShareDataPlaneID),
+		Type:     storageParse.StorageShareDataPlaneId{},
+	},
+	"azurerm_storage_share_directory": {
+		Validate: parser(directories.ParseResourceID),
+	},
+	"azurerm_storage_share_file": {
+		Validate: parser(files.ParseResourceID),
+	},
+	"azurerm_storage_sync": {
+		Validate: parser(storagesyncservicesresource.ParseStorageSyncServiceID),
+		Type:     storagesyncservicesresource.StorageSyncServiceId{},
+	},
+	"azurerm_storage_sync_cloud_endpoint": {
+		Validate: parser(cloudendpointresource.ParseCloudEndpointID),
+		Type:     cloudendpointresource.CloudEndpointId{},
+	},
+	"azurerm_storage_sync_group": {
+		Validate: parser(syncgroupresource.ParseSyncGroupID),
+		Type:     syncgroupresource.SyncGroupId{},
+	},
+	"azurerm_storage_table": {
+		Validate: parser(storageParse.StorageTableDataPlaneID),
+		Type:     storageParse.StorageTableDataPlaneId{},
+	},
+	"azurerm_storage_table_entity": {
+		Validate: parser(entities.ParseResourceID),
+	},
+	"azurerm_stream_analytics_function_javascript_uda": {
+		Validate: parser(functions.ParseFunctionID),
+		Type:     functions.FunctionId{},
+	},
+	"azurerm_stream_analytics_function_javascript_udf": {
+		Validate: parser(functions.ParseFunctionID),
+		Type:     functions.FunctionId{},
+	},
+	"azurerm_stream_analytics_job": {
+		Validate: parser(streamingjobs.ParseStreamingJobID),
+		Type:     streamingjobs.StreamingJobId{},
+	},
+	"azurerm_stream_analytics_output_blob": {
+		Validate: parser(outputs.ParseOutputID),
+		Type:     outputs.OutputId{},
+	},
+	"azurerm_stream_analytics_output_eventhub": {
+		Validate: parser(outputs.ParseOutputID),
+		Type:     outputs.OutputId{},
+	},
+	"azurerm_stream_analytics_output_mssql": {
+		Validate: parser(outputs.ParseOutputID),
+		Type:     outputs.OutputId{},
+	},
+	"azurerm_stream_analytics_output_servicebus_queue": {
+		Validate: parser(outputs.ParseOutputID),
+		Type:     outputs.OutputId{},
+	},
+	"azurerm_stream_analytics_output_servicebus_topic": {
+		Validate: parser(outputs.ParseOutputID),
+		Type:     outputs.OutputId{},
+	},
+	"azurerm_stream_analytics_output_synapse": {
+		Validate: parser(outputs.ParseOutputID),
+		Type:     outputs.OutputId{},
+	},
+	"azurerm_stream_analytics_reference_input_blob": {
+		Validate: parser(inputs.ParseInputID),
+		Type:     inputs.InputId{},
+	},
+	"azurerm_stream_analytics_reference_input_mssql": {
+		Validate: parser(inputs.ParseInputID),
+		Type:     inputs.InputId{},
+	},
+	"azurerm_stream_analytics_stream_input_blob": {
+		Validate: parser(inputs.ParseInputID),
+		Type:     inputs.InputId{},
+	},
+	"azurerm_stream_analytics_stream_input_eventhub": {
+		Validate: parser(inputs.ParseInputID),
+		Type:     inputs.InputId{},
+	},
+	"azurerm_stream_analytics_stream_input_iothub": {
+		Validate: parser(inputs.ParseInputID),
+		Type:     inputs.InputId{},
+	},
+	"azurerm_subnet": {
+		Validate: parser(commonids.ParseSubnetID),
+		Type:     commonids.SubnetId{},
+	},
+	"azurerm_subnet_nat_gateway_association": {
+		Validate: parser(commonids.ParseSubnetID),
+		Type:     commonids.SubnetId{},
+	},
+	"azurerm_subnet_network_security_group_association": {
+		Validate: parser(commonids.ParseSubnetID),
+		Type:     commonids.SubnetId{},
+	},
+	"azurerm_subnet_route_table_association": {
+		Validate: parser(commonids.ParseSubnetID),
+		Type:     commonids.SubnetId{},
+	},
+	"azurerm_subnet_service_endpoint_storage_policy": {
+		Validate: parser(networkParse.SubnetServiceEndpointStoragePolicyID),
+		Type:     networkParse.SubnetServiceEndpointStoragePolicyId{},
+	},
+	"azurerm_subscription": {
+		Validate: parser(subscriptionParse.SubscriptionAliasID),
+	},
+	"azurerm_subscription_policy_exemption": {
+		Validate: parser(policyParse.SubscriptionPolicyExemptionID),
+		Type:     policyParse.SubscriptionPolicyExemptionId{},
+	},
+	"azurerm_subscription_policy_remediation": {
+		Validate: parser(policyParse.SubscriptionPolicyRemediationID),
+		Type:     policyParse.SubscriptionPolicyRemediationId{},
+	},
+	"azurerm_subscription_template_deployment": {
+		Validate: parser(resourceParse.SubscriptionTemplateDeploymentID),
+		Type:     resourceParse.SubscriptionTemplateDeploymentId{},
+	},
+	"azurerm_synapse_firewall_rule": {
+		Validate: parser(synapseParse.FirewallRuleID),
+		Type:     synapseParse.FirewallRuleId{},
+	},
+	"azurerm_synapse_integration_runtime_azure": {
+		Validate: parser(synapseParse.IntegrationRuntimeID),
+		Type:     synapseParse.IntegrationRuntimeId{},
+	},
+	"azurerm_synapse_integration_runtime_self_hosted": {
+		Validate: parser(synapseParse.IntegrationRuntimeID),
+		Type:     synapseParse.IntegrationRuntimeId{},
+	},
+	"azurerm_synapse_linked_service": {
+		Validate: parser(synapseParse.LinkedServiceID),
+		Type:     synapseParse.LinkedServiceId{},
+	},
+	"azurerm_synapse_managed_private_endpoint": {
+		Validate: parser(synapseParse.ManagedPrivateEndpointID),
+		Type:     synapseParse.ManagedPrivateEndpointId{},
+	},
+	"azurerm_synapse_private_link_hub": {
+		Validate: parser(synapseParse.PrivateLinkHubID),
+		Type:     synapseParse.PrivateLinkHubId{},
+	},
+	"azurerm_synapse_role_assignment": {
+		Validate: parser(synapseParse.RoleAssignmentID),
+		Type:     synapseParse.RoleAssignmentId{},
+	},
+	"azurerm_synapse_spark_pool": {
+		Validate: parser(synapseParse.SparkPoolID),
+		Type:     synapseParse.SparkPoolId{},
+	},
+	"azurerm_synapse_sql_pool": {
+		Validate: parser(synapseParse.SqlPoolID),
+		Type:     synapseParse.SqlPoolId{},
+	},
+	"azurerm_synapse_sql_pool_extended_auditing_policy": {
+		Validate: parser(synapseParse.SqlPoolExtendedAuditingPolicyID),
+		Type:     synapseParse.SqlPoolExtendedAuditingPolicyId{},
+	},
+	"azurerm_synapse_sql_pool_security_alert_policy": {
+		Validate: parser(synapseParse.SqlPoolSecurityAlertPolicyID),
+		Type:     synapseParse.SqlPoolSecurityAlertPolicyId{},
+	},
+	"azurerm_synapse_sql_pool_vulnerability_assessment": {
+		Validate: parser(synapseParse.SqlPoolVulnerabilityAssessmentID),
+		Type:     synapseParse.SqlPoolVulnerabilityAssessmentId{},
+	},
+	"azurerm_synapse_sql_pool_vulnerability_assessment_baseline": {
+		Validate: parser(synapseParse.SqlPoolVulnerabilityAssessmentBaselineID),
+		Type:     synapseParse.SqlPoolVulnerabilityAssessmentBaselineId{},
+	},
+	"azurerm_synapse_sql_pool_workload_classifier": {
+		Validate: parser(synapseParse.SqlPoolWorkloadClassifierID),
+		Type:     synapseParse.SqlPoolWorkloadClassifierId{},
+	},
+	"azurerm_synapse_sql_pool_workload_group": {
+		Validate: parser(synapseParse.SqlPoolWorkloadGroupID),
+		Type:     synapseParse.SqlPoolWorkloadGroupId{},
+	},
+	"azurerm_synapse_workspace": {
+		Validate: parser(synapseParse.WorkspaceID),
+		Type:     synapseParse.WorkspaceId{},
+	},
+	"azurerm_synapse_workspace_aad_admin": {
+		Validate: parser(synapseParse.WorkspaceAADAdminID),
+		Type:     synapseParse.WorkspaceAADAdminId{},
+	},
+	"azurerm_synapse_workspace_extended_auditing_policy": {
+		Validate: parser(synapseParse.WorkspaceExtendedAuditingPolicyID),
+		Type:     synapseParse.WorkspaceExtendedAuditingPolicyId{},
+	},
+	"azurerm_synapse_workspace_key": {
+		Validate: parser(synapseParse.WorkspaceKeysID),
+		Type:     synapseParse.WorkspaceKeysId{},
+	},
+	"azurerm_synapse_workspace_security_alert_policy": {
+		Validate: parser(synapseParse.WorkspaceSecurityAlertPolicyID),
+		Type:     synapseParse.WorkspaceSecurityAlertPolicyId{},
+	},
+	"azurerm_synapse_workspace_sql_aad_admin": {
+		Validate: parser(synapseParse.WorkspaceSqlAADAdminID),
+		Type:     synapseParse.WorkspaceSqlAADAdminId{},
+	},
+	"azurerm_synapse_workspace_vulnerability_assessment": {
+		Validate: parser(synapseParse.WorkspaceVulnerabilityAssessmentID),
+		Type:     synapseParse.WorkspaceVulnerabilityAssessmentId{},
+	},
+	"azurerm_tenant_template_deployment": {
+		Validate: parser(resourceParse.TenantTemplateDeploymentID),
+		Type:     resourceParse.TenantTemplateDeploymentId{},
+	},
+	"azurerm_traffic_manager_azure_endpoint": {
+		Validate: trafficManagerEndpointType(endpoints.EndpointTypeAzureEndpoints),
+		Type:     endpoints.EndpointTypeId{EndpointType: endpoints.EndpointTypeAzureEndpoints},
+	},
+	"azurerm_traffic_manager_external_endpoint": {
+		Validate: trafficManagerEndpointType(endpoints.EndpointTypeExternalEndpoints),
+		Type:     endpoints.EndpointTypeId{EndpointType: endpoints.EndpointTypeExternalEndpoints},
+	},
+	"azurerm_traffic_manager_nested_endpoint": {
+		Validate: trafficManagerEndpointType(endpoints.EndpointTypeNestedEndpoints),
+		Type:     endpoints.EndpointTypeId{EndpointType: endpoints.EndpointTypeNestedEndpoints},
+	},
+	"azurerm_traffic_manager_profile": {
+		Validate: parser(profiles.ParseTrafficManagerProfileID),
+		Type:     profiles.TrafficManagerProfileId{},
+	},
+	"azurerm_video_analyzer": {
+		Validate: parser(videoanalyzers.ParseVideoAnalyzerID),
+		Type:     videoanalyzers.VideoAnalyzerId{},
+	},
+	"azurerm_video_analyzer_edge_module": {
+		Validate: parser(edgemodules.ParseEdgeModuleID),
+		Type:     edgemodules.EdgeModuleId{},
+	},
+	"azurerm_virtual_desktop_application": {
+		Validate: parser(desktopvirtualizationApplication.ParseApplicationID),
+		Type:     desktopvirtualizationApplication.ApplicationId{},
+	},
+	"azurerm_virtual_desktop_application_group": {
+		Validate: parser(applicationgroup.ParseApplicationGroupID),
+		Type:     applicationgroup.ApplicationGroupId{},
+	},
+	"azurerm_virtual_desktop_host_pool": {
+		Validate: parser(hostpool.ParseHostPoolID),
+		Type:     hostpool.HostPoolId{},
+	},
+	"azurerm_virtual_desktop_host_pool_registration_info": {
+		Validate: parser(desktopvirtualizationParse.HostPoolRegistrationInfoID),
+		Type:     desktopvirtualizationParse.HostPoolRegistrationInfoId{},
+	},
+	"azurerm_virtual_desktop_scaling_plan": {
+		Validate: parser(scalingplan.ParseScalingPlanID),
+		Type:     scalingplan.ScalingPlanId{},
+	},
+	"azurerm_virtual_desktop_workspace": {
+		Validate: parser(workspace.ParseWorkspaceID),
+		Type:     workspace.WorkspaceId{},
+	},
+	"azurerm_virtual_desktop_workspace_application_group_association": {
+		Validate: parser(desktopvirtualizationParse.WorkspaceApplicationGroupAssociationID),
+	},
+	"azurerm_virtual_hub": {
+		Validate: parser(networkParse.VirtualHubID),
+		Type:     networkParse.VirtualHubId{},
+	},
+	"azurerm_virtual_hub_bgp_connection": {
+		Validate: parser(networkParse.BgpConnectionID),
+		Type:     networkParse.BgpConnectionId{},
+	},
+	"azurerm_virtual_hub_connection": {
+		Validate: parser(networkParse.HubVirtualNetworkConnectionID),
+		Type:     networkParse.HubVirtualNetworkConnectionId{},
+	},
+	"azurerm_virtual_hub_ip": {
+		Validate: parser(networkParse.VirtualHubIpConfigurationID),
+		Type:     networkParse.VirtualHubIpConfigurationId{},
+	},
+	"azurerm_virtual_hub_route_table": {
+		Validate: parser(networkParse.HubRouteTableID),
+		Type:     networkParse.HubRouteTableId{},
+	},
+	"azurerm_virtual_hub_route_table_route": {
+		Validate: parser(networkParse.HubRouteTableRouteID),
+		Type:     networkParse.HubRouteTableRouteId{},
+	},
+	"azurerm_virtual_hub_security_partner_provider": {
+		Validate: parser(networkParse.SecurityPartnerProviderID),
+		Type:     networkParse.SecurityPartnerProviderId{},
+	},
+	"azurerm_virtual_machine": {
+		Validate: parser(commonids.ParseVirtualMachineID),
+		Type:     commonids.VirtualMachineId{},
+	},
+	"azurerm_virtual_machine_data_disk_attachment": {
+		Validate: parser(computeParse.DataDiskID),
+		Type:     computeParse.DataDiskId{},
+	},
+	"azurerm_virtual_machine_extension": {
+		Validate: parser(computeParse.VirtualMachineExtensionID),
+		Type:     computeParse.VirtualMachineExtensionId{},
+	},
+	"azurerm_virtual_machine_packet_capture": {
+		Validate: parser(packetcaptures.ParsePacketCaptureID),
+		Type:     packetcaptures.PacketCaptureId{},
+	},
+	"azurerm_virtual_machine_scale_set": {
+		Validate: parser(commonids.ParseVirtualMachineScaleSetID),
+		Type:     commonids.VirtualMachineScaleSetId{},
+	},
+	"azurerm_virtual_machine_scale_set_extension": {
+		Validate: parser(computeParse.VirtualMachineScaleSetExtensionID),
+		Type:     computeParse.VirtualMachineScaleSetExtensionId{},
+	},
+	"azurerm_virtual_machine_scale_set_packet_capture": {
+		Validate: parser(packetcaptures.ParsePacketCaptureID),
+		Type:     packetcaptures.PacketCaptureId{},
+	},
+	"azurerm_virtual_network": {
+		Validate: parser(commonids.ParseVirtualNetworkID),
+		Type:     commonids.VirtualNetworkId{},
+	},
+	"azurerm_virtual_network_dns_servers": {
+		Validate: parser(networkParse.VirtualNetworkDnsServersID),
+		Type:     networkParse.VirtualNetworkDnsServersId{},
+	},
+	"azurerm_virtual_network_gateway": {
+		Validate: parser(networkParse.VirtualNetworkGatewayID),
+		Type:     networkParse.VirtualNetworkGatewayId{},
+	},
+	"azurerm_virtual_network_gateway_connection": {
+		Validate: parser(networkParse.NetworkGatewayConnectionID),
+		Type:     networkParse.NetworkGatewayConnectionId{},
+	},
+	"azurerm_virtual_network_gateway_nat_rule": {
+		Validate: parser(networkParse.VirtualNetworkGatewayNatRuleID),
+		Type:     networkParse.VirtualNetworkGatewayNatRuleId{},
+	},
+	"azurerm_virtual_network_peering": {
+		Validate: parser(networkParse.VirtualNetworkPeeringID),
+		Type:     networkParse.VirtualNetworkPeeringId{},
+	},
+	"azurerm_virtual_wan": {
+		Validate: parser(networkParse.VirtualWanID),
+		Type:     networkParse.VirtualWanId{},
+	},
+	"azurerm_vmware_cluster": {
+		Validate: parser(vmwareClusters.ParseClusterID),
+		Type:     vmwareClusters.ClusterId{},
+	},
+	"azurerm_vmware_express_route_authorization": {
+		Validate: parser(authorizations.ParseAuthorizationID),
+		Type:     authorizations.AuthorizationId{},
+	},
+	"azurerm_vmware_private_cloud": {
+		Validate: parser(privateclouds.ParsePrivateCloudID),
+		Type:     privateclouds.PrivateCloudId{},
+	},
+	"azurerm_vpn_gateway": {
+		Validate: parser(virtualwans.ParseVpnGatewayID),
+		Type:     virtualwans.VpnGatewayId{},
+	},
+	"azurerm_vpn_gateway_connection": {
+		Validate: parser(commonids.ParseVPNConnectionID),
+		Type:     commonids.VPNConnectionId{},
+	},
+	"azurerm_vpn_gateway_nat_rule": {
+		Validate: parser(networkParse.VpnGatewayNatRuleID),
+		Type:     networkParse.VpnGatewayNatRuleId{},
+	},
+	"azurerm_vpn_server_configuration": {
+		Validate: parser(virtualwans.ParseVpnServerConfigurationID),
+		Type:     virtualwans.VpnServerConfigurationId{},
+	},
+	"azurerm_vpn_server_configuration_policy_group": {
+		Validate: parser(virtualwans.ParseConfigurationPolicyGroupID),
+		Type:     virtualwans.ConfigurationPolicyGroupId{},
+	},
+	"azurerm_vpn_site": {
+		Validate: parser(virtualwans.ParseVpnSiteID),
+		Type:     virtualwans.VpnSiteId{},
+	},
+	"azurerm_web_application_firewall_policy": {
+		Validate: parser(webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID),
+		Type:     webapplicationfirewallpolicies.ApplicationGatewayWebApplicationFirewallPolicyId{},
+	},
+	"azurerm_web_pubsub": {
+		Validate: parser(webpubsub.ParseWebPubSubID),
+		Type:     webpubsub.WebPubSubId{},
+	},
+	"azurerm_web_pubsub_hub": {
+		Validate: parser(webpubsub.ParseHubID),
+		Type:     webpubsub.HubId{},
+	},
+	"azurerm_web_pubsub_network_acl": {
+		Validate: parser(webpubsub.ParseWebPubSubID),
+		Type:     webpubsub.WebPubSubId{},
+	},
+	"azurerm_web_pubsub_shared_private_link_resource": {
+		Validate: parser(webpubsub.ParseSharedPrivateLinkResourceID),
+		Type:     webpubsub.SharedPrivateLinkResourceId{},
+	},
+	"azurerm_windows_virtual_machine": {
+		Validate: parser(commonids.ParseVirtualMachineID),
+		Type:     commonids.VirtualMachineId{},
+	},
+	"azurerm_windows_virtual_machine_scale_set": {
+		Validate: parser(commonids.ParseVirtualMachineScaleSetID),
+		Type:     commonids.VirtualMachineScaleSetId{},
+	},
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package resourceidexample builds an example of a Resource ID from its type, for use by the tooling (e.g. when
+// generating documentation).
+//
+// For a `resourceids.ResourceId` the example is built from the example value of each Segment returned from its
+// `Segments()` function, and for the legacy Resource ID types (within the `parse` package of each Service) an example
+// value is used for each field.
+package resourceidexample
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// FromResourceIdType returns an example of the specified type of Resource ID.
+//
+// Any fields which are already set on the Resource ID are kept (e.g. the Record Type of a DNS Record Set, where
+// each Record Type is managed by a different Resource) - the remaining fields use an example value. This returns
+// false when the type of Resource ID isn't known or the example can't be built.
+func FromResourceIdType(id resourceids.Id) (example string, ok bool) {
+	// some legacy Resource ID types contain nested Resource IDs, which panic when they're not set
+	defer func() {
+		if r := recover(); r != nil {
+			example, ok = "", false
+		}
+	}()
+
+	if id == nil {
+		return "", false
+	}
+
+	value := reflect.ValueOf(id)
+	if value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return "", false
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	// populate a copy so that the Resource ID which was passed in isn't modified
+	output := reflect.New(value.Type())
+	output.Elem().Set(value)
+
+	if resourceId, isResourceId := output.Interface().(resourceids.ResourceId); isResourceId {
+		return exampleFromSegments(output.Elem(), resourceId.Segments())
+	}
+
+	for i := 0; i < output.Elem().NumField(); i++ {
+		field := output.Elem().Field(i)
+		if !field.CanSet() || field.Kind() != reflect.String || field.String() != "" {
+			continue
+		}
+		field.SetString(exampleValueForField(value.Type().Name(), value.Type().Field(i).Name))
+	}
+
+	legacyId, isId := output.Interface().(resourceids.Id)
+	if !isId {
+		return "", false
+	}
+	return legacyId.ID(), true
+}
+
+// exampleFromSegments returns an example using the example value for each Segment, unless the field for that
+// Segment has already been set on the Resource ID
+func exampleFromSegments(value reflect.Value, segments []resourceids.Segment) (string, bool) {
+	if len(segments) == 0 {
+		return "", false
+	}
+
+	components := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		example := segment.ExampleValue
+		if segment.Type != resourceids.StaticSegmentType && segment.Type != resourceids.ResourceProviderSegmentType {
+			// the field for each Segment is the name of the Segment, e.g. `resourceGroupName` -> `ResourceGroupName`
+			field := value.FieldByName(strings.ToUpper(segment.Name[:1]) + segment.Name[1:])
+			if field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+				example = field.String()
+			}
+		}
+
+		// Scope Segments contain an example Resource ID, which is prefixed with `/`
+		components = append(components, strings.TrimPrefix(example, "/"))
+	}
+
+	return "/" + strings.Join(components, "/"), true
+}
+
+// exampleValueForField returns an example value for the field of a legacy Resource ID type, matching the
+// example values used for the Segments of a `resourceids.ResourceId` where possible
+func exampleValueForField(typeName string, fieldName string) string {
+	switch fieldName {
+	case "SubscriptionId":
+		return "12345678-1234-9876-4563-123456789012"
+	case "ResourceGroup", "ResourceGroupName":
+		return "example-resource-group"
+	case "Name":
+		fieldName = strings.TrimSuffix(typeName, "Id")
+	}
+
+	fieldName = strings.TrimSuffix(fieldName, "Name")
+	return strings.ToLower(fieldName[:1]) + fieldName[1:] + "Value"
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceidexample
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2023-10-15/fleets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+func TestFromResourceIdType(t *testing.T) {
+	cases := []struct {
+		name     string
+		id       resourceids.Id
+		expected string
+	}{
+		{
+			name:     "resource id",
+			id:       fleets.FleetId{},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.ContainerService/fleets/fleetValue",
+		},
+		{
+			name:     "resource id pointer",
+			id:       &commonids.KubernetesClusterId{},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.ContainerService/managedClusters/managedClusterValue",
+		},
+		{
+			name:     "scoped resource id",
+			id:       commonids.ScopeId{},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group",
+		},
+		{
+			name:     "resource id with a field set",
+			id:       recordsets.RecordTypeId{RecordType: recordsets.RecordTypeCNAME},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Network/dnsZones/dnsZoneValue/CNAME/relativeRecordSetValue",
+		},
+		{
+			name:     "legacy resource id",
+			id:       parse.ApplicationGatewayId{},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/example-resource-group/providers/Microsoft.Network/applicationGateways/applicationGatewayValue",
+		},
+		{
+			name: "resource id type unknown",
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			actual, ok := FromResourceIdType(v.id)
+			if ok != (v.expected != "") {
+				t.Fatalf("expected ok to be %t but got %t (%q)", v.expected != "", ok, actual)
+			}
+			if actual != v.expected {
+				t.Fatalf("expected %q but got %q", v.expected, actual)
+			}
+		})
+	}
+}
@@ -34,6 +34,9 @@ func main() {
 	serviceName := f.String("service", "", "-service=compute")
 	oldApiVersion := f.String("old-api-version", "", "-old-api-version=2019-01-01")
 	newApiVersion := f.String("new-api-version", "", "-new-api-version=2023-06-01")
+	report := f.Bool("report", false, "-report (outputs a report of the changes between the API versions, rather than updating the imports)")
+	reportPath := f.String("report-path", "", "-report-path=report.md (defaults to stdout)")
+	sdkPath := f.String("sdk-path", "", "-sdk-path=/path/to/go-azure-sdk/resource-manager (defaults to the version within the Go module cache)")
 	if len(os.Args) == 1 { // 0 is the app name
 		log.Fatalf("expected multiple arguments but didn't get any")
 	}
@@ -43,6 +46,23 @@ func main() {
 	if serviceName == nil || *serviceName == "" {
 		log.Fatalf("missing `-service`")
 	}
+
+	workingDirectory := "../.." // path to the `internal` folder
+	if *report {
+		output, err := runReport(*serviceName, *oldApiVersion, *newApiVersion, *sdkPath, workingDirectory)
+		if err != nil {
+			log.Fatalf("error: %+v", err)
+		}
+		if *reportPath == "" {
+			fmt.Print(*output)
+			return
+		}
+		if err := os.WriteFile(*reportPath, []byte(*output), 0644); err != nil {
+			log.Fatalf("writing the report to %q: %+v", *reportPath, err)
+		}
+		return
+	}
+
 	if oldApiVersion == nil || *oldApiVersion == "" {
 		log.Fatalf("missing `-old-api-version`")
 	}
@@ -50,7 +70,6 @@ func main() {
 		log.Fatalf("missing `-new-api-version`")
 	}
 
-	workingDirectory := "../.." // path to the `internal` folder
 	if err := run(*serviceName, *oldApiVersion, *newApiVersion, workingDirectory); err != nil {
 		log.Fatalf("error: %+v", err)
 	}
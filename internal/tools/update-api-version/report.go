@@ -0,0 +1,837 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const sdkResourceManagerModule = "github.com/hashicorp/go-azure-sdk/resource-manager"
+
+var apiVersionRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
+
+// reportTarget is an API Version of a go-azure-sdk Service which is used by a Service Package
+type reportTarget struct {
+	// serviceName is the name of the Service Package, e.g. `containers`
+	serviceName string
+
+	// sdkServiceName is the name of the Service within go-azure-sdk, e.g. `containerservice`
+	sdkServiceName string
+
+	oldApiVersion string
+	newApiVersion string
+}
+
+// runReport outputs a report detailing the changes between the old and new API Versions of the SDK Packages
+// used by the specified Service Package(s), and the functions within the Service Package(s) impacted by them.
+//
+// When `oldApiVersion` is empty each API Version used by the Service is reported on, and when `newApiVersion`
+// is empty (or `latest`) the latest API Version available within go-azure-sdk is used.
+func runReport(serviceName, oldApiVersion, newApiVersion, sdkPath, workingDirectory string) (*string, error) {
+	if sdkPath == "" {
+		logger.Debug("Determining the path to the go-azure-sdk Resource Manager module..")
+		modulePath, err := sdkModulePath(workingDirectory)
+		if err != nil {
+			return nil, err
+		}
+		sdkPath = *modulePath
+	}
+	logger.Debug(fmt.Sprintf("Using the go-azure-sdk Resource Manager module at %q", sdkPath))
+
+	serviceNames := []string{serviceName}
+	if serviceName == "all" {
+		entries, err := os.ReadDir(path.Join(workingDirectory, "services"))
+		if err != nil {
+			return nil, fmt.Errorf("listing the Service Packages: %+v", err)
+		}
+		serviceNames = make([]string, 0)
+		for _, entry := range entries {
+			if entry.IsDir() {
+				serviceNames = append(serviceNames, entry.Name())
+			}
+		}
+	}
+
+	output := strings.Builder{}
+	summary := strings.Builder{}
+	summary.WriteString("# API Version Upgrade Report\n\n")
+	summary.WriteString("| Service | SDK Service | Old API Version | New API Version | Breaking Changes | Impacted Functions | New Properties |\n")
+	summary.WriteString("| ------- | ----------- | --------------- | --------------- | ---------------- | ------------------ | -------------- |\n")
+
+	for _, name := range serviceNames {
+		serviceDirectory := path.Join(workingDirectory, "services", name)
+		usages, err := parseServiceUsages(serviceDirectory)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the Service Package %q: %+v", name, err)
+		}
+
+		for _, target := range reportTargetsForService(name, usages, oldApiVersion) {
+			target.newApiVersion = newApiVersion
+			if target.newApiVersion == "" || target.newApiVersion == "latest" {
+				latest, err := latestApiVersion(sdkPath, target.sdkServiceName, target.oldApiVersion)
+				if err != nil {
+					return nil, err
+				}
+				if latest == nil {
+					logger.Debug(fmt.Sprintf("Service %q is using the latest API Version %q of %q", name, target.oldApiVersion, target.sdkServiceName))
+					continue
+				}
+				target.newApiVersion = *latest
+			}
+
+			logger.Info(fmt.Sprintf("Comparing %q API Version %q to %q for Service %q..", target.sdkServiceName, target.oldApiVersion, target.newApiVersion, name))
+			diffs, err := diffApiVersions(sdkPath, target)
+			if err != nil {
+				return nil, err
+			}
+
+			result := buildServiceReport(target, diffs, usages)
+			summary.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %d | %d | %d |\n", target.serviceName, target.sdkServiceName, target.oldApiVersion, target.newApiVersion, result.breakingChanges, len(result.impactedFunctions), result.newProperties))
+			output.WriteString(result.content)
+		}
+	}
+
+	report := fmt.Sprintf("%s\n%s", summary.String(), output.String())
+	return &report, nil
+}
+
+// sdkModulePath returns the path to the go-azure-sdk Resource Manager module on disk, which contains every API Version
+func sdkModulePath(workingDirectory string) (*string, error) {
+	cmd := exec.Command("go", "list", "-mod=mod", "-m", "-f", "{{.Dir}}", sdkResourceManagerModule)
+	cmd.Dir = workingDirectory
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("determining the path to %q (specify this using `-sdk-path`): %+v", sdkResourceManagerModule, err)
+	}
+
+	path := strings.TrimSpace(string(out))
+	if path == "" {
+		return nil, fmt.Errorf("the path to %q couldn't be determined - specify this using `-sdk-path`", sdkResourceManagerModule)
+	}
+
+	return &path, nil
+}
+
+// latestApiVersion returns the latest API Version available for the SDK Service which is newer than `currentApiVersion`,
+// preferring Stable API Versions over Preview API Versions - or nil if `currentApiVersion` is the latest
+func latestApiVersion(sdkPath, sdkServiceName, currentApiVersion string) (*string, error) {
+	entries, err := os.ReadDir(path.Join(sdkPath, sdkServiceName))
+	if err != nil {
+		return nil, fmt.Errorf("listing the API Versions for %q: %+v", sdkServiceName, err)
+	}
+
+	var latestStable, latestPreview string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || !apiVersionRegex.MatchString(name) || name <= currentApiVersion {
+			continue
+		}
+
+		if strings.HasSuffix(name, "-preview") {
+			if name > latestPreview {
+				latestPreview = name
+			}
+			continue
+		}
+		if name > latestStable {
+			latestStable = name
+		}
+	}
+
+	if latestStable != "" {
+		return &latestStable, nil
+	}
+	if latestPreview != "" {
+		return &latestPreview, nil
+	}
+	return nil, nil
+}
+
+// sdkModel is a Model (struct) within an SDK Package
+type sdkModel struct {
+	// fields is a map of the Go field name to the field
+	fields map[string]sdkField
+}
+
+type sdkField struct {
+	name     string
+	jsonName string
+	typeName string
+}
+
+// sdkPackage is an SDK Package (e.g. `virtualnetworks`) within an API Version
+type sdkPackage struct {
+	models map[string]sdkModel
+
+	// enums is a map of the Constant type name to the possible values
+	enums map[string][]string
+}
+
+// parseSdkPackage parses the Models and Constants defined within the SDK Package at `directory`
+func parseSdkPackage(directory string) (*sdkPackage, error) {
+	fileSet := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fileSet, directory, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", directory, err)
+	}
+
+	files := make([]*ast.File, 0)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			files = append(files, file)
+		}
+	}
+	return parseSdkPackageFiles(files), nil
+}
+
+func parseSdkPackageFiles(files []*ast.File) *sdkPackage {
+	output := sdkPackage{
+		models: map[string]sdkModel{},
+		enums:  map[string][]string{},
+	}
+
+	constants := make(map[string][]string)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				switch v := spec.(type) {
+				case *ast.TypeSpec:
+					switch t := v.Type.(type) {
+					case *ast.StructType:
+						output.models[v.Name.Name] = parseSdkModel(t)
+					case *ast.Ident:
+						if t.Name == "string" || t.Name == "int64" || t.Name == "float64" {
+							if _, ok := output.enums[v.Name.Name]; !ok {
+								output.enums[v.Name.Name] = make([]string, 0)
+							}
+						}
+					}
+
+				case *ast.ValueSpec:
+					if genDecl.Tok != token.CONST || v.Type == nil {
+						continue
+					}
+					typeName, ok := v.Type.(*ast.Ident)
+					if !ok {
+						continue
+					}
+					for _, value := range v.Values {
+						if lit, ok := value.(*ast.BasicLit); ok {
+							unquoted, err := strconv.Unquote(lit.Value)
+							if err != nil {
+								unquoted = lit.Value
+							}
+							constants[typeName.Name] = append(constants[typeName.Name], unquoted)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for name, values := range constants {
+		if _, ok := output.enums[name]; ok {
+			sort.Strings(values)
+			output.enums[name] = values
+		}
+	}
+
+	return &output
+}
+
+func parseSdkModel(input *ast.StructType) sdkModel {
+	output := sdkModel{
+		fields: map[string]sdkField{},
+	}
+	for _, field := range input.Fields.List {
+		jsonName := ""
+		if field.Tag != nil {
+			if tag, err := strconv.Unquote(field.Tag.Value); err == nil {
+				jsonName = strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+			}
+		}
+
+		for _, name := range field.Names {
+			output.fields[name.Name] = sdkField{
+				name:     name.Name,
+				jsonName: jsonName,
+				typeName: types.ExprString(field.Type),
+			}
+		}
+	}
+	return output
+}
+
+type fieldRename struct {
+	old sdkField
+	new sdkField
+}
+
+type fieldTypeChange struct {
+	field   string
+	oldType string
+	newType string
+}
+
+type modelDiff struct {
+	name        string
+	added       []sdkField
+	removed     []sdkField
+	renamed     []fieldRename
+	typeChanged []fieldTypeChange
+}
+
+type enumDiff struct {
+	name    string
+	added   []string
+	removed []string
+}
+
+type packageDiff struct {
+	name           string
+	addedPackage   bool
+	removedPackage bool
+	addedModels    []string
+	removedModels  []string
+	models         []modelDiff
+	addedEnums     []string
+	removedEnums   []string
+	enums          []enumDiff
+
+	// newModels is used to determine the models reachable from those referenced by the Service
+	newModels map[string]sdkModel
+}
+
+// diffApiVersions compares each of the SDK Packages within the old and new API Versions
+func diffApiVersions(sdkPath string, target reportTarget) ([]packageDiff, error) {
+	oldDirectory := path.Join(sdkPath, target.sdkServiceName, target.oldApiVersion)
+	newDirectory := path.Join(sdkPath, target.sdkServiceName, target.newApiVersion)
+
+	oldPackages, err := listSdkPackages(oldDirectory)
+	if err != nil {
+		return nil, err
+	}
+	newPackages, err := listSdkPackages(newDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]packageDiff, 0)
+	for _, name := range unionOfKeys(oldPackages, newPackages) {
+		_, existedBefore := oldPackages[name]
+		_, existsNow := newPackages[name]
+		switch {
+		case !existedBefore:
+			output = append(output, packageDiff{name: name, addedPackage: true})
+			continue
+		case !existsNow:
+			output = append(output, packageDiff{name: name, removedPackage: true})
+			continue
+		}
+
+		oldPackage, err := parseSdkPackage(path.Join(oldDirectory, name))
+		if err != nil {
+			return nil, err
+		}
+		newPackage, err := parseSdkPackage(path.Join(newDirectory, name))
+		if err != nil {
+			return nil, err
+		}
+
+		diff := diffSdkPackages(*oldPackage, *newPackage)
+		diff.name = name
+		output = append(output, diff)
+	}
+
+	return output, nil
+}
+
+func listSdkPackages(directory string) (map[string]struct{}, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("listing the SDK Packages within %q: %+v", directory, err)
+	}
+
+	output := make(map[string]struct{})
+	for _, entry := range entries {
+		if entry.IsDir() {
+			output[entry.Name()] = struct{}{}
+		}
+	}
+	return output, nil
+}
+
+func diffSdkPackages(oldPackage, newPackage sdkPackage) packageDiff {
+	output := packageDiff{
+		newModels: newPackage.models,
+	}
+
+	for _, name := range unionOfKeys(oldPackage.models, newPackage.models) {
+		oldModel, existedBefore := oldPackage.models[name]
+		newModel, existsNow := newPackage.models[name]
+		switch {
+		case !existedBefore:
+			output.addedModels = append(output.addedModels, name)
+		case !existsNow:
+			output.removedModels = append(output.removedModels, name)
+		default:
+			if diff := diffSdkModels(name, oldModel, newModel); diff != nil {
+				output.models = append(output.models, *diff)
+			}
+		}
+	}
+
+	for _, name := range unionOfKeys(oldPackage.enums, newPackage.enums) {
+		oldValues, existedBefore := oldPackage.enums[name]
+		newValues, existsNow := newPackage.enums[name]
+		switch {
+		case !existedBefore:
+			output.addedEnums = append(output.addedEnums, name)
+		case !existsNow:
+			output.removedEnums = append(output.removedEnums, name)
+		default:
+			diff := enumDiff{
+				name:    name,
+				added:   differenceOf(newValues, oldValues),
+				removed: differenceOf(oldValues, newValues),
+			}
+			if len(diff.added) > 0 || len(diff.removed) > 0 {
+				output.enums = append(output.enums, diff)
+			}
+		}
+	}
+
+	return output
+}
+
+// diffSdkModels compares two versions of a Model - where a field is considered renamed when the
+// Go field name has changed but the JSON field name hasn't
+func diffSdkModels(name string, oldModel, newModel sdkModel) *modelDiff {
+	output := modelDiff{
+		name: name,
+	}
+
+	removed := make(map[string]sdkField)
+	for _, fieldName := range unionOfKeys(oldModel.fields, newModel.fields) {
+		oldField, existedBefore := oldModel.fields[fieldName]
+		newField, existsNow := newModel.fields[fieldName]
+		switch {
+		case !existedBefore:
+			output.added = append(output.added, newField)
+		case !existsNow:
+			removed[oldField.jsonName] = oldField
+		case oldField.typeName != newField.typeName:
+			output.typeChanged = append(output.typeChanged, fieldTypeChange{
+				field:   fieldName,
+				oldType: oldField.typeName,
+				newType: newField.typeName,
+			})
+		}
+	}
+
+	added := make([]sdkField, 0)
+	for _, field := range output.added {
+		if oldField, ok := removed[field.jsonName]; ok && field.jsonName != "" {
+			output.renamed = append(output.renamed, fieldRename{
+				old: oldField,
+				new: field,
+			})
+			delete(removed, field.jsonName)
+			continue
+		}
+		added = append(added, field)
+	}
+	output.added = added
+
+	for _, jsonName := range unionOfKeys(removed, nil) {
+		output.removed = append(output.removed, removed[jsonName])
+	}
+
+	if len(output.added) == 0 && len(output.removed) == 0 && len(output.renamed) == 0 && len(output.typeChanged) == 0 {
+		return nil
+	}
+	return &output
+}
+
+// serviceUsages details the references to go-azure-sdk from within a Service Package
+type serviceUsages struct {
+	// apiVersions is a map of the SDK Service name to the API Versions used
+	apiVersions map[string]map[string]struct{}
+
+	functions []functionUsage
+}
+
+// functionUsage details the SDK Packages, types and fields referenced by a function within a Service Package
+type functionUsage struct {
+	name     string
+	fileName string
+
+	// types is a map of the SDK Package path (e.g. `containerservice/2023-09-02-preview/agentpools`) to the types referenced
+	types map[string]map[string]struct{}
+
+	// fields is the names of the fields referenced through selectors or composite literal keys
+	fields map[string]struct{}
+}
+
+// parseServiceUsages parses the Go files within the Service Package (and nested directories) to determine
+// which go-azure-sdk packages, types and fields are referenced by each function
+func parseServiceUsages(serviceDirectory string) (*serviceUsages, error) {
+	output := serviceUsages{
+		apiVersions: map[string]map[string]struct{}{},
+		functions:   make([]functionUsage, 0),
+	}
+
+	err := filepath.WalkDir(serviceDirectory, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		fileSet := token.NewFileSet()
+		file, err := parser.ParseFile(fileSet, path, nil, 0)
+		if err != nil {
+			return fmt.Errorf("parsing %q: %+v", path, err)
+		}
+
+		output.add(path, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+func (s *serviceUsages) add(fileName string, file *ast.File) {
+	// map the import aliases to the SDK Package path (e.g. `containerservice/2023-09-02-preview/agentpools`)
+	sdkImports := make(map[string]string)
+	for _, item := range file.Imports {
+		importPath, err := strconv.Unquote(item.Path.Value)
+		if err != nil || !strings.HasPrefix(importPath, sdkResourceManagerModule+"/") {
+			continue
+		}
+
+		packagePath := strings.TrimPrefix(importPath, sdkResourceManagerModule+"/")
+		segments := strings.Split(packagePath, "/")
+		if len(segments) < 2 || !apiVersionRegex.MatchString(segments[1]) {
+			continue
+		}
+		if _, ok := s.apiVersions[segments[0]]; !ok {
+			s.apiVersions[segments[0]] = map[string]struct{}{}
+		}
+		s.apiVersions[segments[0]][segments[1]] = struct{}{}
+
+		alias := segments[len(segments)-1]
+		if item.Name != nil {
+			alias = item.Name.Name
+		}
+		sdkImports[alias] = packagePath
+	}
+
+	if len(sdkImports) == 0 {
+		return
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		usage := functionUsage{
+			name:     funcDecl.Name.Name,
+			fileName: fileName,
+			types:    map[string]map[string]struct{}{},
+			fields:   map[string]struct{}{},
+		}
+		if funcDecl.Recv != nil && len(funcDecl.Recv.List) > 0 {
+			usage.name = fmt.Sprintf("%s.%s", strings.TrimPrefix(types.ExprString(funcDecl.Recv.List[0].Type), "*"), funcDecl.Name.Name)
+		}
+
+		ast.Inspect(funcDecl, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.SelectorExpr:
+				if ident, ok := v.X.(*ast.Ident); ok {
+					if packagePath, ok := sdkImports[ident.Name]; ok {
+						if _, ok := usage.types[packagePath]; !ok {
+							usage.types[packagePath] = map[string]struct{}{}
+						}
+						usage.types[packagePath][v.Sel.Name] = struct{}{}
+						return true
+					}
+				}
+				usage.fields[v.Sel.Name] = struct{}{}
+
+			case *ast.KeyValueExpr:
+				if ident, ok := v.Key.(*ast.Ident); ok {
+					usage.fields[ident.Name] = struct{}{}
+				}
+			}
+			return true
+		})
+
+		if len(usage.types) > 0 {
+			s.functions = append(s.functions, usage)
+		}
+	}
+}
+
+// reportTargetsForService returns the API Versions of each SDK Service used by the Service Package, optionally
+// limited to a specific API Version
+func reportTargetsForService(serviceName string, usages *serviceUsages, oldApiVersion string) []reportTarget {
+	output := make([]reportTarget, 0)
+	for _, sdkServiceName := range unionOfKeys(usages.apiVersions, nil) {
+		for _, apiVersion := range unionOfKeys(usages.apiVersions[sdkServiceName], nil) {
+			if oldApiVersion != "" && apiVersion != oldApiVersion {
+				continue
+			}
+			output = append(output, reportTarget{
+				serviceName:    serviceName,
+				sdkServiceName: sdkServiceName,
+				oldApiVersion:  apiVersion,
+			})
+		}
+	}
+	return output
+}
+
+type serviceReport struct {
+	content           string
+	breakingChanges   int
+	impactedFunctions map[string]struct{}
+	newProperties     int
+}
+
+func buildServiceReport(target reportTarget, diffs []packageDiff, usages *serviceUsages) serviceReport {
+	output := serviceReport{
+		impactedFunctions: map[string]struct{}{},
+	}
+
+	content := strings.Builder{}
+	content.WriteString(fmt.Sprintf("## Service `%s`: `%s` from `%s` to `%s`\n\n", target.serviceName, target.sdkServiceName, target.oldApiVersion, target.newApiVersion))
+
+	for _, diff := range diffs {
+		packagePath := fmt.Sprintf("%s/%s/%s", target.sdkServiceName, target.oldApiVersion, diff.name)
+		functions := usages.functionsReferencingPackage(packagePath)
+		if len(functions) == 0 {
+			// changes to SDK Packages which aren't used don't impact the Service
+			continue
+		}
+
+		section := strings.Builder{}
+		if diff.removedPackage {
+			output.breakingChanges++
+			section.WriteString("* **Breaking:** this SDK Package has been removed\n")
+			for _, function := range functions {
+				output.impactedFunctions[function.name] = struct{}{}
+				section.WriteString(fmt.Sprintf("  * referenced by `%s` (%s)\n", function.name, function.fileName))
+			}
+		}
+
+		for _, model := range diff.removedModels {
+			output.breakingChanges++
+			section.WriteString(fmt.Sprintf("* **Breaking:** the Model `%s` has been removed\n", model))
+			for _, function := range usages.functionsReferencingType(packagePath, model) {
+				output.impactedFunctions[function.name] = struct{}{}
+				section.WriteString(fmt.Sprintf("  * referenced by `%s` (%s)\n", function.name, function.fileName))
+			}
+		}
+
+		for _, enum := range diff.removedEnums {
+			output.breakingChanges++
+			section.WriteString(fmt.Sprintf("* **Breaking:** the Constant `%s` has been removed\n", enum))
+			for _, function := range usages.functionsReferencingType(packagePath, enum) {
+				output.impactedFunctions[function.name] = struct{}{}
+				section.WriteString(fmt.Sprintf("  * referenced by `%s` (%s)\n", function.name, function.fileName))
+			}
+		}
+
+		for _, model := range diff.models {
+			changes := make([]string, 0)
+			fields := make([]string, 0)
+			for _, field := range model.removed {
+				changes = append(changes, fmt.Sprintf("**Breaking:** the field `%s.%s` (`%s`) has been removed", model.name, field.name, field.jsonName))
+				fields = append(fields, field.name)
+			}
+			for _, rename := range model.renamed {
+				changes = append(changes, fmt.Sprintf("**Breaking:** the field `%s.%s` has been renamed to `%s` (`%s`)", model.name, rename.old.name, rename.new.name, rename.new.jsonName))
+				fields = append(fields, rename.old.name)
+			}
+			for _, change := range model.typeChanged {
+				changes = append(changes, fmt.Sprintf("**Breaking:** the type of the field `%s.%s` has changed from `%s` to `%s`", model.name, change.field, change.oldType, change.newType))
+				fields = append(fields, change.field)
+			}
+			output.breakingChanges += len(changes)
+
+			for i, change := range changes {
+				section.WriteString(fmt.Sprintf("* %s\n", change))
+				for _, function := range usages.functionsReferencingField(packagePath, fields[i]) {
+					output.impactedFunctions[function.name] = struct{}{}
+					section.WriteString(fmt.Sprintf("  * referenced by `%s` (%s)\n", function.name, function.fileName))
+				}
+			}
+		}
+
+		for _, enum := range diff.enums {
+			for _, value := range enum.removed {
+				output.breakingChanges++
+				section.WriteString(fmt.Sprintf("* **Breaking:** the value `%s` has been removed from the Constant `%s`\n", value, enum.name))
+			}
+			if len(enum.added) > 0 {
+				section.WriteString(fmt.Sprintf("* the values `%s` have been added to the Constant `%s`\n", strings.Join(enum.added, "`, `"), enum.name))
+			}
+		}
+
+		if len(diff.addedEnums) > 0 {
+			section.WriteString(fmt.Sprintf("* the Constants `%s` have been added\n", strings.Join(diff.addedEnums, "`, `")))
+		}
+
+		// new fields within the Models used by the Service are candidates for new Schema arguments/attributes
+		reachable := diff.modelsReachableFrom(usages.typesReferencedInPackage(packagePath))
+		for _, model := range diff.models {
+			if _, ok := reachable[model.name]; !ok {
+				continue
+			}
+			for _, field := range model.added {
+				output.newProperties++
+				section.WriteString(fmt.Sprintf("* **New Property:** `%s.%s` (`%s`, type `%s`)\n", model.name, field.name, field.jsonName, field.typeName))
+			}
+		}
+
+		if section.Len() > 0 {
+			content.WriteString(fmt.Sprintf("### SDK Package `%s`\n\n", diff.name))
+			content.WriteString(section.String())
+			content.WriteString("\n")
+		}
+	}
+
+	output.content = content.String()
+	return output
+}
+
+func (s serviceUsages) functionsReferencingPackage(packagePath string) []functionUsage {
+	output := make([]functionUsage, 0)
+	for _, function := range s.functions {
+		if _, ok := function.types[packagePath]; ok {
+			output = append(output, function)
+		}
+	}
+	return output
+}
+
+func (s serviceUsages) functionsReferencingType(packagePath, typeName string) []functionUsage {
+	output := make([]functionUsage, 0)
+	for _, function := range s.functionsReferencingPackage(packagePath) {
+		if _, ok := function.types[packagePath][typeName]; ok {
+			output = append(output, function)
+		}
+	}
+	return output
+}
+
+// functionsReferencingField returns the functions which reference both the SDK Package and the field name - since
+// the type of the variable the field is accessed on isn't known, this is an approximation
+func (s serviceUsages) functionsReferencingField(packagePath, fieldName string) []functionUsage {
+	output := make([]functionUsage, 0)
+	for _, function := range s.functionsReferencingPackage(packagePath) {
+		if _, ok := function.fields[fieldName]; ok {
+			output = append(output, function)
+		}
+	}
+	return output
+}
+
+func (s serviceUsages) typesReferencedInPackage(packagePath string) map[string]struct{} {
+	output := make(map[string]struct{})
+	for _, function := range s.functionsReferencingPackage(packagePath) {
+		for typeName := range function.types[packagePath] {
+			output[typeName] = struct{}{}
+		}
+	}
+	return output
+}
+
+var typeNameRegex = regexp.MustCompile(`[A-Za-z0-9_]+`)
+
+// modelsReachableFrom returns the names of the Models referenced directly, or through the fields of those Models
+func (p packageDiff) modelsReachableFrom(referenced map[string]struct{}) map[string]struct{} {
+	output := make(map[string]struct{})
+	queue := unionOfKeys(referenced, nil)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+
+		model, ok := p.newModels[name]
+		if !ok {
+			continue
+		}
+		if _, seen := output[name]; seen {
+			continue
+		}
+		output[name] = struct{}{}
+
+		for _, field := range model.fields {
+			queue = append(queue, typeNameRegex.FindAllString(field.typeName, -1)...)
+		}
+	}
+	return output
+}
+
+// unionOfKeys returns the sorted union of the keys within both maps
+func unionOfKeys[T any](first, second map[string]T) []string {
+	keys := make(map[string]struct{})
+	for k := range first {
+		keys[k] = struct{}{}
+	}
+	for k := range second {
+		keys[k] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for k := range keys {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
+
+// differenceOf returns the values within `first` which aren't present in `second`
+func differenceOf(first, second []string) []string {
+	existing := make(map[string]struct{})
+	for _, v := range second {
+		existing[v] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for _, v := range first {
+		if _, ok := existing[v]; !ok {
+			output = append(output, v)
+		}
+	}
+	return output
+}
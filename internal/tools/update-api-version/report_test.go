@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func init() {
+	logger = hclog.NewNullLogger()
+}
+
+func parseTestFile(t *testing.T, fileName, src string) *ast.File {
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, src, 0)
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", fileName, err)
+	}
+	return file
+}
+
+const oldSdkPackage = `
+package widgets
+
+type SkuName string
+
+const (
+	SkuNameBasic    SkuName = "Basic"
+	SkuNameStandard SkuName = "Standard"
+	SkuNameClassic  SkuName = "Classic"
+)
+
+type Widget struct {
+	Name       *string           ` + "`json:\"name,omitempty\"`" + `
+	Properties *WidgetProperties ` + "`json:\"properties,omitempty\"`" + `
+}
+
+type WidgetProperties struct {
+	Count       *int64   ` + "`json:\"count,omitempty\"`" + `
+	IpAddresses []string ` + "`json:\"ipAddresses,omitempty\"`" + `
+	Legacy      *bool    ` + "`json:\"legacy,omitempty\"`" + `
+	Sku         *SkuName ` + "`json:\"sku,omitempty\"`" + `
+}
+
+type Unused struct {
+	Value *string ` + "`json:\"value,omitempty\"`" + `
+}
+`
+
+const newSdkPackage = `
+package widgets
+
+type SkuName string
+
+const (
+	SkuNameBasic    SkuName = "Basic"
+	SkuNameStandard SkuName = "Standard"
+	SkuNamePremium  SkuName = "Premium"
+)
+
+type Widget struct {
+	Name       *string           ` + "`json:\"name,omitempty\"`" + `
+	Properties *WidgetProperties ` + "`json:\"properties,omitempty\"`" + `
+}
+
+type WidgetProperties struct {
+	Count        *int64   ` + "`json:\"count,omitempty\"`" + `
+	IPAddresses  []string ` + "`json:\"ipAddresses,omitempty\"`" + `
+	Sku          *SkuName ` + "`json:\"sku,omitempty\"`" + `
+	Zones        *[]string ` + "`json:\"zones,omitempty\"`" + `
+}
+
+type Unused struct {
+	Value   *string ` + "`json:\"value,omitempty\"`" + `
+	Another *string ` + "`json:\"another,omitempty\"`" + `
+}
+`
+
+const serviceFile = `
+package example
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/example/2022-01-01/widgets"
+)
+
+func expandWidgetProperties(input []interface{}) *widgets.WidgetProperties {
+	return &widgets.WidgetProperties{
+		IpAddresses: []string{},
+		Legacy:      nil,
+	}
+}
+
+func flattenWidgetProperties(input *widgets.WidgetProperties) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"count": input.Count,
+		},
+	}
+}
+
+func unrelated() string {
+	return "hello"
+}
+`
+
+func TestDiffSdkPackages(t *testing.T) {
+	oldPackage := parseSdkPackageFiles([]*ast.File{parseTestFile(t, "old.go", oldSdkPackage)})
+	newPackage := parseSdkPackageFiles([]*ast.File{parseTestFile(t, "new.go", newSdkPackage)})
+
+	if expected := []string{"Basic", "Classic", "Standard"}; !reflect.DeepEqual(oldPackage.enums["SkuName"], expected) {
+		t.Fatalf("expected the values %+v for the Constant `SkuName` but got %+v", expected, oldPackage.enums["SkuName"])
+	}
+
+	diff := diffSdkPackages(*oldPackage, *newPackage)
+	if len(diff.addedModels) != 0 || len(diff.removedModels) != 0 {
+		t.Fatalf("expected no Models to be added or removed but got %+v / %+v", diff.addedModels, diff.removedModels)
+	}
+
+	if len(diff.models) != 2 {
+		t.Fatalf("expected 2 Models to have changed but got %d", len(diff.models))
+	}
+
+	unused := diff.models[0]
+	if unused.name != "Unused" || len(unused.added) != 1 || unused.added[0].name != "Another" {
+		t.Fatalf("expected the field `Another` to be added to `Unused` but got %+v", unused)
+	}
+
+	properties := diff.models[1]
+	if properties.name != "WidgetProperties" {
+		t.Fatalf("expected the Model `WidgetProperties` but got %q", properties.name)
+	}
+	if len(properties.renamed) != 1 || properties.renamed[0].old.name != "IpAddresses" || properties.renamed[0].new.name != "IPAddresses" {
+		t.Fatalf("expected `IpAddresses` to be renamed to `IPAddresses` but got %+v", properties.renamed)
+	}
+	if len(properties.removed) != 1 || properties.removed[0].name != "Legacy" {
+		t.Fatalf("expected `Legacy` to be removed but got %+v", properties.removed)
+	}
+	if len(properties.added) != 1 || properties.added[0].name != "Zones" {
+		t.Fatalf("expected `Zones` to be added but got %+v", properties.added)
+	}
+	if len(properties.typeChanged) != 0 {
+		t.Fatalf("expected no type changes but got %+v", properties.typeChanged)
+	}
+
+	expectedEnums := []enumDiff{
+		{
+			name:    "SkuName",
+			added:   []string{"Premium"},
+			removed: []string{"Classic"},
+		},
+	}
+	if !reflect.DeepEqual(diff.enums, expectedEnums) {
+		t.Fatalf("expected the Constant changes %+v but got %+v", expectedEnums, diff.enums)
+	}
+}
+
+func TestDiffSdkModelsTypeChanged(t *testing.T) {
+	oldModel := sdkModel{
+		fields: map[string]sdkField{
+			"Count": {name: "Count", jsonName: "count", typeName: "*int64"},
+		},
+	}
+	newModel := sdkModel{
+		fields: map[string]sdkField{
+			"Count": {name: "Count", jsonName: "count", typeName: "*string"},
+		},
+	}
+
+	diff := diffSdkModels("Example", oldModel, newModel)
+	if diff == nil {
+		t.Fatalf("expected a diff but didn't get one")
+	}
+	expected := []fieldTypeChange{
+		{
+			field:   "Count",
+			oldType: "*int64",
+			newType: "*string",
+		},
+	}
+	if !reflect.DeepEqual(diff.typeChanged, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, diff.typeChanged)
+	}
+
+	if diff := diffSdkModels("Example", oldModel, oldModel); diff != nil {
+		t.Fatalf("expected no diff for identical Models but got %+v", *diff)
+	}
+}
+
+func TestBuildServiceReport(t *testing.T) {
+	oldPackage := parseSdkPackageFiles([]*ast.File{parseTestFile(t, "old.go", oldSdkPackage)})
+	newPackage := parseSdkPackageFiles([]*ast.File{parseTestFile(t, "new.go", newSdkPackage)})
+	diff := diffSdkPackages(*oldPackage, *newPackage)
+	diff.name = "widgets"
+
+	usages := serviceUsages{
+		apiVersions: map[string]map[string]struct{}{},
+	}
+	usages.add("example.go", parseTestFile(t, "example.go", serviceFile))
+
+	if _, ok := usages.apiVersions["example"]["2022-01-01"]; !ok {
+		t.Fatalf("expected the API Version `2022-01-01` of `example` to be used but got %+v", usages.apiVersions)
+	}
+	if len(usages.functions) != 2 {
+		t.Fatalf("expected 2 functions to reference the SDK but got %d", len(usages.functions))
+	}
+
+	target := reportTarget{
+		serviceName:    "example",
+		sdkServiceName: "example",
+		oldApiVersion:  "2022-01-01",
+		newApiVersion:  "2023-01-01",
+	}
+	result := buildServiceReport(target, []packageDiff{diff}, &usages)
+
+	// `Legacy` removed, `IpAddresses` renamed and `Classic` removed
+	if result.breakingChanges != 3 {
+		t.Fatalf("expected 3 breaking changes but got %d:\n%s", result.breakingChanges, result.content)
+	}
+	if _, ok := result.impactedFunctions["expandWidgetProperties"]; !ok || len(result.impactedFunctions) != 1 {
+		t.Fatalf("expected only `expandWidgetProperties` to be impacted but got %+v", result.impactedFunctions)
+	}
+
+	// `Unused.Another` isn't reachable from the Models used by the Service
+	if result.newProperties != 1 || !strings.Contains(result.content, "`WidgetProperties.Zones`") {
+		t.Fatalf("expected the new property `WidgetProperties.Zones` but got:\n%s", result.content)
+	}
+}
+
+func TestLatestApiVersion(t *testing.T) {
+	sdkPath := t.TempDir()
+	for _, directory := range []string{"2021-01-01", "2022-01-01", "2022-06-01-preview", "2023-01-01-preview", "widgets"} {
+		if err := os.MkdirAll(filepath.Join(sdkPath, "example", directory), 0755); err != nil {
+			t.Fatalf("creating %q: %+v", directory, err)
+		}
+	}
+
+	cases := map[string]*string{
+		"2021-01-01":         pointerTo("2022-01-01"),
+		"2022-01-01":         pointerTo("2023-01-01-preview"),
+		"2023-01-01-preview": nil,
+	}
+	for current, expected := range cases {
+		actual, err := latestApiVersion(sdkPath, "example", current)
+		if err != nil {
+			t.Fatalf("determining the latest API Version for %q: %+v", current, err)
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("expected %+v for %q but got %+v", expected, current, actual)
+		}
+	}
+}
+
+func pointerTo(input string) *string {
+	return &input
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/importids"
 	"github.com/magodo/terraform-provider-azurerm-example-gen/examplegen"
 )
 
@@ -28,7 +29,7 @@ func main() {
 
 	resourceName := f.String("name", "", "The name of the Data Source/Resource which should be generated")
 	brandName := f.String("brand-name", "", "The friendly/brand name of this Data Source/Resource (e.g. Resource Group)")
-	resourceId := f.String("resource-id", "", "An Azure Resource ID showing an example of how to Import this Resource")
+	resourceId := f.String("resource-id", "", "An Azure Resource ID showing an example of how to Import this Resource. Generated from the Resource ID parser when not specified")
 	resourceType := f.String("type", "", "Whether this is a Data Source (data) or a Resource (resource)")
 	websitePath := f.String("website-path", "", "The relative path to the website folder")
 
@@ -71,10 +72,6 @@ func main() {
 	}
 
 	isResource := *resourceType == "resource"
-	if isResource && (resourceId == nil || *resourceId == "") {
-		quitWithError("An example of an Azure Resource ID must be specified via `-resource-id` when scaffolding for a Resource")
-		return
-	}
 
 	var expsrc *examplegen.ExampleSource
 	if *genExample {
@@ -166,6 +163,15 @@ func getContent(resourceName, brandName string, resourceId *string, isResource b
 		if generator.resource == nil {
 			return nil, fmt.Errorf("Resource %q was not registered!", resourceName)
 		}
+
+		if generator.resourceId == nil || *generator.resourceId == "" {
+			// generate the example from the type of Resource ID used to import the Resource
+			example, ok := importids.Resources()[resourceName].ExampleID()
+			if !ok {
+				return nil, fmt.Errorf("an example Resource ID couldn't be generated for Resource %q - an example of an Azure Resource ID must be specified via `-resource-id`", resourceName)
+			}
+			generator.resourceId = &example
+		}
 	}
 
 	docs := generator.generate()